@@ -40,11 +40,25 @@ func FiberServer() {
 
 	// Setup repository and service based on the selected database
 	var superUserRepository repositories.SuperUserRepositoryInterface
+	var eventRepository repositories.EventRepositoryInterface
+	var venueRepository repositories.VenueRepositoryInterface
+	var guestRepository repositories.GuestRepositoryInterface
+	var auditRepository repositories.AuditRepositoryInterface
+	var eventGrantRepository repositories.EventGrantRepositoryInterface
+	var organizationRepository repositories.OrganizationRepositoryInterface
+	var loginChallengeRepository repositories.LoginChallengeRepositoryInterface
 
 	switch configs.DatabaseType {
 	case "inmemory":
 		// Initialize Postgres repository (not shown in your example, but you can add it here)
 		superUserRepository = inmemory.NewInMemorySuperUserRepository()
+		eventRepository = inmemory.NewInMemoryEventRepository()
+		venueRepository = inmemory.NewInMemoryVenueRepository()
+		guestRepository = inmemory.NewInMemoryGuestRepository()
+		auditRepository = inmemory.NewInMemoryAuditRepository()
+		eventGrantRepository = inmemory.NewInMemoryEventGrantRepository()
+		organizationRepository = inmemory.NewInMemoryOrganizationRepository()
+		loginChallengeRepository = inmemory.NewInMemoryLoginChallengeRepository()
 
 	case "postgres":
 		// Check if GORM PostgreSQL connection is initialized
@@ -53,6 +67,13 @@ func FiberServer() {
 		}
 		// Initialize Postgres repository (not shown in your example, but you can add it here)
 		superUserRepository = postgresdb.NewPostgresSuperUserRepository(configs.GormDB) // Example
+		eventRepository = postgresdb.NewPostgresEventRepository(configs.GormDB)
+		venueRepository = postgresdb.NewPostgresVenueRepository(configs.GormDB, configs.PostgresGeoExtension)
+		guestRepository = postgresdb.NewPostgresGuestRepository(configs.GormDB)
+		auditRepository = postgresdb.NewPostgresAuditRepository(configs.GormDB)
+		eventGrantRepository = postgresdb.NewPostgresEventGrantRepository(configs.GormDB)
+		organizationRepository = postgresdb.NewPostgresOrganizationRepository(configs.GormDB)
+		loginChallengeRepository = postgresdb.NewPostgresLoginChallengeRepository(configs.GormDB)
 
 	case "mongodb":
 		if configs.MongoClient == nil {
@@ -62,10 +83,16 @@ func FiberServer() {
 		// Retrieve the specific database for the SuperUser repository
 		superUserDB := gophermongo.GetDatabase(configs.MongoClient, "superuser")
 		superUserRepository = mongodb.NewMongoSuperUserRepository(superUserDB)
+		loginChallengeRepository = mongodb.NewMongoLoginChallengeRepository(superUserDB)
 
-		// Similarly, if you need to set up another repository with a different database:
-		// eventDB := gophermongo.GetDatabase(configs.MongoClient, "events")
-		// eventRepository = mongodb.NewMongoEventRepository(eventDB) // Example
+		// Events, guests, organizations, event grants and the audit log are shared with the Gin server
+		eventureGoDatabase := gophermongo.GetDatabase(configs.MongoClient, "EventureGo")
+		eventRepository = mongodb.NewMongoEventRepository(eventureGoDatabase)
+		venueRepository = mongodb.NewMongoVenueRepository(eventureGoDatabase)
+		guestRepository = mongodb.NewMongoGuestRepository(eventureGoDatabase)
+		auditRepository = mongodb.NewMongoAuditRepository(eventureGoDatabase)
+		eventGrantRepository = mongodb.NewMongoEventGrantRepository(eventureGoDatabase)
+		organizationRepository = mongodb.NewMongoOrganizationRepository(eventureGoDatabase)
 
 	default:
 		log.Fatalf("Invalid database configuration")
@@ -76,6 +103,8 @@ func FiberServer() {
 	if err != nil {
 		log.Fatalf("Failed to initiate token: %v", err)
 	}
+	// Reject the tokens of sessions a superuser revoked, e.g. by changing their password
+	tokenManager = services.NewSessionTokenManager(tokenManager, superUserRepository)
 
 	// Initialize services
 	emailService := gophersmtp.NewEmailService(
@@ -84,9 +113,17 @@ func FiberServer() {
 		configs.EmailUsername,
 		configs.EmailPassword,
 	)
-	superUserService := services.NewSuperUserService(superUserRepository, tokenManager, emailService)
+	auditService := services.NewAuditService(auditRepository)
+	superUserService := services.NewSuperUserService(superUserRepository, tokenManager, emailService, auditService)
+	eventBusService := services.NewEventBusService()
+	passwordlessLoginService := services.NewPasswordlessLoginService(loginChallengeRepository, superUserRepository, tokenManager, emailService)
+	attendanceService := services.NewAttendanceService(guestRepository, eventRepository, organizationRepository, venueRepository, superUserRepository, eventGrantRepository, eventBusService)
 
 	superUserHandler := handlers.NewSuperUserFiberHandler(superUserService)
+	passwordlessLoginHandler := handlers.NewPasswordlessLoginFiberHandler(passwordlessLoginService)
+	attendanceHandler := handlers.NewAttendanceFiberHandler(attendanceService)
+	adminSuperUserHandler := handlers.NewAdminSuperUserFiberHandler(superUserService)
+	adminAuditHandler := handlers.NewAdminAuditFiberHandler(auditService)
 
 	// Create ServerConfig for gopherfiber
 	serverConfig := gopherfiber.ServerConfig{
@@ -113,6 +150,10 @@ func FiberServer() {
 
 	// Set up Fiber routes
 	routes.SetupSuperUserFiberRoutes(fiberServer.GetRouter(), superUserHandler, tokenManager)
+	routes.SetupPasswordlessLoginFiberRoutes(fiberServer.GetRouter(), passwordlessLoginHandler)
+	routes.SetupAttendanceFiberRoutes(fiberServer.GetRouter(), attendanceHandler, tokenManager)
+	routes.SetupAdminSuperUserFiberRoutes(fiberServer.GetRouter(), adminSuperUserHandler, tokenManager, superUserService)
+	routes.SetupAdminAuditFiberRoutes(fiberServer.GetRouter(), adminAuditHandler, tokenManager, superUserService)
 
 	// Start the Fiber server
 	if err := fiberServer.Start(); err != nil {
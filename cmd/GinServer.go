@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"log"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/lordofthemind/EventureGo/internals/repositories/inmemory"
 	"github.com/lordofthemind/EventureGo/internals/repositories/mongodb"
 	"github.com/lordofthemind/EventureGo/internals/repositories/postgresdb"
+	"github.com/lordofthemind/EventureGo/internals/responses"
 	"github.com/lordofthemind/EventureGo/internals/routes"
 	"github.com/lordofthemind/EventureGo/internals/services"
 	"github.com/lordofthemind/mygopher/gophergin"
@@ -42,11 +44,40 @@ func GinServer() {
 	// Set up repository and service based on the selected database type from the config
 	var superUserRepository repositories.SuperUserRepositoryInterface
 	var eventRepository repositories.EventRepositoryInterface
+	var venueRepository repositories.VenueRepositoryInterface
+	var guestRepository repositories.GuestRepositoryInterface
+	var reminderRepository repositories.ReminderRepositoryInterface
+	var jobRepository repositories.JobRepositoryInterface
+	var ticketRepository repositories.TicketRepositoryInterface
+	var webhookRepository repositories.WebhookRepositoryInterface
+	var ticketTierRepository repositories.TicketTierRepositoryInterface
+	var orderRepository repositories.OrderRepositoryInterface
+	var promoCodeRepository repositories.PromoCodeRepositoryInterface
+	var registrationFormRepository repositories.RegistrationFormRepositoryInterface
+	var auditRepository repositories.AuditRepositoryInterface
+	var organizationRepository repositories.OrganizationRepositoryInterface
+	var eventGrantRepository repositories.EventGrantRepositoryInterface
+	var loginChallengeRepository repositories.LoginChallengeRepositoryInterface
 
 	switch configs.DatabaseType {
 	case "inmemory":
 		// Initialize In-memory repository
 		superUserRepository = inmemory.NewInMemorySuperUserRepository()
+		eventRepository = inmemory.NewInMemoryEventRepository()
+		venueRepository = inmemory.NewInMemoryVenueRepository()
+		guestRepository = inmemory.NewInMemoryGuestRepository()
+		reminderRepository = inmemory.NewInMemoryReminderRepository()
+		jobRepository = inmemory.NewInMemoryJobRepository()
+		ticketRepository = inmemory.NewInMemoryTicketRepository()
+		webhookRepository = inmemory.NewInMemoryWebhookRepository()
+		ticketTierRepository = inmemory.NewInMemoryTicketTierRepository()
+		orderRepository = inmemory.NewInMemoryOrderRepository()
+		promoCodeRepository = inmemory.NewInMemoryPromoCodeRepository()
+		registrationFormRepository = inmemory.NewInMemoryRegistrationFormRepository()
+		auditRepository = inmemory.NewInMemoryAuditRepository()
+		organizationRepository = inmemory.NewInMemoryOrganizationRepository()
+		eventGrantRepository = inmemory.NewInMemoryEventGrantRepository()
+		loginChallengeRepository = inmemory.NewInMemoryLoginChallengeRepository()
 
 	case "postgres":
 		// Check if GORM PostgreSQL connection is initialized
@@ -56,6 +87,20 @@ func GinServer() {
 		// Initialize PostgreSQL repository
 		superUserRepository = postgresdb.NewPostgresSuperUserRepository(configs.GormDB)
 		eventRepository = postgresdb.NewPostgresEventRepository(configs.GormDB)
+		venueRepository = postgresdb.NewPostgresVenueRepository(configs.GormDB, configs.PostgresGeoExtension)
+		guestRepository = postgresdb.NewPostgresGuestRepository(configs.GormDB)
+		reminderRepository = postgresdb.NewPostgresReminderRepository(configs.GormDB)
+		jobRepository = postgresdb.NewPostgresJobRepository(configs.GormDB)
+		ticketRepository = postgresdb.NewPostgresTicketRepository(configs.GormDB)
+		webhookRepository = postgresdb.NewPostgresWebhookRepository(configs.GormDB)
+		ticketTierRepository = postgresdb.NewPostgresTicketTierRepository(configs.GormDB)
+		orderRepository = postgresdb.NewPostgresOrderRepository(configs.GormDB)
+		promoCodeRepository = postgresdb.NewPostgresPromoCodeRepository(configs.GormDB)
+		registrationFormRepository = postgresdb.NewPostgresRegistrationFormRepository(configs.GormDB)
+		auditRepository = postgresdb.NewPostgresAuditRepository(configs.GormDB)
+		organizationRepository = postgresdb.NewPostgresOrganizationRepository(configs.GormDB)
+		eventGrantRepository = postgresdb.NewPostgresEventGrantRepository(configs.GormDB)
+		loginChallengeRepository = postgresdb.NewPostgresLoginChallengeRepository(configs.GormDB)
 
 	case "mongodb":
 		// Check if MongoDB connection is initialized
@@ -66,6 +111,20 @@ func GinServer() {
 		eventureGoDatabase := gophermongo.GetDatabase(configs.MongoClient, "EventureGo")
 		superUserRepository = mongodb.NewMongoSuperUserRepository(eventureGoDatabase)
 		eventRepository = mongodb.NewMongoEventRepository(eventureGoDatabase)
+		venueRepository = mongodb.NewMongoVenueRepository(eventureGoDatabase)
+		guestRepository = mongodb.NewMongoGuestRepository(eventureGoDatabase)
+		reminderRepository = mongodb.NewMongoReminderRepository(eventureGoDatabase)
+		jobRepository = mongodb.NewMongoJobRepository(eventureGoDatabase)
+		ticketRepository = mongodb.NewMongoTicketRepository(eventureGoDatabase)
+		webhookRepository = mongodb.NewMongoWebhookRepository(eventureGoDatabase)
+		ticketTierRepository = mongodb.NewMongoTicketTierRepository(eventureGoDatabase)
+		orderRepository = mongodb.NewMongoOrderRepository(eventureGoDatabase)
+		promoCodeRepository = mongodb.NewMongoPromoCodeRepository(eventureGoDatabase)
+		registrationFormRepository = mongodb.NewMongoRegistrationFormRepository(eventureGoDatabase)
+		auditRepository = mongodb.NewMongoAuditRepository(eventureGoDatabase)
+		organizationRepository = mongodb.NewMongoOrganizationRepository(eventureGoDatabase)
+		eventGrantRepository = mongodb.NewMongoEventGrantRepository(eventureGoDatabase)
+		loginChallengeRepository = mongodb.NewMongoLoginChallengeRepository(eventureGoDatabase)
 
 		// Similarly, if you need to set up another repository with a different database:
 		// eventDB := gophermongo.GetDatabase(configs.MongoClient, "events")
@@ -80,6 +139,17 @@ func GinServer() {
 	if err != nil {
 		log.Fatalf("Failed to initiate token: %v", err)
 	}
+	// Reject the tokens of sessions a superuser revoked, e.g. by changing their password
+	tokenManager = services.NewSessionTokenManager(tokenManager, superUserRepository)
+
+	// Select the payment provider paid orders go through
+	var paymentProvider services.PaymentProviderInterface
+	switch configs.PaymentProvider {
+	case services.FakePaymentProviderName:
+		paymentProvider = services.NewFakePaymentProvider(configs.FakePaymentAutoApprove)
+	default:
+		log.Fatalf("Invalid payment provider configuration: %s", configs.PaymentProvider)
+	}
 
 	// Initialize services
 	emailRoutineService := gophersmtp.NewEmailRoutineService(
@@ -89,12 +159,50 @@ func GinServer() {
 		configs.EmailPassword,
 	)
 
-	superUserService := services.NewSuperUserService(superUserRepository, tokenManager, emailRoutineService)
-	eventService := services.NewEventService(eventRepository)
+	auditService := services.NewAuditService(auditRepository)
+	superUserService := services.NewSuperUserService(superUserRepository, tokenManager, emailRoutineService, auditService)
+	passwordlessLoginService := services.NewPasswordlessLoginService(loginChallengeRepository, superUserRepository, tokenManager, emailRoutineService)
+	eventBusService := services.NewEventBusService()
+	eventNotificationService := services.NewEventNotificationService(guestRepository, emailRoutineService)
+	reminderService := services.NewReminderService(reminderRepository, eventRepository, guestRepository, emailRoutineService)
+	eventService := services.NewEventService(eventRepository, venueRepository, organizationRepository, eventGrantRepository, eventNotificationService, reminderService, eventBusService, auditService)
+	venueService := services.NewVenueService(venueRepository, eventRepository, auditService)
+	ticketService := services.NewTicketService(ticketRepository, guestRepository, eventRepository, organizationRepository, superUserRepository, eventGrantRepository, emailRoutineService, eventBusService, auditService)
+	guestService := services.NewGuestService(guestRepository, eventRepository, venueRepository, registrationFormRepository, organizationRepository, eventGrantRepository, ticketService, eventBusService, auditService)
+	attendanceService := services.NewAttendanceService(guestRepository, eventRepository, organizationRepository, venueRepository, superUserRepository, eventGrantRepository, eventBusService)
+	webhookService := services.NewWebhookService(webhookRepository, eventRepository, organizationRepository, eventGrantRepository, auditService)
+	ticketTierService := services.NewTicketTierService(ticketTierRepository, eventRepository, organizationRepository, eventGrantRepository, auditService)
+	registrationFormService := services.NewRegistrationFormService(registrationFormRepository, eventRepository, organizationRepository, eventGrantRepository, auditService)
+	publicEventService := services.NewPublicEventService(eventRepository, venueRepository, registrationFormRepository, guestRepository, guestService, emailRoutineService, auditService)
+	promoCodeService := services.NewPromoCodeService(promoCodeRepository, eventRepository, organizationRepository, eventGrantRepository, ticketTierRepository, orderRepository, auditService)
+	orderService := services.NewOrderService(orderRepository, ticketTierRepository, promoCodeRepository, eventRepository, organizationRepository, eventGrantRepository, guestRepository, ticketService, paymentProvider, eventBusService, auditService)
+	organizationService := services.NewOrganizationService(organizationRepository, superUserRepository, emailRoutineService, auditService)
+	eventGrantService := services.NewEventGrantService(eventGrantRepository, eventRepository, organizationRepository, superUserRepository, emailRoutineService, auditService)
+	jobSchedulerService := services.NewJobSchedulerService(jobRepository)
+
+	// Deliver domain events to the organizers' webhooks
+	eventBusService.Subscribe(webhookService.DispatchDomainEvent)
 
 	// Initialize handler
 	superUserHandler := handlers.NewSuperUserGinHandler(superUserService)
+	passwordlessLoginHandler := handlers.NewPasswordlessLoginGinHandler(passwordlessLoginService)
 	eventHandler := handlers.NewEventGinHandler(eventService)
+	venueHandler := handlers.NewVenueGinHandler(venueService)
+	guestHandler := handlers.NewGuestGinHandler(guestService)
+	ticketHandler := handlers.NewTicketGinHandler(ticketService)
+	attendanceHandler := handlers.NewAttendanceGinHandler(attendanceService)
+	webhookHandler := handlers.NewWebhookGinHandler(webhookService)
+	ticketTierHandler := handlers.NewTicketTierGinHandler(ticketTierService)
+	orderHandler := handlers.NewOrderGinHandler(orderService)
+	promoCodeHandler := handlers.NewPromoCodeGinHandler(promoCodeService)
+	registrationFormHandler := handlers.NewRegistrationFormGinHandler(registrationFormService)
+	publicEventHandler := handlers.NewPublicEventGinHandler(publicEventService)
+	organizationHandler := handlers.NewOrganizationGinHandler(organizationService)
+	eventGrantHandler := handlers.NewEventGrantGinHandler(eventGrantService)
+	jobHandler := handlers.NewJobGinHandler(jobSchedulerService)
+	adminConsoleHandler := handlers.NewAdminConsoleGinHandler(superUserService, eventService, guestService, auditService)
+	adminSuperUserHandler := handlers.NewAdminSuperUserGinHandler(superUserService)
+	adminAuditHandler := handlers.NewAdminAuditGinHandler(auditService)
 
 	// Use gophergin to set up the server
 	serverConfig := gophergin.ServerConfig{
@@ -118,12 +226,36 @@ func GinServer() {
 	// Get the router from the server
 	router := ginServer.GetRouter()
 
+	// Load the pages handlers render for browsers and HTMX
+	htmlRenderer, err := responses.NewHTMLRenderer(configs.TemplatePath)
+	if err != nil {
+		log.Fatalf("Failed to load HTML templates: %v", err)
+	}
+
 	// Middleware
 	router.Use(middlewares.RequestIDGinMiddleware())
+	router.Use(middlewares.HTMLRendererGinMiddleware(htmlRenderer))
 
 	// Set up routes
 	routes.SetupSuperUserGinRoutes(router, superUserHandler, tokenManager)
-	routes.SetupEventGinRoutes(router, eventHandler, tokenManager)
+	routes.SetupPasswordlessLoginGinRoutes(router, passwordlessLoginHandler)
+	routes.SetupEventGinRoutes(router, eventHandler, tokenManager, organizationService)
+	routes.SetupVenueGinRoutes(router, venueHandler, tokenManager)
+	routes.SetupGuestGinRoutes(router, guestHandler, tokenManager, organizationService)
+	routes.SetupTicketGinRoutes(router, ticketHandler, tokenManager, organizationService)
+	routes.SetupAttendanceGinRoutes(router, attendanceHandler, tokenManager, organizationService)
+	routes.SetupWebhookGinRoutes(router, webhookHandler, tokenManager)
+	routes.SetupTicketTierGinRoutes(router, ticketTierHandler, tokenManager, organizationService)
+	routes.SetupOrderGinRoutes(router, orderHandler, tokenManager, organizationService)
+	routes.SetupPromoCodeGinRoutes(router, promoCodeHandler, tokenManager, organizationService)
+	routes.SetupRegistrationFormGinRoutes(router, registrationFormHandler, tokenManager, organizationService)
+	routes.SetupPublicEventGinRoutes(router, publicEventHandler)
+	routes.SetupOrganizationGinRoutes(router, organizationHandler, tokenManager)
+	routes.SetupEventGrantGinRoutes(router, eventGrantHandler, tokenManager, organizationService)
+	routes.SetupJobGinRoutes(router, jobHandler, tokenManager, superUserService)
+	routes.SetupAdminConsoleGinRoutes(router, adminConsoleHandler, tokenManager, superUserService)
+	routes.SetupAdminSuperUserGinRoutes(router, adminSuperUserHandler, tokenManager, superUserService)
+	routes.SetupAdminAuditGinRoutes(router, adminAuditHandler, tokenManager, superUserService)
 
 	// Start a goroutine to handle email results
 	go func() {
@@ -136,6 +268,12 @@ func GinServer() {
 		}
 	}()
 
+	// Run reminders, cleanups and other periodic jobs in the background until the server shuts down
+	if err := services.RegisterMaintenanceJobs(context.Background(), jobSchedulerService, reminderService, superUserService, passwordlessLoginService, eventService, webhookService, orderService); err != nil {
+		log.Fatalf("Failed to schedule background jobs: %v", err)
+	}
+	jobSchedulerService.StartJobScheduler(context.Background(), configs.JobPollInterval)
+
 	// Start server (with or without TLS)
 	if err := ginServer.Start(); err != nil {
 		log.Fatalf("Failed to start Gin server on port %d: %v", serverConfig.Port, err)
@@ -143,4 +281,5 @@ func GinServer() {
 
 	// Graceful shutdown handling
 	ginServer.GracefulShutdown()
+	jobSchedulerService.StopJobScheduler()
 }
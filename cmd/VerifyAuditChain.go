@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/initializers"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/repositories/inmemory"
+	"github.com/lordofthemind/EventureGo/internals/repositories/mongodb"
+	"github.com/lordofthemind/EventureGo/internals/repositories/postgresdb"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/mygopher/gophermongo"
+)
+
+// VerifyAuditChain checks the hash chain of the audit log in the configured database, prints the result and exits
+// with status 1 when the chain is broken, so it can run from cron or CI
+func VerifyAuditChain() {
+	// Load configuration
+	err := configs.LoadMainConfiguration("config.yaml")
+	if err != nil {
+		log.Fatalf("Failed to load configuration file: %v", err)
+	}
+
+	// Initialize the database (Postgres, MongoDB, or in-memory) based on the loaded config
+	initializers.DatabaseInitializer()
+
+	var auditRepository repositories.AuditRepositoryInterface
+	switch configs.DatabaseType {
+	case "inmemory":
+		// An in-memory audit log lives only as long as the server, so there is nothing to check here
+		auditRepository = inmemory.NewInMemoryAuditRepository()
+
+	case "postgres":
+		if configs.GormDB == nil {
+			log.Fatalf("Postgres connection was not initialized")
+		}
+		auditRepository = postgresdb.NewPostgresAuditRepository(configs.GormDB)
+
+	case "mongodb":
+		if configs.MongoClient == nil {
+			log.Fatalf("MongoDB client was not initialized")
+		}
+		auditRepository = mongodb.NewMongoAuditRepository(gophermongo.GetDatabase(configs.MongoClient, "EventureGo"))
+
+	default:
+		log.Fatalf("Invalid database configuration: %s", configs.DatabaseType)
+	}
+
+	report, err := services.NewAuditService(auditRepository).VerifyAuditChainService(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to verify audit log: %v", err)
+	}
+
+	if !report.Valid {
+		fmt.Printf("Audit log is BROKEN at record %d: %s\n", report.BrokenAtSequence, report.Problem)
+		fmt.Printf("%d records before it are intact\n", report.Records)
+		os.Exit(1)
+	}
+	fmt.Printf("Audit log is intact: %d records, last hash %s\n", report.Records, report.LastHash)
+}
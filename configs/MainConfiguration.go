@@ -1,8 +1,14 @@
 package configs
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
 	"fmt"
 	"log"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -25,6 +31,74 @@ var (
 	GormDB       *gorm.DB      // GORM DB object for PostgreSQL
 	MongoClient  *mongo.Client // MongoDB client connection
 
+	// Geo Configuration
+	PostgresGeoExtension  string  // Preferred Postgres geo extension ("postgis" or "earthdistance"), updated to the one actually enabled
+	DefaultSearchRadiusKm float64 // Radius used by "near me" searches when the client does not send one
+
+	// Scheduling Configuration
+	EventBufferBefore   time.Duration // Setup time blocked before every event when checking for double bookings
+	EventBufferAfter    time.Duration // Teardown time blocked after every event when checking for double bookings
+	DefaultConflictMode string        // "reject" or "warn", used when a request does not choose a conflict mode
+
+	// Reminder Configuration
+	ReminderOffsets []time.Duration // How long before an event starts each reminder email goes out
+
+	// Background Job Configuration
+	JobPollInterval time.Duration     // How often each instance looks for due jobs
+	JobLockDuration time.Duration     // How long an instance holds a job before another may take it over
+	JobMaxAttempts  int               // Attempts per run before a failing job waits for its next scheduled run
+	JobRetryBackoff time.Duration     // Wait before a retry, multiplied by the number of failed attempts
+	JobSchedules    map[string]string // Cron schedule per built-in job (e.g. "send_reminders"); an empty schedule disables the job
+
+	// Ticket Configuration
+	TicketSigningKey  ed25519.PrivateKey // Signs ticket codes; check-in apps verify them offline with the public half
+	CheckInStaffRoles []string           // Roles allowed to check guests in at any event, besides its organizer
+	OfflineScanMaxAge time.Duration      // Oldest offline scan a check-in app may still sync
+
+	// Realtime Configuration
+	StreamHeartbeatInterval time.Duration // How often live streams send a keep-alive and re-check for changes made elsewhere
+	StreamRetryInterval     time.Duration // How long browsers wait before reconnecting a dropped live stream
+
+	// Webhook Configuration
+	WebhookTimeout         time.Duration // How long an endpoint has to answer a delivery
+	WebhookMaxAttempts     int           // Attempts per delivery before it is marked as failed
+	WebhookRetryBackoff    time.Duration // Wait before the first retry, doubled after every further failed attempt
+	WebhookMaxRetryBackoff time.Duration // Longest wait between two attempts of a delivery
+	WebhookAllowPrivate    bool          // Whether webhooks may target loopback and private addresses, for local receivers only
+
+	// Order and Payment Configuration
+	OrderHoldDuration      time.Duration // How long tickets of an unpaid order stay reserved
+	MaxTicketsPerOrder     int           // Most attendees a single order may buy tickets for
+	PaymentProvider        string        // Payment provider used for paid orders (e.g. "fake")
+	FakePaymentAutoApprove bool          // Whether the fake provider approves payments right away instead of leaving them pending
+
+	// Public Event Page Configuration
+	RegistrationConfirmationTTL time.Duration // How long the link in a self-registration confirmation email stays valid
+	RegistrationRateLimit       int           // Registrations each client IP may submit per window; 0 disables the limit
+	RegistrationRateWindow      time.Duration // Window the registration rate limit counts over
+	// Organization Configuration
+	OrganizationInvitationTTL time.Duration // How long the link in an organization invitation email stays valid
+	// Superuser Account Configuration
+	EmailChangeTTL     time.Duration // How long the link verifying a new email address stays valid
+	EmailChangeUndoTTL time.Duration // How long the old email address can undo an email change
+
+	// Password Policy Configuration
+	PasswordMinLength     int           // Fewest characters a password may have; never below 8
+	PasswordRequireUpper  bool          // Whether a password needs an uppercase letter
+	PasswordRequireLower  bool          // Whether a password needs a lowercase letter
+	PasswordRequireDigit  bool          // Whether a password needs a digit
+	PasswordRequireSymbol bool          // Whether a password needs a character that is not a letter or digit
+	PasswordHistorySize   int           // How many of the latest passwords, the current one included, cannot be reused; 0 allows reuse
+	PasswordMaxAge        time.Duration // How long a password lasts before it has to be reset; 0 never expires passwords
+	BreachedPasswordsPath string        // Directory of the breached password list, one <SHA-1 prefix>.txt file per hash prefix; empty skips the check
+
+	// Passwordless Login Configuration
+	LoginModes              map[string]string // Login mode per lower-cased role: "password", "passwordless" or "both"; unlisted roles use "password"
+	PasswordlessCodeTTL     time.Duration     // How long an emailed login link and code stay valid
+	PasswordlessMaxAttempts int               // Wrong codes after which a login code stops working
+	PasswordlessRateLimit   int               // Login emails each account, and each client IP, may request per window; 0 disables the limit
+	PasswordlessRateWindow  time.Duration     // Window the passwordless rate limit counts over
+
 	// Security (TLS)
 	EnableTLS   bool   // Flag to enable TLS
 	TLSKeyFile  string // Path to the TLS private key file
@@ -115,6 +189,74 @@ func LoadMainConfiguration(configFile string) error {
 
 	OTPExpiryDuration = viper.GetDuration("otp.access_duration")
 
+	PostgresGeoExtension = viper.GetString("geo.postgres_extension")
+	DefaultSearchRadiusKm = viper.GetFloat64("geo.default_radius_km")
+
+	EventBufferBefore = viper.GetDuration("scheduling.buffer_before")
+	EventBufferAfter = viper.GetDuration("scheduling.buffer_after")
+	DefaultConflictMode = viper.GetString("scheduling.default_conflict_mode")
+
+	ReminderOffsets = nil
+	for _, offset := range viper.GetStringSlice("reminders.offsets") {
+		duration, err := time.ParseDuration(offset)
+		if err != nil || duration <= 0 {
+			return fmt.Errorf("invalid reminder offset %q: must be a positive duration such as \"24h\"", offset)
+		}
+		ReminderOffsets = append(ReminderOffsets, duration)
+	}
+
+	JobPollInterval = viper.GetDuration("jobs.poll_interval")
+	JobLockDuration = viper.GetDuration("jobs.lock_duration")
+	JobMaxAttempts = viper.GetInt("jobs.max_attempts")
+	JobRetryBackoff = viper.GetDuration("jobs.retry_backoff")
+	JobSchedules = viper.GetStringMapString("jobs.schedules")
+
+	signingKey, err := loadTicketSigningKey(viper.GetString("tickets.signing_key"), viper.GetString("tickets.signing_key_path"))
+	if err != nil {
+		return err
+	}
+	TicketSigningKey = signingKey
+	CheckInStaffRoles = viper.GetStringSlice("tickets.staff_roles")
+	OfflineScanMaxAge = viper.GetDuration("tickets.offline_scan_max_age")
+
+	StreamHeartbeatInterval = viper.GetDuration("realtime.heartbeat_interval")
+	StreamRetryInterval = viper.GetDuration("realtime.retry_interval")
+
+	WebhookTimeout = viper.GetDuration("webhooks.timeout")
+	WebhookMaxAttempts = viper.GetInt("webhooks.max_attempts")
+	WebhookRetryBackoff = viper.GetDuration("webhooks.retry_backoff")
+	WebhookMaxRetryBackoff = viper.GetDuration("webhooks.max_retry_backoff")
+	WebhookAllowPrivate = viper.GetBool("webhooks.allow_private_targets")
+
+	OrderHoldDuration = viper.GetDuration("orders.hold_duration")
+	MaxTicketsPerOrder = viper.GetInt("orders.max_tickets_per_order")
+	PaymentProvider = viper.GetString("payments.provider")
+	FakePaymentAutoApprove = viper.GetBool("payments.fake_auto_approve")
+
+	RegistrationConfirmationTTL = viper.GetDuration("public_pages.confirmation_ttl")
+	RegistrationRateLimit = viper.GetInt("public_pages.registration_rate_limit")
+	RegistrationRateWindow = viper.GetDuration("public_pages.registration_rate_window")
+
+	OrganizationInvitationTTL = viper.GetDuration("organizations.invitation_ttl")
+
+	EmailChangeTTL = viper.GetDuration("superusers.email_change_ttl")
+	EmailChangeUndoTTL = viper.GetDuration("superusers.email_change_undo_ttl")
+
+	PasswordMinLength = viper.GetInt("password_policy.min_length")
+	PasswordRequireUpper = viper.GetBool("password_policy.require_upper")
+	PasswordRequireLower = viper.GetBool("password_policy.require_lower")
+	PasswordRequireDigit = viper.GetBool("password_policy.require_digit")
+	PasswordRequireSymbol = viper.GetBool("password_policy.require_symbol")
+	PasswordHistorySize = viper.GetInt("password_policy.history_size")
+	PasswordMaxAge = viper.GetDuration("password_policy.max_age")
+	BreachedPasswordsPath = viper.GetString("password_policy.breached_passwords_path")
+
+	LoginModes = viper.GetStringMapString("passwordless_login.login_modes")
+	PasswordlessCodeTTL = viper.GetDuration("passwordless_login.code_ttl")
+	PasswordlessMaxAttempts = viper.GetInt("passwordless_login.max_attempts")
+	PasswordlessRateLimit = viper.GetInt("passwordless_login.rate_limit")
+	PasswordlessRateWindow = viper.GetDuration("passwordless_login.rate_window")
+
 	StaticPath = viper.GetString("file_path.static")
 	TemplatePath = viper.GetString("file_path.template")
 
@@ -133,3 +275,46 @@ func LoadMainConfiguration(configFile string) error {
 	log.Println("Configuration loaded for database:", DatabaseType)
 	return nil
 }
+
+// loadTicketSigningKey decodes the base64 Ed25519 seed from the config, or else from the key file. Every instance
+// has to sign with the same key for tickets to verify after a restart and on other instances, so outside development
+// a missing key stops startup; in development a key is generated once and kept in the key file.
+func loadTicketSigningKey(encodedSeed, keyPath string) (ed25519.PrivateKey, error) {
+	if encodedSeed == "" && keyPath != "" {
+		contents, err := os.ReadFile(keyPath)
+		switch {
+		case err == nil:
+			encodedSeed = strings.TrimSpace(string(contents))
+		case !os.IsNotExist(err):
+			return nil, fmt.Errorf("failed to read ticket signing key: %w", err)
+		case Environment == "development":
+			return generateTicketSigningKey(keyPath)
+		}
+	}
+	if encodedSeed == "" {
+		return nil, fmt.Errorf("no ticket signing key configured: set tickets.signing_key or put one in tickets.signing_key_path")
+	}
+
+	seed, err := base64.StdEncoding.DecodeString(encodedSeed)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("invalid ticket signing key: must be %d random bytes, base64 encoded", ed25519.SeedSize)
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// generateTicketSigningKey generates a new ticket signing key and stores its seed in keyPath for later starts
+func generateTicketSigningKey(keyPath string) (ed25519.PrivateKey, error) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(keyPath), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create ticket signing key directory: %w", err)
+	}
+	encodedSeed := base64.StdEncoding.EncodeToString(privateKey.Seed())
+	if err := os.WriteFile(keyPath, []byte(encodedSeed+"\n"), 0o600); err != nil {
+		return nil, fmt.Errorf("failed to store ticket signing key: %w", err)
+	}
+	log.Println("Generated a ticket signing key in", keyPath)
+	return privateKey, nil
+}
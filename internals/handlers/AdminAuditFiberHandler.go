@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
+)
+
+// AdminAuditFiberHandler serves the JSON API administrators use to read and check the audit log
+type AdminAuditFiberHandler struct {
+	service services.AuditServiceInterface
+}
+
+func NewAdminAuditFiberHandler(service services.AuditServiceInterface) *AdminAuditFiberHandler {
+	return &AdminAuditFiberHandler{
+		service: service,
+	}
+}
+
+// ListAuditRecordsHandler lists one page of audit records, latest first, filtered by actor, action, target and time
+func (h *AdminAuditFiberHandler) ListAuditRecordsHandler(c *fiber.Ctx) error {
+	var query utils.AuditRecordQuery
+	if err := c.QueryParser(&query); err != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Invalid query parameters", nil, err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+	if validationErr := validators.ValidateAuditRecordQuery(query); validationErr != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+
+	list, err := h.service.ListAuditRecordsService(c.Context(), &query)
+	if err != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusInternalServerError, "Failed to list audit records", nil, err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(response)
+	}
+
+	response := responses.NewFiberResponse(c, fiber.StatusOK, "Audit records retrieved successfully", list, nil)
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// VerifyAuditChainHandler checks the hash chain of the whole audit log; a broken chain is reported, not an error
+func (h *AdminAuditFiberHandler) VerifyAuditChainHandler(c *fiber.Ctx) error {
+	report, err := h.service.VerifyAuditChainService(c.Context())
+	if err != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusInternalServerError, "Failed to verify audit log", nil, err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(response)
+	}
+
+	message := "Audit log is intact"
+	if !report.Valid {
+		message = "Audit log has been tampered with"
+	}
+	response := responses.NewFiberResponse(c, fiber.StatusOK, message, report, nil)
+	return c.Status(fiber.StatusOK).JSON(response)
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
+)
+
+// AdminAuditGinHandler serves the JSON API administrators use to read and check the audit log
+type AdminAuditGinHandler struct {
+	service services.AuditServiceInterface
+}
+
+func NewAdminAuditGinHandler(service services.AuditServiceInterface) *AdminAuditGinHandler {
+	return &AdminAuditGinHandler{
+		service: service,
+	}
+}
+
+// ListAuditRecordsHandler lists one page of audit records, latest first, filtered by actor, action, target and time
+func (h *AdminAuditGinHandler) ListAuditRecordsHandler(c *gin.Context) {
+	var query utils.AuditRecordQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid query parameters", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+	if validationErr := validators.ValidateAuditRecordQuery(query); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	list, err := h.service.ListAuditRecordsService(c.Request.Context(), &query)
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to list audit records", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Audit records retrieved successfully", list, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// VerifyAuditChainHandler checks the hash chain of the whole audit log; a broken chain is reported, not an error
+func (h *AdminAuditGinHandler) VerifyAuditChainHandler(c *gin.Context) {
+	report, err := h.service.VerifyAuditChainService(c.Request.Context())
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to verify audit log", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	message := "Audit log is intact"
+	if !report.Valid {
+		message = "Audit log has been tampered with"
+	}
+	response := responses.NewGinResponse(c, http.StatusOK, message, report, nil)
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,360 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
+)
+
+// adminConsoleRoles are the roles that may use the admin console
+var adminConsoleRoles = []string{"SuperUser", "Admin"}
+
+// AdminConsoleGinHandler serves the server-rendered admin console. Every page is also available as JSON,
+// and every action goes through the same services the JSON API uses.
+type AdminConsoleGinHandler struct {
+	superUserService services.SuperUserServiceInterface
+	eventService     services.EventServiceInterface
+	guestService     services.GuestServiceInterface
+	auditService     services.AuditServiceInterface
+}
+
+func NewAdminConsoleGinHandler(
+	superUserService services.SuperUserServiceInterface,
+	eventService services.EventServiceInterface,
+	guestService services.GuestServiceInterface,
+	auditService services.AuditServiceInterface,
+) *AdminConsoleGinHandler {
+	return &AdminConsoleGinHandler{
+		superUserService: superUserService,
+		eventService:     eventService,
+		guestService:     guestService,
+		auditService:     auditService,
+	}
+}
+
+// LogInPageHandler shows the login form of the console
+func (h *AdminConsoleGinHandler) LogInPageHandler(c *gin.Context) {
+	responses.RespondGin(c, "admin/login", responses.NewGinResponse(c, http.StatusOK, "Log in to the admin console", nil, nil))
+}
+
+// LogInHandler logs an administrator in from the console login form and opens the console
+func (h *AdminConsoleGinHandler) LogInHandler(c *gin.Context) {
+	var logInRequest utils.AdminLogInRequest
+	if err := c.ShouldBind(&logInRequest); err != nil {
+		responses.RespondGin(c, "admin/login", responses.NewGinResponse(c, http.StatusBadRequest, "Invalid input", nil, err.Error()))
+		return
+	}
+
+	loggedInSuperUser, err := h.superUserService.LogInSuperuser(c.Request.Context(), utils.TransformToLogInSuperuserRequest(logInRequest))
+	if err != nil {
+		switch {
+		case newerrors.IsValidationError(err):
+			responses.RespondGin(c, "admin/login", responses.NewGinResponse(c, http.StatusUnauthorized, "Login failed", nil, "Invalid email/username or password"))
+		case newerrors.IsForbiddenError(err):
+			responses.RespondGin(c, "admin/login", responses.NewGinResponse(c, http.StatusForbidden, "Login failed", nil, err.Error()))
+		default:
+			responses.RespondGin(c, "admin/login", responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to log in", nil, err.Error()))
+		}
+		return
+	}
+	if !isAdminConsoleRole(loggedInSuperUser.Role) {
+		responses.RespondGin(c, "admin/login", responses.NewGinResponse(c, http.StatusForbidden, "Login failed", nil, "You do not have permission to use the admin console"))
+		return
+	}
+
+	setAuthTokenGinCookie(c, loggedInSuperUser.Role, loggedInSuperUser.Token)
+	redirectGin(c, "/admin/superusers")
+}
+
+// LogOutHandler clears the auth cookie of the console user and returns to the login form
+func (h *AdminConsoleGinHandler) LogOutHandler(c *gin.Context) {
+	// The cookie is named after the role the client logged in with, which the role check may have replaced
+	for _, role := range configs.AllowedRoles {
+		c.SetCookie(role+"|_|"+configs.TokenBaseCookieName, "", -1, "/", "", configs.SecureCookieHTTPS, true)
+	}
+	redirectGin(c, "/admin/login")
+}
+
+// ListSuperUsersHandler lists, searches and filters superusers
+func (h *AdminConsoleGinHandler) ListSuperUsersHandler(c *gin.Context) {
+	var query utils.SuperUserListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		responses.RespondGinError(c, http.StatusBadRequest, "Invalid query parameters", err.Error())
+		return
+	}
+	if err := validators.ValidateSuperUserListQuery(query); err != nil {
+		responses.RespondGinError(c, http.StatusBadRequest, "Validation error", err.Error())
+		return
+	}
+
+	list, err := h.superUserService.ListSuperUsers(c.Request.Context(), &query)
+	if err != nil {
+		responses.RespondGinError(c, http.StatusInternalServerError, "Failed to list superusers", err.Error())
+		return
+	}
+	list.Roles = configs.AllowedRoles
+	responses.RespondGin(c, "admin/superusers", responses.NewGinResponse(c, http.StatusOK, "Superusers retrieved successfully", list, nil))
+}
+
+// GetSuperUserHandler shows a superuser with the forms that manage their account
+func (h *AdminConsoleGinHandler) GetSuperUserHandler(c *gin.Context) {
+	superUserID, ok := h.superUserIDParam(c)
+	if !ok {
+		return
+	}
+
+	superUser, err := h.superUserService.FindSuperUserByID(c.Request.Context(), superUserID)
+	if err != nil {
+		respondAdminConsoleError(c, err, "Failed to retrieve superuser")
+		return
+	}
+	respondSuperUserPage(c, http.StatusOK, "Superuser retrieved successfully", superUser)
+}
+
+// SetSuperUserActiveHandler activates or deactivates a superuser account
+func (h *AdminConsoleGinHandler) SetSuperUserActiveHandler(c *gin.Context) {
+	actorID, superUserID, ok := h.actorAndSuperUserIDs(c)
+	if !ok {
+		return
+	}
+	var activeRequest utils.SetSuperUserActiveRequest
+	if err := c.ShouldBind(&activeRequest); err != nil {
+		responses.RespondGinError(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	superUser, err := h.superUserService.SetSuperUserActive(c.Request.Context(), actorID, superUserID, *activeRequest.Active)
+	if err != nil {
+		respondAdminConsoleError(c, err, "Failed to update superuser")
+		return
+	}
+	message := "Superuser deactivated"
+	if superUser.IsActive {
+		message = "Superuser activated"
+	}
+	respondSuperUserPage(c, http.StatusOK, message, superUser)
+}
+
+// ChangeSuperUserRoleHandler changes the role and permission groups of a superuser
+func (h *AdminConsoleGinHandler) ChangeSuperUserRoleHandler(c *gin.Context) {
+	actorID, superUserID, ok := h.actorAndSuperUserIDs(c)
+	if !ok {
+		return
+	}
+	var roleRequest utils.ChangeSuperUserRoleRequest
+	if err := c.ShouldBind(&roleRequest); err != nil {
+		responses.RespondGinError(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	permissionGroups := utils.NormalizePermissionGroups(roleRequest.PermissionGroups)
+	if err := validators.ValidatePermissionGroups(permissionGroups); err != nil {
+		responses.RespondGinError(c, http.StatusBadRequest, "Validation error", err.Error())
+		return
+	}
+
+	superUser, err := h.superUserService.ChangeSuperUserRole(c.Request.Context(), actorID, superUserID, roleRequest.Role, permissionGroups)
+	if err != nil {
+		respondAdminConsoleError(c, err, "Failed to update superuser")
+		return
+	}
+	respondSuperUserPage(c, http.StatusOK, "Role and permission groups updated", superUser)
+}
+
+// ResendVerificationEmailHandler sends a superuser who has not verified their account a new verification email
+func (h *AdminConsoleGinHandler) ResendVerificationEmailHandler(c *gin.Context) {
+	actorID, superUserID, ok := h.actorAndSuperUserIDs(c)
+	if !ok {
+		return
+	}
+
+	if err := h.superUserService.ResendVerificationEmail(c.Request.Context(), actorID, superUserID); err != nil {
+		respondAdminConsoleError(c, err, "Failed to resend verification email")
+		return
+	}
+	superUser, err := h.superUserService.FindSuperUserByID(c.Request.Context(), superUserID)
+	if err != nil {
+		respondAdminConsoleError(c, err, "Failed to retrieve superuser")
+		return
+	}
+	respondSuperUserPage(c, http.StatusOK, "Verification email sent", superUser)
+}
+
+// ForcePasswordResetHandler emails a superuser a password reset link and blocks their login until they use it
+func (h *AdminConsoleGinHandler) ForcePasswordResetHandler(c *gin.Context) {
+	actorID, superUserID, ok := h.actorAndSuperUserIDs(c)
+	if !ok {
+		return
+	}
+
+	if err := h.superUserService.ForcePasswordReset(c.Request.Context(), actorID, superUserID); err != nil {
+		respondAdminConsoleError(c, err, "Failed to force a password reset")
+		return
+	}
+	superUser, err := h.superUserService.FindSuperUserByID(c.Request.Context(), superUserID)
+	if err != nil {
+		respondAdminConsoleError(c, err, "Failed to retrieve superuser")
+		return
+	}
+	respondSuperUserPage(c, http.StatusOK, "Password reset email sent", superUser)
+}
+
+// DeleteSuperUserHandler soft-deletes a superuser and shows the account as deleted
+func (h *AdminConsoleGinHandler) DeleteSuperUserHandler(c *gin.Context) {
+	actorID, superUserID, ok := h.actorAndSuperUserIDs(c)
+	if !ok {
+		return
+	}
+
+	if err := h.superUserService.DeleteSuperUser(c.Request.Context(), actorID, superUserID); err != nil {
+		respondAdminConsoleError(c, err, "Failed to delete superuser")
+		return
+	}
+	superUser, err := h.superUserService.FindSuperUserByID(c.Request.Context(), superUserID)
+	if err != nil {
+		respondAdminConsoleError(c, err, "Failed to retrieve superuser")
+		return
+	}
+	respondSuperUserPage(c, http.StatusOK, "Superuser deleted", superUser)
+}
+
+// ListEventsHandler lists every event, drafts included, optionally searched by title
+func (h *AdminConsoleGinHandler) ListEventsHandler(c *gin.Context) {
+	var query utils.AdminEventSearchQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		responses.RespondGinError(c, http.StatusBadRequest, "Invalid query parameters", err.Error())
+		return
+	}
+
+	var events []*types.EventType
+	var err error
+	if query.Search != "" {
+		events, err = h.eventService.SearchEventsByTitleService(c.Request.Context(), query.Search)
+	} else {
+		events, err = h.eventService.FindAllEventsService(c.Request.Context())
+	}
+	if err != nil {
+		responses.RespondGinError(c, http.StatusInternalServerError, "Failed to list events", err.Error())
+		return
+	}
+
+	page := &utils.AdminEventListPage{Events: events, Search: query.Search}
+	responses.RespondGin(c, "admin/events", responses.NewGinResponse(c, http.StatusOK, "Events retrieved successfully", page, nil))
+}
+
+// GetEventGuestsHandler shows an event with its guest list
+func (h *AdminConsoleGinHandler) GetEventGuestsHandler(c *gin.Context) {
+	eventID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		responses.RespondGinError(c, http.StatusBadRequest, "Invalid id", err.Error())
+		return
+	}
+
+	event, err := h.eventService.FindEventByIDService(c.Request.Context(), eventID)
+	if err != nil {
+		respondAdminConsoleError(c, err, "Failed to retrieve event")
+		return
+	}
+	guests, err := h.guestService.FindEventGuestsService(c.Request.Context(), eventID)
+	if err != nil {
+		respondAdminConsoleError(c, err, "Failed to retrieve guests")
+		return
+	}
+
+	page := &utils.AdminEventPage{Event: event, Guests: guests}
+	responses.RespondGin(c, "admin/event", responses.NewGinResponse(c, http.StatusOK, "Event retrieved successfully", page, nil))
+}
+
+// ListAuditRecordsHandler lists one page of the audit log, latest first, filtered by actor, action, target and time
+func (h *AdminConsoleGinHandler) ListAuditRecordsHandler(c *gin.Context) {
+	var query utils.AuditRecordQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		responses.RespondGinError(c, http.StatusBadRequest, "Invalid query parameters", err.Error())
+		return
+	}
+	if validationErr := validators.ValidateAuditRecordQuery(query); validationErr != nil {
+		responses.RespondGinError(c, http.StatusBadRequest, "Validation error", validationErr.Error())
+		return
+	}
+
+	list, err := h.auditService.ListAuditRecordsService(c.Request.Context(), &query)
+	if err != nil {
+		responses.RespondGinError(c, http.StatusInternalServerError, "Failed to list audit records", err.Error())
+		return
+	}
+	responses.RespondGin(c, "admin/audit", responses.NewGinResponse(c, http.StatusOK, "Audit records retrieved successfully", list, nil))
+}
+
+// superUserIDParam parses the superuser ID of the path, writing the error page when it is invalid
+func (h *AdminConsoleGinHandler) superUserIDParam(c *gin.Context) (uuid.UUID, bool) {
+	superUserID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		responses.RespondGinError(c, http.StatusBadRequest, "Invalid id", err.Error())
+		return uuid.Nil, false
+	}
+	return superUserID, true
+}
+
+// actorAndSuperUserIDs returns the ID of the administrator acting and of the superuser they act on
+func (h *AdminConsoleGinHandler) actorAndSuperUserIDs(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	value, _ := c.Get("userID")
+	actorID, ok := value.(uuid.UUID)
+	if !ok {
+		responses.RespondGinError(c, http.StatusUnauthorized, "Unauthorized", "User ID not found in context")
+		return uuid.Nil, uuid.Nil, false
+	}
+	superUserID, ok := h.superUserIDParam(c)
+	return actorID, superUserID, ok
+}
+
+// respondSuperUserPage writes the page of a superuser; after an action the page shows the message as its outcome
+func respondSuperUserPage(c *gin.Context, status int, message string, superUser *types.SuperUserType) {
+	page := &utils.SuperUserAdminPage{
+		SuperUser:    utils.TransformToSuperUserAdminResponse(superUser),
+		AllowedRoles: configs.AllowedRoles,
+	}
+	if c.Request.Method != http.MethodGet {
+		page.Notice = message
+	}
+	responses.RespondGin(c, "admin/superuser", responses.NewGinResponse(c, status, message, page, nil))
+}
+
+// respondAdminConsoleError writes the error page matching a service error
+func respondAdminConsoleError(c *gin.Context, err error, message string) {
+	switch {
+	case newerrors.IsValidationError(err):
+		responses.RespondGinError(c, http.StatusBadRequest, "Validation error", err.Error())
+	case newerrors.IsForbiddenError(err):
+		responses.RespondGinError(c, http.StatusForbidden, "Forbidden", err.Error())
+	default:
+		responses.RespondGinError(c, http.StatusInternalServerError, message, err.Error())
+	}
+}
+
+// isAdminConsoleRole reports whether a role may use the admin console
+func isAdminConsoleRole(role string) bool {
+	for _, consoleRole := range adminConsoleRoles {
+		if role == consoleRole {
+			return true
+		}
+	}
+	return false
+}
+
+// redirectGin sends the client to location after a form post; HTMX follows the HX-Redirect header instead,
+// so the whole page is replaced rather than swapped into the target
+func redirectGin(c *gin.Context, location string) {
+	if c.GetHeader("HX-Request") == "true" {
+		c.Header("HX-Redirect", location)
+		c.Status(http.StatusNoContent)
+		return
+	}
+	c.Redirect(http.StatusSeeOther, location)
+}
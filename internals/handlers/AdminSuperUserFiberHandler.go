@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
+)
+
+// AdminSuperUserFiberHandler serves the JSON API administrators use to manage superuser accounts
+type AdminSuperUserFiberHandler struct {
+	service services.SuperUserServiceInterface
+}
+
+func NewAdminSuperUserFiberHandler(service services.SuperUserServiceInterface) *AdminSuperUserFiberHandler {
+	return &AdminSuperUserFiberHandler{
+		service: service,
+	}
+}
+
+// ListSuperUsersHandler lists one page of superusers, searched and filtered by role, verification and activity
+func (h *AdminSuperUserFiberHandler) ListSuperUsersHandler(c *fiber.Ctx) error {
+	var query utils.SuperUserListQuery
+	if err := c.QueryParser(&query); err != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Invalid query parameters", nil, err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+	if validationErr := validators.ValidateSuperUserListQuery(query); validationErr != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+
+	list, err := h.service.ListSuperUsers(c.Context(), &query)
+	if err != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusInternalServerError, "Failed to list superusers", nil, err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(response)
+	}
+
+	response := responses.NewFiberResponse(c, fiber.StatusOK, "Superusers retrieved successfully", list, nil)
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// GetSuperUserHandler returns a superuser, deleted ones included
+func (h *AdminSuperUserFiberHandler) GetSuperUserHandler(c *fiber.Ctx) error {
+	superUserID, ok := uuidParamFromFiberContext(c, "id")
+	if !ok {
+		return nil
+	}
+
+	superUser, err := h.service.FindSuperUserByID(c.Context(), superUserID)
+	if err != nil {
+		return respondAdminSuperUserFiberError(c, err, "Failed to retrieve superuser")
+	}
+
+	response := responses.NewFiberResponse(c, fiber.StatusOK, "Superuser retrieved successfully", utils.TransformToSuperUserAdminResponse(superUser), nil)
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// SetSuperUserActiveHandler deactivates or reactivates a superuser account
+func (h *AdminSuperUserFiberHandler) SetSuperUserActiveHandler(c *fiber.Ctx) error {
+	actorID, ok := userIDFromFiberContext(c)
+	if !ok {
+		return nil
+	}
+	superUserID, ok := uuidParamFromFiberContext(c, "id")
+	if !ok {
+		return nil
+	}
+
+	var activeRequest utils.SetSuperUserActiveRequest
+	if err := c.BodyParser(&activeRequest); err != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Invalid request body", nil, err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+	// BodyParser does not enforce binding tags
+	if activeRequest.Active == nil {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Invalid request body", nil, "active is required")
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+
+	superUser, err := h.service.SetSuperUserActive(c.Context(), actorID, superUserID, *activeRequest.Active)
+	if err != nil {
+		return respondAdminSuperUserFiberError(c, err, "Failed to update superuser")
+	}
+
+	response := responses.NewFiberResponse(c, fiber.StatusOK, "Superuser updated successfully", utils.TransformToSuperUserAdminResponse(superUser), nil)
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// ChangeSuperUserRoleHandler changes the role and permission groups of a superuser
+func (h *AdminSuperUserFiberHandler) ChangeSuperUserRoleHandler(c *fiber.Ctx) error {
+	actorID, ok := userIDFromFiberContext(c)
+	if !ok {
+		return nil
+	}
+	superUserID, ok := uuidParamFromFiberContext(c, "id")
+	if !ok {
+		return nil
+	}
+
+	var roleRequest utils.ChangeSuperUserRoleRequest
+	if err := c.BodyParser(&roleRequest); err != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Invalid request body", nil, err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+	permissionGroups := utils.NormalizePermissionGroups(roleRequest.PermissionGroups)
+	if validationErr := validators.ValidatePermissionGroups(permissionGroups); validationErr != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+
+	superUser, err := h.service.ChangeSuperUserRole(c.Context(), actorID, superUserID, roleRequest.Role, permissionGroups)
+	if err != nil {
+		return respondAdminSuperUserFiberError(c, err, "Failed to update superuser")
+	}
+
+	response := responses.NewFiberResponse(c, fiber.StatusOK, "Superuser updated successfully", utils.TransformToSuperUserAdminResponse(superUser), nil)
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// ForcePasswordResetHandler emails a superuser a password reset link and blocks their login until they use it
+func (h *AdminSuperUserFiberHandler) ForcePasswordResetHandler(c *fiber.Ctx) error {
+	actorID, ok := userIDFromFiberContext(c)
+	if !ok {
+		return nil
+	}
+	superUserID, ok := uuidParamFromFiberContext(c, "id")
+	if !ok {
+		return nil
+	}
+
+	if err := h.service.ForcePasswordReset(c.Context(), actorID, superUserID); err != nil {
+		return respondAdminSuperUserFiberError(c, err, "Failed to force a password reset")
+	}
+
+	response := responses.NewFiberResponse(c, fiber.StatusOK, "Password reset email sent", nil, nil)
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// ResendVerificationEmailHandler sends a superuser who has not verified their account a new verification email
+func (h *AdminSuperUserFiberHandler) ResendVerificationEmailHandler(c *fiber.Ctx) error {
+	actorID, ok := userIDFromFiberContext(c)
+	if !ok {
+		return nil
+	}
+	superUserID, ok := uuidParamFromFiberContext(c, "id")
+	if !ok {
+		return nil
+	}
+
+	if err := h.service.ResendVerificationEmail(c.Context(), actorID, superUserID); err != nil {
+		return respondAdminSuperUserFiberError(c, err, "Failed to resend verification email")
+	}
+
+	response := responses.NewFiberResponse(c, fiber.StatusOK, "Verification email sent", nil, nil)
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// DeleteSuperUserHandler soft-deletes a superuser account
+func (h *AdminSuperUserFiberHandler) DeleteSuperUserHandler(c *fiber.Ctx) error {
+	actorID, ok := userIDFromFiberContext(c)
+	if !ok {
+		return nil
+	}
+	superUserID, ok := uuidParamFromFiberContext(c, "id")
+	if !ok {
+		return nil
+	}
+
+	if err := h.service.DeleteSuperUser(c.Context(), actorID, superUserID); err != nil {
+		return respondAdminSuperUserFiberError(c, err, "Failed to delete superuser")
+	}
+
+	response := responses.NewFiberResponse(c, fiber.StatusOK, "Superuser deleted successfully", nil, nil)
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// respondAdminSuperUserFiberError writes the response matching an error of a superuser management action
+func respondAdminSuperUserFiberError(c *fiber.Ctx, err error, message string) error {
+	switch {
+	case newerrors.IsForbiddenError(err):
+		response := responses.NewFiberResponse(c, fiber.StatusForbidden, "Forbidden", nil, err.Error())
+		return c.Status(fiber.StatusForbidden).JSON(response)
+	case newerrors.IsValidationError(err):
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Validation error", nil, err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	default:
+		response := responses.NewFiberResponse(c, fiber.StatusInternalServerError, message, nil, err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(response)
+	}
+}
@@ -0,0 +1,202 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
+)
+
+// AdminSuperUserGinHandler serves the JSON API administrators use to manage superuser accounts
+type AdminSuperUserGinHandler struct {
+	service services.SuperUserServiceInterface
+}
+
+func NewAdminSuperUserGinHandler(service services.SuperUserServiceInterface) *AdminSuperUserGinHandler {
+	return &AdminSuperUserGinHandler{
+		service: service,
+	}
+}
+
+// ListSuperUsersHandler lists one page of superusers, searched and filtered by role, verification and activity
+func (h *AdminSuperUserGinHandler) ListSuperUsersHandler(c *gin.Context) {
+	var query utils.SuperUserListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid query parameters", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+	if validationErr := validators.ValidateSuperUserListQuery(query); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	list, err := h.service.ListSuperUsers(c.Request.Context(), &query)
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to list superusers", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Superusers retrieved successfully", list, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetSuperUserHandler returns a superuser, deleted ones included
+func (h *AdminSuperUserGinHandler) GetSuperUserHandler(c *gin.Context) {
+	superUserID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	superUser, err := h.service.FindSuperUserByID(c.Request.Context(), superUserID)
+	if err != nil {
+		respondAdminSuperUserGinError(c, err, "Failed to retrieve superuser")
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Superuser retrieved successfully", utils.TransformToSuperUserAdminResponse(superUser), nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// SetSuperUserActiveHandler deactivates or reactivates a superuser account
+func (h *AdminSuperUserGinHandler) SetSuperUserActiveHandler(c *gin.Context) {
+	actorID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	superUserID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var activeRequest utils.SetSuperUserActiveRequest
+	if err := c.ShouldBindJSON(&activeRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	superUser, err := h.service.SetSuperUserActive(c.Request.Context(), actorID, superUserID, *activeRequest.Active)
+	if err != nil {
+		respondAdminSuperUserGinError(c, err, "Failed to update superuser")
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Superuser updated successfully", utils.TransformToSuperUserAdminResponse(superUser), nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// ChangeSuperUserRoleHandler changes the role and permission groups of a superuser
+func (h *AdminSuperUserGinHandler) ChangeSuperUserRoleHandler(c *gin.Context) {
+	actorID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	superUserID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var roleRequest utils.ChangeSuperUserRoleRequest
+	if err := c.ShouldBindJSON(&roleRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+	permissionGroups := utils.NormalizePermissionGroups(roleRequest.PermissionGroups)
+	if validationErr := validators.ValidatePermissionGroups(permissionGroups); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	superUser, err := h.service.ChangeSuperUserRole(c.Request.Context(), actorID, superUserID, roleRequest.Role, permissionGroups)
+	if err != nil {
+		respondAdminSuperUserGinError(c, err, "Failed to update superuser")
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Superuser updated successfully", utils.TransformToSuperUserAdminResponse(superUser), nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// ForcePasswordResetHandler emails a superuser a password reset link and blocks their login until they use it
+func (h *AdminSuperUserGinHandler) ForcePasswordResetHandler(c *gin.Context) {
+	actorID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	superUserID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.service.ForcePasswordReset(c.Request.Context(), actorID, superUserID); err != nil {
+		respondAdminSuperUserGinError(c, err, "Failed to force a password reset")
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Password reset email sent", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// ResendVerificationEmailHandler sends a superuser who has not verified their account a new verification email
+func (h *AdminSuperUserGinHandler) ResendVerificationEmailHandler(c *gin.Context) {
+	actorID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	superUserID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.service.ResendVerificationEmail(c.Request.Context(), actorID, superUserID); err != nil {
+		respondAdminSuperUserGinError(c, err, "Failed to resend verification email")
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Verification email sent", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteSuperUserHandler soft-deletes a superuser account
+func (h *AdminSuperUserGinHandler) DeleteSuperUserHandler(c *gin.Context) {
+	actorID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	superUserID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteSuperUser(c.Request.Context(), actorID, superUserID); err != nil {
+		respondAdminSuperUserGinError(c, err, "Failed to delete superuser")
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Superuser deleted successfully", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// respondAdminSuperUserGinError writes the response matching an error of a superuser management action
+func respondAdminSuperUserGinError(c *gin.Context, err error, message string) {
+	switch {
+	case newerrors.IsForbiddenError(err):
+		response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+		c.JSON(http.StatusForbidden, response)
+	case newerrors.IsValidationError(err):
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+	default:
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, message, nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+	}
+}
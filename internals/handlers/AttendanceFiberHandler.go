@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+type AttendanceFiberHandler struct {
+	service services.AttendanceServiceInterface
+}
+
+func NewAttendanceFiberHandler(service services.AttendanceServiceInterface) *AttendanceFiberHandler {
+	return &AttendanceFiberHandler{
+		service: service,
+	}
+}
+
+// GetAttendanceHandler returns the current RSVP, check-in and waitlist numbers of an event
+func (h *AttendanceFiberHandler) GetAttendanceHandler(c *fiber.Ctx) error {
+	userID, ok := userIDFromFiberContext(c)
+	if !ok {
+		return nil
+	}
+	eventID, ok := uuidParamFromFiberContext(c, "id")
+	if !ok {
+		return nil
+	}
+
+	stats, err := h.service.AttendanceStatsService(c.Context(), userID, eventID)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewFiberResponse(c, fiber.StatusForbidden, "Forbidden", nil, err.Error())
+			return c.Status(fiber.StatusForbidden).JSON(response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Validation error", nil, err.Error())
+			return c.Status(fiber.StatusBadRequest).JSON(response)
+		default:
+			response := responses.NewFiberResponse(c, fiber.StatusInternalServerError, "Failed to count attendance", nil, err.Error())
+			return c.Status(fiber.StatusInternalServerError).JSON(response)
+		}
+	}
+
+	response := responses.NewFiberResponse(c, fiber.StatusOK, "Attendance retrieved successfully", stats, nil)
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// StreamAttendanceHandler streams the attendance numbers of an event as server-sent events while they change
+func (h *AttendanceFiberHandler) StreamAttendanceHandler(c *fiber.Ctx) error {
+	userID, ok := userIDFromFiberContext(c)
+	if !ok {
+		return nil
+	}
+	eventID, ok := uuidParamFromFiberContext(c, "id")
+	if !ok {
+		return nil
+	}
+
+	// fasthttp does not cancel anything when the client goes away; the stream ends on the first failed flush instead
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Subscribe before counting, so no change slips in between
+	changes, err := h.service.WatchAttendanceService(ctx, userID, eventID)
+	var stats *utils.AttendanceStats
+	if err == nil {
+		stats, err = h.service.AttendanceStatsService(ctx, userID, eventID)
+	}
+	if err != nil {
+		cancel()
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewFiberResponse(c, fiber.StatusForbidden, "Forbidden", nil, err.Error())
+			return c.Status(fiber.StatusForbidden).JSON(response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Validation error", nil, err.Error())
+			return c.Status(fiber.StatusBadRequest).JSON(response)
+		default:
+			response := responses.NewFiberResponse(c, fiber.StatusInternalServerError, "Failed to follow attendance", nil, err.Error())
+			return c.Status(fiber.StatusInternalServerError).JSON(response)
+		}
+	}
+
+	for name, value := range sseHeaders {
+		c.Set(name, value)
+	}
+	lastEventID := c.Get("Last-Event-ID") // The context is recycled once the handler returns
+	c.Status(fiber.StatusOK).Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer cancel()
+		if err := streamAttendance(ctx, w, w.Flush, h.service, userID, eventID, stats, changes, lastEventID); err != nil {
+			log.Printf("Attendance stream of event %s ended: %v", eventID, err)
+		}
+	})
+	return nil
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+type AttendanceGinHandler struct {
+	service services.AttendanceServiceInterface
+}
+
+func NewAttendanceGinHandler(service services.AttendanceServiceInterface) *AttendanceGinHandler {
+	return &AttendanceGinHandler{
+		service: service,
+	}
+}
+
+// GetAttendanceHandler returns the current RSVP, check-in and waitlist numbers of an event
+func (h *AttendanceGinHandler) GetAttendanceHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	stats, err := h.service.AttendanceStatsService(c.Request.Context(), userID, eventID)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to count attendance", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Attendance retrieved successfully", stats, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// StreamAttendanceHandler streams the attendance numbers of an event as server-sent events while they change
+func (h *AttendanceGinHandler) StreamAttendanceHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	// Subscribe before counting, so no change slips in between
+	ctx := c.Request.Context()
+	changes, err := h.service.WatchAttendanceService(ctx, userID, eventID)
+	var stats *utils.AttendanceStats
+	if err == nil {
+		stats, err = h.service.AttendanceStatsService(ctx, userID, eventID)
+	}
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to follow attendance", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	for name, value := range sseHeaders {
+		c.Header(name, value)
+	}
+	c.Status(http.StatusOK)
+
+	flush := func() error {
+		c.Writer.Flush()
+		return nil
+	}
+	if err := streamAttendance(ctx, c.Writer, flush, h.service, userID, eventID, stats, changes, c.GetHeader("Last-Event-ID")); err != nil {
+		log.Printf("Attendance stream of event %s ended: %v", eventID, err)
+	}
+}
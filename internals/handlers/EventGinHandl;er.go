@@ -1,11 +1,13 @@
 package handlers
 
 import (
+	"fmt"
 	"log"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
 	"github.com/lordofthemind/EventureGo/internals/responses"
 	"github.com/lordofthemind/EventureGo/internals/services"
 	"github.com/lordofthemind/EventureGo/internals/utils"
@@ -61,8 +63,18 @@ func (h *EventGinHandler) CreateEventHandler(c *gin.Context) {
 	eventDTO := utils.TransformToEventDTO(eventRequest)
 
 	// Call the service to create the event
-	createdEvent, err := h.service.CreateEventService(c.Request.Context(), userID, eventDTO)
+	createdEvent, conflicts, err := h.service.CreateEventService(c.Request.Context(), userID, eventDTO)
 	if err != nil {
+		if newerrors.IsConflictError(err) {
+			response := responses.NewGinResponse(c, http.StatusConflict, "Scheduling conflict", conflicts, err.Error())
+			c.JSON(http.StatusConflict, response)
+			return
+		}
+		if newerrors.IsValidationError(err) {
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+			return
+		}
 		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to create event", nil, err.Error())
 		c.JSON(http.StatusInternalServerError, response)
 		return
@@ -71,7 +83,305 @@ func (h *EventGinHandler) CreateEventHandler(c *gin.Context) {
 	// Transform createdEvent to RegisterEventResponse and return it
 	responseData := utils.TransformToRegisterEventResponse(createdEvent)
 
+	// In "warn" mode the event is saved and the clashes are reported alongside it
+	message := "Event created successfully"
+	if len(conflicts) > 0 {
+		responseData.Conflicts = conflicts
+		message = "Event created with scheduling conflicts"
+	}
+
 	// Return the standardized response
-	response := responses.NewGinResponse(c, http.StatusOK, "Event created successfully", responseData, nil)
+	response := responses.NewGinResponse(c, http.StatusOK, message, responseData, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// RescheduleEventHandler moves an event to a new time slot, rejecting or warning about double bookings
+func (h *EventGinHandler) RescheduleEventHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var rescheduleRequest utils.RescheduleEventRequest
+	if err := c.ShouldBindJSON(&rescheduleRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if validationErr := validators.ValidateRescheduleEventRequest(rescheduleRequest); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	event, conflicts, err := h.service.RescheduleEventService(c.Request.Context(), userID, eventID, utils.TransformToRescheduleEventDTO(rescheduleRequest))
+	if err != nil {
+		switch {
+		case newerrors.IsConflictError(err):
+			response := responses.NewGinResponse(c, http.StatusConflict, "Scheduling conflict", conflicts, err.Error())
+			c.JSON(http.StatusConflict, response)
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to reschedule event", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	responseData := utils.TransformToRegisterEventResponse(event)
+	message := "Event rescheduled successfully"
+	if len(conflicts) > 0 {
+		responseData.Conflicts = conflicts
+		message = "Event rescheduled with scheduling conflicts"
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, message, responseData, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// FindEventsNearHandler returns events held within a radius of the given coordinates, e.g. "events near me"
+func (h *EventGinHandler) FindEventsNearHandler(c *gin.Context) {
+	var query utils.GeoRadiusQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid query parameters", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if validationErr := validators.ValidateGeoRadiusQuery(query); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	events, err := h.service.FindEventsNearService(c.Request.Context(), *query.Latitude, *query.Longitude, query.RadiusKm)
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to search events", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Events retrieved successfully", events, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// FindEventsWithinBoundsHandler returns events held at venues inside a bounding box
+func (h *EventGinHandler) FindEventsWithinBoundsHandler(c *gin.Context) {
+	var query utils.GeoBoundingBoxQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid query parameters", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if validationErr := validators.ValidateGeoBoundingBoxQuery(query); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	events, err := h.service.FindEventsWithinBoundingBoxService(c.Request.Context(), *query.MinLatitude, *query.MinLongitude, *query.MaxLatitude, *query.MaxLongitude)
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to search events", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Events retrieved successfully", events, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetEventHandler returns a single event with its times rendered in the event's timezone
+func (h *EventGinHandler) GetEventHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	event, err := h.service.FindEventForViewerService(c.Request.Context(), userID, eventID)
+	if err != nil {
+		responses.RespondGinError(c, http.StatusNotFound, "Event not found", err.Error())
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Event retrieved successfully", utils.TransformToRegisterEventResponse(event), nil)
+	responses.RespondGin(c, "events/detail", response)
+}
+
+// FindUpcomingEventsHandler returns upcoming events ordered by their next occurrence
+func (h *EventGinHandler) FindUpcomingEventsHandler(c *gin.Context) {
+	events, err := h.service.FindUpcomingEventsService(c.Request.Context())
+	if err != nil {
+		responses.RespondGinError(c, http.StatusInternalServerError, "Failed to find upcoming events", err.Error())
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Events retrieved successfully", events, nil)
+	responses.RespondGin(c, "events/upcoming", response)
+}
+
+// GetEventOccurrencesHandler expands a (recurring) event into its occurrences within a time window
+func (h *EventGinHandler) GetEventOccurrencesHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var query utils.OccurrencesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid query parameters", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if !query.From.IsZero() && !query.To.IsZero() && !query.To.After(query.From) {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, "to must be after from")
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	occurrences, err := h.service.FindEventOccurrencesService(c.Request.Context(), userID, eventID, query.From, query.To, query.Limit)
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusNotFound, "Event not found", nil, err.Error())
+		c.JSON(http.StatusNotFound, response)
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Occurrences retrieved successfully", occurrences, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetEventICSHandler serves the event as an iCalendar file that calendar apps can import
+func (h *EventGinHandler) GetEventICSHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	ics, err := h.service.GenerateEventICSService(c.Request.Context(), userID, eventID)
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusNotFound, "Event not found", nil, err.Error())
+		c.JSON(http.StatusNotFound, response)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"event-%s.ics\"", eventID))
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(ics))
+}
+
+// ChangeEventStatusHandler publishes, postpones, cancels or completes an event
+func (h *EventGinHandler) ChangeEventStatusHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var statusRequest utils.EventStatusChangeRequest
+	if err := c.ShouldBind(&statusRequest); err != nil { // JSON, or a form posted by HTMX
+		responses.RespondGinError(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if validationErr := validators.ValidateEventStatusChangeRequest(statusRequest); validationErr != nil {
+		responses.RespondGinError(c, http.StatusBadRequest, "Validation error", validationErr.Error())
+		return
+	}
+
+	event, err := h.service.ChangeEventStatusService(c.Request.Context(), userID, eventID, utils.TransformToEventStatusChangeDTO(statusRequest))
+	if err != nil {
+		switch {
+		case newerrors.IsConflictError(err):
+			responses.RespondGinError(c, http.StatusConflict, "Event status changed concurrently", err.Error())
+		case newerrors.IsForbiddenError(err):
+			responses.RespondGinError(c, http.StatusForbidden, "Forbidden", err.Error())
+		case newerrors.IsValidationError(err):
+			responses.RespondGinError(c, http.StatusBadRequest, "Validation error", err.Error())
+		default:
+			responses.RespondGinError(c, http.StatusInternalServerError, "Failed to change event status", err.Error())
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Event status changed successfully", utils.TransformToRegisterEventResponse(event), nil)
+	responses.RespondGin(c, "events/detail", response)
+}
+
+// ChangeEventSlugHandler sets the shareable name of an event's public page
+func (h *EventGinHandler) ChangeEventSlugHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var slugRequest utils.ChangeEventSlugRequest
+	if err := c.ShouldBindJSON(&slugRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	slug := utils.NormalizeEventSlug(slugRequest.Slug)
+	if validationErr := validators.ValidateEventSlug(slug); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	event, err := h.service.ChangeEventSlugService(c.Request.Context(), userID, eventID, slug)
+	if err != nil {
+		switch {
+		case newerrors.IsConflictError(err):
+			response := responses.NewGinResponse(c, http.StatusConflict, "Slug already taken", nil, err.Error())
+			c.JSON(http.StatusConflict, response)
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Event not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to change event slug", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Event slug changed successfully", utils.TransformToRegisterEventResponse(event), nil)
 	c.JSON(http.StatusOK, response)
 }
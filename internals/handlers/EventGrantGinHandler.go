@@ -0,0 +1,226 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
+)
+
+type EventGrantGinHandler struct {
+	service services.EventGrantServiceInterface
+}
+
+func NewEventGrantGinHandler(service services.EventGrantServiceInterface) *EventGrantGinHandler {
+	return &EventGrantGinHandler{
+		service: service,
+	}
+}
+
+// GrantEventAccessHandler shares an event with another user as co-organizer, editor or check-in staff
+func (h *EventGrantGinHandler) GrantEventAccessHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var grantRequest utils.GrantEventAccessRequest
+	if err := c.ShouldBindJSON(&grantRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if validationErr := validators.ValidateGrantEventAccessRequest(grantRequest); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	grant, err := h.service.GrantEventAccessService(c.Request.Context(), userID, eventID, utils.TransformToEventGrantDTO(grantRequest))
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		case newerrors.IsConflictError(err):
+			response := responses.NewGinResponse(c, http.StatusConflict, "Access already granted", nil, err.Error())
+			c.JSON(http.StatusConflict, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to share event", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusCreated, "Event shared successfully", grant, nil)
+	c.JSON(http.StatusCreated, response)
+}
+
+// ListEventGrantsHandler lists the users an event was shared with
+func (h *EventGrantGinHandler) ListEventGrantsHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	grants, err := h.service.FindEventGrantsService(c.Request.Context(), userID, eventID)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Event not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to list event access", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Event access retrieved successfully", grants, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// ChangeEventGrantRoleHandler changes the access of a user an event was shared with
+func (h *EventGrantGinHandler) ChangeEventGrantRoleHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+	granteeID, ok := uuidParamFromGinContext(c, "userId")
+	if !ok {
+		return
+	}
+
+	var roleRequest utils.ChangeEventGrantRoleRequest
+	if err := c.ShouldBindJSON(&roleRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if validationErr := validators.ValidateChangeEventGrantRoleRequest(roleRequest); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	grant, err := h.service.ChangeEventGrantRoleService(c.Request.Context(), userID, eventID, granteeID, roleRequest.Role)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Event access not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to change event access", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Event access changed successfully", grant, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// RevokeEventAccessHandler takes back the access of a user; users revoke their own access to give it up
+func (h *EventGrantGinHandler) RevokeEventAccessHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+	granteeID, ok := uuidParamFromGinContext(c, "userId")
+	if !ok {
+		return
+	}
+
+	if err := h.service.RevokeEventAccessService(c.Request.Context(), userID, eventID, granteeID); err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Event access not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to revoke event access", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Event access revoked successfully", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// TransferEventHandler hands an event over to a new primary organizer
+func (h *EventGrantGinHandler) TransferEventHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var transferRequest utils.TransferEventRequest
+	if err := c.ShouldBindJSON(&transferRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if validationErr := validators.ValidateTransferEventRequest(transferRequest); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	event, err := h.service.TransferEventService(c.Request.Context(), userID, eventID, transferRequest.Email)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to transfer event", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Event transferred successfully", utils.TransformToRegisterEventResponse(event), nil)
+	c.JSON(http.StatusOK, response)
+}
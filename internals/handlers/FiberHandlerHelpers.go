@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// userIDFromFiberContext retrieves the authenticated user ID set by AuthTokenFiberMiddleware.
+// It writes the error response itself and returns false when the ID is missing or malformed.
+func userIDFromFiberContext(c *fiber.Ctx) (uuid.UUID, bool) {
+	userID, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		response := responses.NewFiberResponse(c, fiber.StatusUnauthorized, "User ID not found in context", nil, nil)
+		c.Status(fiber.StatusUnauthorized).JSON(response)
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+// setAuthTokenFiberCookie stores an auth token in the cookie AuthTokenFiberMiddleware reads for the role
+func setAuthTokenFiberCookie(c *fiber.Ctx, role, token string) {
+	c.Cookie(&fiber.Cookie{
+		Name:     role + "|_|" + configs.TokenBaseCookieName,
+		Value:    token,
+		Expires:  time.Now().Add(configs.TokenExpiryDuration),
+		Path:     "/",
+		HTTPOnly: true,
+		SameSite: "Lax",
+		Secure:   configs.SecureCookieHTTPS,
+	})
+}
+
+// setPasswordlessNonceFiberCookie stores the nonce binding a passwordless login to this browser; it is only sent
+// to the passwordless login routes. An empty nonce removes the cookie.
+func setPasswordlessNonceFiberCookie(c *fiber.Ctx, nonce string) {
+	expires := time.Now().Add(configs.PasswordlessCodeTTL)
+	if nonce == "" {
+		expires = time.Unix(0, 0)
+	}
+
+	c.Cookie(&fiber.Cookie{
+		Name:     utils.PasswordlessNonceCookieName(),
+		Value:    nonce,
+		Expires:  expires,
+		Path:     utils.PasswordlessLoginPath,
+		HTTPOnly: true,
+		SameSite: "Lax",
+		Secure:   configs.SecureCookieHTTPS,
+	})
+}
+
+// uuidParamFromFiberContext parses a UUID path parameter, writing a 400 response when it is invalid.
+func uuidParamFromFiberContext(c *fiber.Ctx, name string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Params(name))
+	if err != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Invalid "+name, nil, err.Error())
+		c.Status(fiber.StatusBadRequest).JSON(response)
+		return uuid.Nil, false
+	}
+	return id, true
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// userIDFromGinContext retrieves the authenticated user ID set by AuthTokenGinMiddleware.
+// It writes the error response itself and returns false when the ID is missing or malformed.
+func userIDFromGinContext(c *gin.Context) (uuid.UUID, bool) {
+	value, exists := c.Get("userID")
+	if !exists {
+		response := responses.NewGinResponse(c, http.StatusUnauthorized, "User ID not found in context", nil, nil)
+		c.JSON(http.StatusUnauthorized, response)
+		return uuid.Nil, false
+	}
+
+	userID, ok := value.(uuid.UUID)
+	if !ok {
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Invalid User ID type", nil, nil)
+		c.JSON(http.StatusInternalServerError, response)
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+// uuidParamFromGinContext parses a UUID path parameter, writing a 400 response when it is invalid.
+func uuidParamFromGinContext(c *gin.Context, name string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param(name))
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid "+name, nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// setAuthTokenGinCookie stores an auth token in the cookie AuthTokenGinMiddleware reads for the role
+func setAuthTokenGinCookie(c *gin.Context, role, token string) {
+	// Generate dynamic cookie name using the superuser's role
+	cookieName := role + "|_|" + configs.TokenBaseCookieName
+
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(
+		cookieName,
+		token,
+		int(configs.TokenExpiryDuration.Seconds()),
+		"/",
+		"",
+		configs.SecureCookieHTTPS,
+		true,
+	)
+}
+
+// setActiveOrganizationGinCookie stores the organization TenantScopeGinMiddleware scopes requests to;
+// an empty organization ID removes the cookie, switching back to personal events
+func setActiveOrganizationGinCookie(c *gin.Context, organizationID string) {
+	maxAge := int(configs.TokenExpiryDuration.Seconds())
+	if organizationID == "" {
+		maxAge = -1
+	}
+
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(
+		utils.ActiveOrganizationCookieName(),
+		organizationID,
+		maxAge,
+		"/",
+		"",
+		configs.SecureCookieHTTPS,
+		true,
+	)
+}
+
+// setPasswordlessNonceGinCookie stores the nonce binding a passwordless login to this browser; it is only sent
+// to the passwordless login routes. An empty nonce removes the cookie.
+func setPasswordlessNonceGinCookie(c *gin.Context, nonce string) {
+	maxAge := int(configs.PasswordlessCodeTTL.Seconds())
+	if nonce == "" {
+		maxAge = -1
+	}
+
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(
+		utils.PasswordlessNonceCookieName(),
+		nonce,
+		maxAge,
+		utils.PasswordlessLoginPath,
+		"",
+		configs.SecureCookieHTTPS,
+		true,
+	)
+}
+
+// ginAttachmentWriter sends the attachment headers on the first write, so a handler can still
+// answer with a JSON error when the producer fails before writing anything
+type ginAttachmentWriter struct {
+	c           *gin.Context
+	contentType string
+	fileName    string
+	written     bool
+}
+
+func newGinAttachmentWriter(c *gin.Context, contentType, fileName string) *ginAttachmentWriter {
+	return &ginAttachmentWriter{c: c, contentType: contentType, fileName: fileName}
+}
+
+func (w *ginAttachmentWriter) Write(p []byte) (int, error) {
+	if !w.written {
+		w.written = true
+		w.c.Header("Content-Type", w.contentType)
+		w.c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", w.fileName))
+		w.c.Status(http.StatusOK)
+	}
+	return w.c.Writer.Write(p)
+}
@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
+)
+
+type GuestGinHandler struct {
+	service services.GuestServiceInterface
+}
+
+func NewGuestGinHandler(service services.GuestServiceInterface) *GuestGinHandler {
+	return &GuestGinHandler{
+		service: service,
+	}
+}
+
+// ImportGuestsHandler adds the guests of an uploaded CSV or XLSX file to an event and returns a row-by-row report
+func (h *GuestGinHandler) ImportGuestsHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var importRequest utils.ImportGuestsRequest
+	if err := c.ShouldBind(&importRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Guest list file is required", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if validationErr := validators.ValidateGuestImportFile(fileHeader.Filename, fileHeader.Size); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to open guest list", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+	defer file.Close()
+
+	rows, err := utils.ReadGuestSpreadsheet(fileHeader.Filename, file)
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Unreadable guest list", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	importDTO := utils.TransformToImportGuestsDTO(importRequest, rows)
+	report, err := h.service.ImportGuestsService(c.Request.Context(), userID, eventID, importDTO)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to import guests", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	message := "Guests imported successfully"
+	if report.DryRun {
+		message = "Guest import previewed successfully"
+	}
+	response := responses.NewGinResponse(c, http.StatusOK, message, report, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// ExportGuestsHandler streams the guest list of an event as a CSV or XLSX file or a PDF sign-in sheet
+func (h *GuestGinHandler) ExportGuestsHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var exportQuery utils.GuestExportQuery
+	if err := c.ShouldBindQuery(&exportQuery); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid query parameters", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	exportDTO := utils.TransformToGuestExportDTO(exportQuery)
+	if validationErr := validators.ValidateGuestExportQuery(exportDTO); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	fileName := fmt.Sprintf("guests-%s.%s", eventID, exportDTO.Format)
+	writer := newGinAttachmentWriter(c, utils.GuestExportContentType(exportDTO.Format), fileName)
+	err := h.service.ExportGuestsService(c.Request.Context(), userID, eventID, exportDTO, writer)
+	if err == nil {
+		return
+	}
+	if writer.written {
+		// The file is partly sent, so the client can only be told by cutting the download short
+		log.Printf("guest export of event %s failed: %v", eventID, err)
+		c.Abort()
+		return
+	}
+
+	switch {
+	case newerrors.IsForbiddenError(err):
+		response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+		c.JSON(http.StatusForbidden, response)
+	case newerrors.IsValidationError(err):
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+	default:
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to export guests", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+	}
+}
+
+// UpdateRSVPHandler records a guest's RSVP answer; accepting issues the guest a ticket
+func (h *GuestGinHandler) UpdateRSVPHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+	guestID, ok := uuidParamFromGinContext(c, "guestId")
+	if !ok {
+		return
+	}
+
+	var rsvpRequest utils.UpdateRSVPRequest
+	if err := c.ShouldBindJSON(&rsvpRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	rsvpDTO := utils.TransformToUpdateRSVPDTO(rsvpRequest)
+	if validationErr := validators.ValidateUpdateRSVP(rsvpDTO); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	guest, err := h.service.UpdateRSVPService(c.Request.Context(), userID, eventID, guestID, rsvpDTO)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to update RSVP", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "RSVP updated successfully", guest, nil)
+	c.JSON(http.StatusOK, response)
+}
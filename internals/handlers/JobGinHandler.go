@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
+)
+
+type JobGinHandler struct {
+	service services.JobSchedulerServiceInterface
+}
+
+func NewJobGinHandler(service services.JobSchedulerServiceInterface) *JobGinHandler {
+	return &JobGinHandler{
+		service: service,
+	}
+}
+
+// ListJobsHandler lists every background job with its schedule, next run and lease
+func (h *JobGinHandler) ListJobsHandler(c *gin.Context) {
+	jobs, err := h.service.FindJobsService(c.Request.Context())
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to list jobs", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Jobs retrieved successfully", jobs, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// ListJobRunsHandler lists the latest job runs, optionally only those of one job
+func (h *JobGinHandler) ListJobRunsHandler(c *gin.Context) {
+	var query utils.JobRunsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid query parameters", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if validationErr := validators.ValidateJobRunsQuery(query); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+	if query.Limit == 0 {
+		query.Limit = utils.DefaultJobRunsLimit
+	}
+
+	runs, err := h.service.FindJobRunsService(c.Request.Context(), query.Job, query.Limit)
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to list job runs", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Job runs retrieved successfully", runs, nil)
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,237 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
+)
+
+type OrderGinHandler struct {
+	service services.OrderServiceInterface
+}
+
+func NewOrderGinHandler(service services.OrderServiceInterface) *OrderGinHandler {
+	return &OrderGinHandler{
+		service: service,
+	}
+}
+
+// CreateOrderHandler orders tickets of one tier of an event for the current user
+func (h *OrderGinHandler) CreateOrderHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var orderRequest utils.CreateOrderRequest
+	if err := c.ShouldBindJSON(&orderRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	orderDTO := utils.TransformToCreateOrderDTO(orderRequest)
+	if validationErr := validators.ValidateCreateOrderRequest(orderDTO); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	order, err := h.service.CreateOrderService(c.Request.Context(), userID, eventID, orderDTO)
+	if err != nil {
+		switch {
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		case newerrors.IsConflictError(err):
+			response := responses.NewGinResponse(c, http.StatusConflict, "Order not possible", nil, err.Error())
+			c.JSON(http.StatusConflict, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to create order", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusCreated, "Order created successfully", utils.TransformToOrderResponse(order), nil)
+	c.JSON(http.StatusCreated, response)
+}
+
+// ListEventOrdersHandler lists the orders of an event for its organizer
+func (h *OrderGinHandler) ListEventOrdersHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	orders, err := h.service.FindEventOrdersService(c.Request.Context(), userID, eventID)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Event not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to list orders", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Orders retrieved successfully", utils.TransformToOrderResponses(orders), nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetOrderHandler returns an order to its buyer or the organizer of its event
+func (h *OrderGinHandler) GetOrderHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	orderID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	order, err := h.service.FindOrderService(c.Request.Context(), userID, orderID)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Order not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Order retrieved successfully", utils.TransformToOrderResponse(order), nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// ConfirmOrderHandler confirms a pending order of the current user once its payment went through
+func (h *OrderGinHandler) ConfirmOrderHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	orderID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	order, err := h.service.ConfirmOrderService(c.Request.Context(), userID, orderID)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Order cannot be confirmed", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		case newerrors.IsConflictError(err):
+			response := responses.NewGinResponse(c, http.StatusConflict, "Order changed concurrently", nil, err.Error())
+			c.JSON(http.StatusConflict, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to confirm order", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Order confirmed successfully", utils.TransformToOrderResponse(order), nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// CancelOrderHandler abandons a pending order of the current user
+func (h *OrderGinHandler) CancelOrderHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	orderID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	order, err := h.service.CancelOrderService(c.Request.Context(), userID, orderID)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Order cannot be cancelled", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		case newerrors.IsConflictError(err):
+			response := responses.NewGinResponse(c, http.StatusConflict, "Order changed concurrently", nil, err.Error())
+			c.JSON(http.StatusConflict, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to cancel order", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Order cancelled successfully", utils.TransformToOrderResponse(order), nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// RefundOrderHandler lets the organizer refund a confirmed order
+func (h *OrderGinHandler) RefundOrderHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	orderID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var refundRequest utils.RefundOrderRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&refundRequest); err != nil {
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+			return
+		}
+	}
+
+	order, err := h.service.RefundOrderService(c.Request.Context(), userID, orderID, refundRequest.Reason)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Order cannot be refunded", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		case newerrors.IsConflictError(err):
+			response := responses.NewGinResponse(c, http.StatusConflict, "Order changed concurrently", nil, err.Error())
+			c.JSON(http.StatusConflict, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to refund order", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Order refunded successfully", utils.TransformToOrderResponse(order), nil)
+	c.JSON(http.StatusOK, response)
+}
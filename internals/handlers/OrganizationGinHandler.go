@@ -0,0 +1,373 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
+)
+
+type OrganizationGinHandler struct {
+	service services.OrganizationServiceInterface
+}
+
+func NewOrganizationGinHandler(service services.OrganizationServiceInterface) *OrganizationGinHandler {
+	return &OrganizationGinHandler{
+		service: service,
+	}
+}
+
+// CreateOrganizationHandler creates an organization with the current user as its owner
+func (h *OrganizationGinHandler) CreateOrganizationHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+
+	var organizationRequest utils.CreateOrganizationRequest
+	if err := c.ShouldBindJSON(&organizationRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if validationErr := validators.ValidateCreateOrganizationRequest(organizationRequest); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	organization, err := h.service.CreateOrganizationService(c.Request.Context(), userID, utils.TransformToOrganizationDTO(organizationRequest))
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to create organization", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusCreated, "Organization created successfully", organization, nil)
+	c.JSON(http.StatusCreated, response)
+}
+
+// ListOrganizationsHandler lists the organizations the current user is a member of
+func (h *OrganizationGinHandler) ListOrganizationsHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+
+	organizations, err := h.service.FindMyOrganizationsService(c.Request.Context(), userID)
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to list organizations", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Organizations retrieved successfully", organizations, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetOrganizationHandler returns an organization and its members
+func (h *OrganizationGinHandler) GetOrganizationHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	organizationID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	organization, err := h.service.FindOrganizationService(c.Request.Context(), userID, organizationID)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Organization not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to retrieve organization", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Organization retrieved successfully", organization, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// ChangeMemberRoleHandler changes the role of a member of an organization
+func (h *OrganizationGinHandler) ChangeMemberRoleHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	organizationID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+	memberID, ok := uuidParamFromGinContext(c, "userId")
+	if !ok {
+		return
+	}
+
+	var roleRequest utils.ChangeOrganizationMemberRoleRequest
+	if err := c.ShouldBindJSON(&roleRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if validationErr := validators.ValidateChangeOrganizationMemberRoleRequest(roleRequest); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	member, err := h.service.ChangeMemberRoleService(c.Request.Context(), userID, organizationID, memberID, roleRequest.Role)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to change member role", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Member role changed successfully", member, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// RemoveMemberHandler removes a member from an organization; members remove themselves to leave
+func (h *OrganizationGinHandler) RemoveMemberHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	organizationID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+	memberID, ok := uuidParamFromGinContext(c, "userId")
+	if !ok {
+		return
+	}
+
+	if err := h.service.RemoveMemberService(c.Request.Context(), userID, organizationID, memberID); err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to remove member", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Member removed successfully", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// InviteMemberHandler emails an invitation to join an organization
+func (h *OrganizationGinHandler) InviteMemberHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	organizationID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var invitationRequest utils.InviteOrganizationMemberRequest
+	if err := c.ShouldBindJSON(&invitationRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if validationErr := validators.ValidateInviteOrganizationMemberRequest(invitationRequest); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	invitation, err := h.service.InviteMemberService(c.Request.Context(), userID, organizationID, utils.TransformToOrganizationInvitationDTO(invitationRequest))
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		case newerrors.IsConflictError(err):
+			response := responses.NewGinResponse(c, http.StatusConflict, "Invitation not possible", nil, err.Error())
+			c.JSON(http.StatusConflict, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to send invitation", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusCreated, "Invitation sent successfully", utils.TransformToOrganizationInvitationResponse(invitation), nil)
+	c.JSON(http.StatusCreated, response)
+}
+
+// ListInvitationsHandler lists the pending invitations of an organization
+func (h *OrganizationGinHandler) ListInvitationsHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	organizationID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	invitations, err := h.service.FindPendingInvitationsService(c.Request.Context(), userID, organizationID)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to list invitations", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Invitations retrieved successfully", utils.TransformToOrganizationInvitationResponses(invitations), nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// RevokeInvitationHandler withdraws a pending invitation
+func (h *OrganizationGinHandler) RevokeInvitationHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	organizationID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+	invitationID, ok := uuidParamFromGinContext(c, "invitationId")
+	if !ok {
+		return
+	}
+
+	if err := h.service.RevokeInvitationService(c.Request.Context(), userID, organizationID, invitationID); err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Invitation not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to revoke invitation", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Invitation revoked successfully", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// AcceptInvitationHandler makes the current user a member of the organization of the emailed invitation link
+func (h *OrganizationGinHandler) AcceptInvitationHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+
+	var acceptQuery utils.AcceptOrganizationInvitationQuery
+	if err := c.ShouldBindQuery(&acceptQuery); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid query parameters", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	organization, err := h.service.AcceptInvitationService(c.Request.Context(), userID, acceptQuery.Token)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		case newerrors.IsConflictError(err):
+			response := responses.NewGinResponse(c, http.StatusConflict, "Invitation not possible", nil, err.Error())
+			c.JSON(http.StatusConflict, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to accept invitation", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Invitation accepted successfully", organization, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// SwitchActiveOrganizationHandler chooses the organization whose events and guests later requests work on;
+// a null organization_id switches back to personal events
+func (h *OrganizationGinHandler) SwitchActiveOrganizationHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+
+	var switchRequest utils.SwitchActiveOrganizationRequest
+	if err := c.ShouldBindJSON(&switchRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if switchRequest.OrganizationID == nil {
+		setActiveOrganizationGinCookie(c, "")
+		response := responses.NewGinResponse(c, http.StatusOK, "Switched to personal events", nil, nil)
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	member, err := h.service.FindMembershipService(c.Request.Context(), userID, *switchRequest.OrganizationID)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to switch organization", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	setActiveOrganizationGinCookie(c, member.OrganizationID.String())
+	response := responses.NewGinResponse(c, http.StatusOK, "Active organization switched successfully", gin.H{
+		"organization_id": member.OrganizationID,
+		"role":            member.Role,
+	}, nil)
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
+)
+
+type PasswordlessLoginFiberHandler struct {
+	service services.PasswordlessLoginServiceInterface
+}
+
+func NewPasswordlessLoginFiberHandler(service services.PasswordlessLoginServiceInterface) *PasswordlessLoginFiberHandler {
+	return &PasswordlessLoginFiberHandler{
+		service: service,
+	}
+}
+
+// RequestLoginHandler emails a login link and code and binds the login to this browser with the nonce cookie.
+// The answer is the same whether or not the account exists.
+func (h *PasswordlessLoginFiberHandler) RequestLoginHandler(c *fiber.Ctx) error {
+	var req utils.PasswordlessLoginRequest
+	if err := c.BodyParser(&req); err != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Invalid input", nil, err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+	if err := validators.ValidatePasswordlessLoginRequest(req); err != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Validation error", nil, err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+
+	nonce, err := h.service.RequestLoginService(c.Context(), strings.TrimSpace(req.Email))
+	if err != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusInternalServerError, "Failed to send login email", nil, err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(response)
+	}
+	setPasswordlessNonceFiberCookie(c, nonce)
+
+	response := responses.NewFiberResponse(c, fiber.StatusAccepted, "If the account can log in without a password, a login link and code have been emailed to it", nil, nil)
+	return c.Status(fiber.StatusAccepted).JSON(response)
+}
+
+// LogInWithLinkHandler logs in with the token of an emailed login link
+func (h *PasswordlessLoginFiberHandler) LogInWithLinkHandler(c *fiber.Ctx) error {
+	token := c.Query("token")
+	if token == "" {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Token is required", nil, nil)
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+
+	loggedInSuperUser, err := h.service.LogInWithLinkService(c.Context(), token, c.Cookies(utils.PasswordlessNonceCookieName()))
+	return h.finishLogin(c, loggedInSuperUser, err)
+}
+
+// LogInWithCodeHandler logs in with an emailed code
+func (h *PasswordlessLoginFiberHandler) LogInWithCodeHandler(c *fiber.Ctx) error {
+	var req utils.PasswordlessCodeLoginRequest
+	if err := c.BodyParser(&req); err != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Invalid input", nil, err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+	if err := validators.ValidatePasswordlessCodeLoginRequest(req); err != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Validation error", nil, err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+
+	loggedInSuperUser, err := h.service.LogInWithCodeService(c.Context(), strings.TrimSpace(req.Code), c.Cookies(utils.PasswordlessNonceCookieName()))
+	return h.finishLogin(c, loggedInSuperUser, err)
+}
+
+// finishLogin answers a passwordless login, storing the auth token and dropping the nonce cookie on success
+func (h *PasswordlessLoginFiberHandler) finishLogin(c *fiber.Ctx, loggedInSuperUser *utils.LoginSuperuserResponse, err error) error {
+	if err != nil {
+		switch {
+		case newerrors.IsValidationError(err):
+			response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Failed to log in", nil, err.Error())
+			return c.Status(fiber.StatusBadRequest).JSON(response)
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewFiberResponse(c, fiber.StatusForbidden, "Login not allowed", nil, err.Error())
+			return c.Status(fiber.StatusForbidden).JSON(response)
+		default:
+			response := responses.NewFiberResponse(c, fiber.StatusInternalServerError, "Failed to log in", nil, err.Error())
+			return c.Status(fiber.StatusInternalServerError).JSON(response)
+		}
+	}
+
+	setAuthTokenFiberCookie(c, loggedInSuperUser.Role, loggedInSuperUser.Token)
+	setPasswordlessNonceFiberCookie(c, "")
+
+	response := responses.NewFiberResponse(c, fiber.StatusOK, "Login successful", loggedInSuperUser, nil)
+	return c.Status(fiber.StatusOK).JSON(response)
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
+)
+
+type PasswordlessLoginGinHandler struct {
+	service services.PasswordlessLoginServiceInterface
+}
+
+func NewPasswordlessLoginGinHandler(service services.PasswordlessLoginServiceInterface) *PasswordlessLoginGinHandler {
+	return &PasswordlessLoginGinHandler{
+		service: service,
+	}
+}
+
+// RequestLoginHandler emails a login link and code and binds the login to this browser with the nonce cookie.
+// The answer is the same whether or not the account exists.
+func (h *PasswordlessLoginGinHandler) RequestLoginHandler(c *gin.Context) {
+	var req utils.PasswordlessLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid input", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+	if err := validators.ValidatePasswordlessLoginRequest(req); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	nonce, err := h.service.RequestLoginService(c.Request.Context(), strings.TrimSpace(req.Email))
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to send login email", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+	setPasswordlessNonceGinCookie(c, nonce)
+
+	response := responses.NewGinResponse(c, http.StatusAccepted, "If the account can log in without a password, a login link and code have been emailed to it", nil, nil)
+	c.JSON(http.StatusAccepted, response)
+}
+
+// LogInWithLinkHandler logs in with the token of an emailed login link
+func (h *PasswordlessLoginGinHandler) LogInWithLinkHandler(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Token is required", nil, nil)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	nonce, _ := c.Cookie(utils.PasswordlessNonceCookieName())
+	loggedInSuperUser, err := h.service.LogInWithLinkService(c.Request.Context(), token, nonce)
+	h.finishLogin(c, loggedInSuperUser, err)
+}
+
+// LogInWithCodeHandler logs in with an emailed code
+func (h *PasswordlessLoginGinHandler) LogInWithCodeHandler(c *gin.Context) {
+	var req utils.PasswordlessCodeLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid input", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+	if err := validators.ValidatePasswordlessCodeLoginRequest(req); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	nonce, _ := c.Cookie(utils.PasswordlessNonceCookieName())
+	loggedInSuperUser, err := h.service.LogInWithCodeService(c.Request.Context(), strings.TrimSpace(req.Code), nonce)
+	h.finishLogin(c, loggedInSuperUser, err)
+}
+
+// finishLogin answers a passwordless login, storing the auth token and dropping the nonce cookie on success
+func (h *PasswordlessLoginGinHandler) finishLogin(c *gin.Context, loggedInSuperUser *utils.LoginSuperuserResponse, err error) {
+	if err != nil {
+		switch {
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Failed to log in", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Login not allowed", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to log in", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	setAuthTokenGinCookie(c, loggedInSuperUser.Role, loggedInSuperUser.Token)
+	setPasswordlessNonceGinCookie(c, "")
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Login successful", loggedInSuperUser, nil)
+	c.JSON(http.StatusOK, response)
+}
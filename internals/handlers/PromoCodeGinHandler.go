@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
+)
+
+type PromoCodeGinHandler struct {
+	service services.PromoCodeServiceInterface
+}
+
+func NewPromoCodeGinHandler(service services.PromoCodeServiceInterface) *PromoCodeGinHandler {
+	return &PromoCodeGinHandler{
+		service: service,
+	}
+}
+
+// CreatePromoCodeHandler adds a promo code to an event of the current user
+func (h *PromoCodeGinHandler) CreatePromoCodeHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var promoRequest utils.CreatePromoCodeRequest
+	if err := c.ShouldBindJSON(&promoRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	promoDTO := utils.TransformToPromoCodeDTO(promoRequest)
+	if validationErr := validators.ValidatePromoCodeRequest(promoDTO); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	promoCode, err := h.service.CreatePromoCodeService(c.Request.Context(), userID, eventID, promoDTO)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		case newerrors.IsConflictError(err):
+			response := responses.NewGinResponse(c, http.StatusConflict, "Promo code already exists", nil, err.Error())
+			c.JSON(http.StatusConflict, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to create promo code", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusCreated, "Promo code created successfully", utils.TransformToPromoCodeResponse(promoCode), nil)
+	c.JSON(http.StatusCreated, response)
+}
+
+// ListPromoCodesHandler lists the promo codes of an event for its organizer
+func (h *PromoCodeGinHandler) ListPromoCodesHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	promoCodes, err := h.service.FindPromoCodesService(c.Request.Context(), userID, eventID)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Event not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to list promo codes", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Promo codes retrieved successfully", utils.TransformToPromoCodeResponses(promoCodes), nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdatePromoCodeHandler replaces the settings of a promo code
+func (h *PromoCodeGinHandler) UpdatePromoCodeHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+	promoCodeID, ok := uuidParamFromGinContext(c, "codeId")
+	if !ok {
+		return
+	}
+
+	var promoRequest utils.UpdatePromoCodeRequest
+	if err := c.ShouldBindJSON(&promoRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	promoDTO := utils.TransformToUpdatePromoCodeDTO(promoRequest)
+	if validationErr := validators.ValidatePromoCodeSettings(promoDTO); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	promoCode, err := h.service.UpdatePromoCodeService(c.Request.Context(), userID, eventID, promoCodeID, promoDTO)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to update promo code", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Promo code updated successfully", utils.TransformToPromoCodeResponse(promoCode), nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// DeletePromoCodeHandler removes a promo code nobody has redeemed
+func (h *PromoCodeGinHandler) DeletePromoCodeHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+	promoCodeID, ok := uuidParamFromGinContext(c, "codeId")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeletePromoCodeService(c.Request.Context(), userID, eventID, promoCodeID); err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Promo code not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		case newerrors.IsConflictError(err):
+			response := responses.NewGinResponse(c, http.StatusConflict, "Promo code in use", nil, err.Error())
+			c.JSON(http.StatusConflict, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to delete promo code", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Promo code deleted successfully", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// PromoCodeUsageReportHandler reports how often each promo code of an event was used
+func (h *PromoCodeGinHandler) PromoCodeUsageReportHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	report, err := h.service.PromoCodeUsageReportService(c.Request.Context(), userID, eventID)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Event not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to build promo code report", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Promo code report retrieved successfully", report, nil)
+	c.JSON(http.StatusOK, response)
+}
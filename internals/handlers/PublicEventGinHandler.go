@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+type PublicEventGinHandler struct {
+	service services.PublicEventServiceInterface
+}
+
+func NewPublicEventGinHandler(service services.PublicEventServiceInterface) *PublicEventGinHandler {
+	return &PublicEventGinHandler{
+		service: service,
+	}
+}
+
+// GetPublicEventHandler returns what anyone may see of a published event
+func (h *PublicEventGinHandler) GetPublicEventHandler(c *gin.Context) {
+	event, err := h.service.FindPublicEventService(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		switch {
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Event not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to retrieve event", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Event retrieved successfully", event, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// RegisterForEventHandler signs a guest up for a published event without an account; the registration
+// only counts once the emailed link is opened
+func (h *PublicEventGinHandler) RegisterForEventHandler(c *gin.Context) {
+	var registrationRequest utils.SelfRegistrationRequest
+	if err := c.ShouldBindJSON(&registrationRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	registrationDTO := utils.TransformToSelfRegistrationDTO(registrationRequest)
+	if err := h.service.RegisterForEventService(c.Request.Context(), c.Param("slug"), registrationDTO); err != nil {
+		switch {
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to register", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusAccepted, "Check your email to confirm your registration", nil, nil)
+	c.JSON(http.StatusAccepted, response)
+}
+
+// ConfirmRegistrationHandler completes a self-registration with the token from the confirmation email
+func (h *PublicEventGinHandler) ConfirmRegistrationHandler(c *gin.Context) {
+	var confirmRequest utils.ConfirmRegistrationRequest
+	if err := c.ShouldBindJSON(&confirmRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	confirmed, err := h.service.ConfirmRegistrationService(c.Request.Context(), c.Param("slug"), confirmRequest.GuestID, confirmRequest.Token)
+	if err != nil {
+		switch {
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Confirmation failed", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to confirm registration", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Registration confirmed", confirmed, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// EventPageHandler renders the public page of a published event with its registration form
+func (h *PublicEventGinHandler) EventPageHandler(c *gin.Context) {
+	event, ok := h.findPageEvent(c)
+	if !ok {
+		return
+	}
+	responses.RespondGin(c, "public/event", responses.NewGinResponse(c, http.StatusOK, "Event retrieved successfully", utils.NewPublicEventPage(event), nil))
+}
+
+// RegisterPageHandler takes a registration posted from the public event page, showing the form again
+// with the reason when it is rejected
+func (h *PublicEventGinHandler) RegisterPageHandler(c *gin.Context) {
+	event, ok := h.findPageEvent(c)
+	if !ok {
+		return
+	}
+	if err := c.Request.ParseForm(); err != nil {
+		responses.RespondGinError(c, http.StatusBadRequest, "Registration failed", "The form could not be read. Please try again.")
+		return
+	}
+
+	page := utils.NewPublicEventPage(event)
+	registrationDTO := utils.TransformFormToSelfRegistrationDTO(c.Request.PostForm, event.RegistrationFields)
+	var reason string
+	switch {
+	case registrationDTO.FullName == "" || registrationDTO.Email == "":
+		reason = "Please enter your name and email address."
+	case len(registrationDTO.FullName) > 100:
+		reason = "Your name can be at most 100 characters long."
+	default:
+		err := h.service.RegisterForEventService(c.Request.Context(), event.Slug, registrationDTO)
+		switch {
+		case err == nil:
+			page.Submitted = true
+			responses.RespondGin(c, "public/event", responses.NewGinResponse(c, http.StatusAccepted, "Check your email to confirm your registration", page, nil))
+			return
+		case newerrors.IsValidationError(err):
+			reason = err.Error()
+		default:
+			log.Printf("Failed to register for event %s: %v", event.Slug, err)
+			reason = "Something went wrong. Please try again later."
+		}
+	}
+
+	page.Values = c.Request.PostForm
+	responses.RespondGin(c, "public/event", responses.NewGinResponse(c, http.StatusBadRequest, "Registration failed", page, reason))
+}
+
+// ConfirmRegistrationPageHandler opens the link of a confirmation email and shows how the registration ended up
+func (h *PublicEventGinHandler) ConfirmRegistrationPageHandler(c *gin.Context) {
+	guestID, err := uuid.Parse(c.Query("guest"))
+	if err != nil || c.Query("token") == "" {
+		responses.RespondGinError(c, http.StatusBadRequest, "Confirmation failed", "This confirmation link is invalid or has expired.")
+		return
+	}
+
+	confirmed, err := h.service.ConfirmRegistrationService(c.Request.Context(), c.Param("slug"), guestID, c.Query("token"))
+	if err != nil {
+		switch {
+		case newerrors.IsValidationError(err):
+			responses.RespondGinError(c, http.StatusBadRequest, "Confirmation failed", fmt.Sprintf("Sorry, %s.", err.Error()))
+		default:
+			log.Printf("Failed to confirm a registration for event %s: %v", c.Param("slug"), err)
+			responses.RespondGinError(c, http.StatusInternalServerError, "Confirmation failed", "Something went wrong. Please try again later.")
+		}
+		return
+	}
+
+	responses.RespondGin(c, "public/confirmed", responses.NewGinResponse(c, http.StatusOK, "Registration confirmed", confirmed, nil))
+}
+
+// findPageEvent loads the event of a public page, writing the error page when it cannot be shown
+func (h *PublicEventGinHandler) findPageEvent(c *gin.Context) (*utils.PublicEventResponse, bool) {
+	event, err := h.service.FindPublicEventService(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		if newerrors.IsValidationError(err) {
+			responses.RespondGinError(c, http.StatusNotFound, "Event not found", "This event does not exist or is not public.")
+		} else {
+			log.Printf("Failed to load public event %s: %v", c.Param("slug"), err)
+			responses.RespondGinError(c, http.StatusInternalServerError, "Something went wrong", "Please try again later.")
+		}
+		return nil, false
+	}
+	return event, true
+}
@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
+)
+
+type RegistrationFormGinHandler struct {
+	service services.RegistrationFormServiceInterface
+}
+
+func NewRegistrationFormGinHandler(service services.RegistrationFormServiceInterface) *RegistrationFormGinHandler {
+	return &RegistrationFormGinHandler{
+		service: service,
+	}
+}
+
+// GetRegistrationFormHandler returns the questions an event asks its guests
+func (h *RegistrationFormGinHandler) GetRegistrationFormHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	form, err := h.service.FindRegistrationFormService(c.Request.Context(), userID, eventID)
+	if err != nil {
+		switch {
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Registration form not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to retrieve registration form", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Registration form retrieved successfully", form, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// SaveRegistrationFormHandler creates or replaces the registration form of an event of the current user
+func (h *RegistrationFormGinHandler) SaveRegistrationFormHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var formRequest utils.SaveRegistrationFormRequest
+	if err := c.ShouldBindJSON(&formRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	formDTO := utils.TransformToRegistrationFormDTO(formRequest)
+	if validationErr := validators.ValidateRegistrationForm(formDTO); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	form, err := h.service.SaveRegistrationFormService(c.Request.Context(), userID, eventID, formDTO)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Event not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to save registration form", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Registration form saved successfully", form, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteRegistrationFormHandler removes the registration form of an event of the current user
+func (h *RegistrationFormGinHandler) DeleteRegistrationFormHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteRegistrationFormService(c.Request.Context(), userID, eventID); err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Event not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to delete registration form", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Registration form deleted successfully", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
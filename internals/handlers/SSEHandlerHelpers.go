@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// defaultStreamHeartbeatInterval is used when realtime.heartbeat_interval is not configured
+const defaultStreamHeartbeatInterval = 15 * time.Second
+
+// sseHeaders are the response headers of every server-sent event stream
+var sseHeaders = map[string]string{
+	"Content-Type":      "text/event-stream",
+	"Cache-Control":     "no-cache",
+	"Connection":        "keep-alive",
+	"X-Accel-Buffering": "no", // Stops nginx from buffering the stream
+}
+
+// streamAttendance writes attendance updates as server-sent events until ctx is done or a write fails.
+// It is shared by the Gin and Fiber handlers, which only differ in how they write and flush.
+// The numbers are re-counted whenever the bus signals a change and on every heartbeat, so changes made by other
+// instances still reach the client. lastEventID is the Last-Event-ID the client reconnected with, if any;
+// when the numbers have not changed since, they are not sent again.
+func streamAttendance(
+	ctx context.Context,
+	w io.Writer,
+	flush func() error,
+	service services.AttendanceServiceInterface,
+	userID, eventID uuid.UUID,
+	stats *utils.AttendanceStats,
+	changes <-chan struct{},
+	lastEventID string,
+) error {
+	sentID := lastEventID
+	send := func(stats *utils.AttendanceStats) (bool, error) {
+		id := stats.Fingerprint()
+		if id == sentID {
+			return false, nil
+		}
+		data, err := json.Marshal(stats)
+		if err != nil {
+			return false, err
+		}
+		if _, err := io.WriteString(w, utils.FormatSSEEvent(id, utils.AttendanceStreamEvent, data)); err != nil {
+			return false, err
+		}
+		sentID = id
+		return true, nil
+	}
+	recount := func() (bool, error) {
+		latest, err := service.AttendanceStatsService(ctx, userID, eventID)
+		if err != nil {
+			// Typically lost access; tell the client before closing so it does not reconnect blindly
+			data, _ := json.Marshal(map[string]string{"error": err.Error()})
+			io.WriteString(w, utils.FormatSSEEvent("", "error", data))
+			return false, err
+		}
+		return send(latest)
+	}
+
+	if configs.StreamRetryInterval > 0 {
+		if _, err := io.WriteString(w, utils.FormatSSERetry(configs.StreamRetryInterval)); err != nil {
+			return err
+		}
+	}
+	if _, err := send(stats); err != nil {
+		return err
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	interval := configs.StreamHeartbeatInterval
+	if interval <= 0 {
+		interval = defaultStreamHeartbeatInterval
+	}
+	heartbeat := time.NewTicker(interval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changes:
+			if _, err := recount(); err != nil {
+				flush()
+				return err
+			}
+		case <-heartbeat.C:
+			sent, err := recount()
+			if err != nil {
+				flush()
+				return err
+			}
+			if !sent {
+				if _, err := io.WriteString(w, utils.SSEHeartbeat); err != nil {
+					return err
+				}
+			}
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+	}
+}
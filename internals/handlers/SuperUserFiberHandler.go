@@ -5,9 +5,11 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
 	"github.com/lordofthemind/EventureGo/internals/responses"
 	"github.com/lordofthemind/EventureGo/internals/services"
 	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
 )
 
 type SuperUserFiberHandler struct {
@@ -36,6 +38,9 @@ func (h *SuperUserFiberHandler) RegisterSuperUserHandler(c *fiber.Ctx) error {
 		if err.Error() == "email already in use" || err.Error() == "username already in use" {
 			response := responses.NewFiberResponse(c, fiber.StatusConflict, err.Error(), nil, nil)
 			return c.Status(fiber.StatusConflict).JSON(response)
+		} else if newerrors.IsValidationError(err) {
+			response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Failed to register superuser", nil, err.Error())
+			return c.Status(fiber.StatusBadRequest).JSON(response)
 		} else {
 			response := responses.NewFiberResponse(c, fiber.StatusInternalServerError, "Failed to register superuser", nil, err.Error())
 			return c.Status(fiber.StatusInternalServerError).JSON(response)
@@ -83,26 +88,17 @@ func (h *SuperUserFiberHandler) LogInSuperUserHandler(c *fiber.Ctx) error {
 		if err.Error() == "invalid email/username or password" {
 			response := responses.NewFiberResponse(c, fiber.StatusUnauthorized, "Invalid email/username or password", nil, nil)
 			return c.Status(fiber.StatusUnauthorized).JSON(response)
+		} else if newerrors.IsForbiddenError(err) {
+			response := responses.NewFiberResponse(c, fiber.StatusForbidden, "Login not allowed", nil, err.Error())
+			return c.Status(fiber.StatusForbidden).JSON(response)
 		} else {
 			response := responses.NewFiberResponse(c, fiber.StatusInternalServerError, "Failed to log in", nil, err.Error())
 			return c.Status(fiber.StatusInternalServerError).JSON(response)
 		}
 	}
 
-	// Create a cookie name using the superuser's role
-	cookieName := loggedInSuperUser.Role + "|_|" + configs.TokenBaseCookieName
-
-	// Create a cookie and set it in the response
-	cookie := new(fiber.Cookie)
-	cookie.Name = cookieName
-	cookie.Value = loggedInSuperUser.Token
-	cookie.Expires = time.Now().Add(configs.TokenExpiryDuration)
-	cookie.Path = "/"
-	cookie.HTTPOnly = true
-	cookie.SameSite = "Lax"
-	cookie.Secure = configs.SecureCookieHTTPS
-
-	c.Cookie(cookie)
+	// Store the token in a cookie named after the superuser's role
+	setAuthTokenFiberCookie(c, loggedInSuperUser.Role, loggedInSuperUser.Token)
 
 	// Use standardized response for successful login
 	response := responses.NewFiberResponse(c, fiber.StatusOK, "Login successful", loggedInSuperUser, nil)
@@ -170,7 +166,7 @@ func (h *SuperUserFiberHandler) PasswordResetRequestHandler(c *fiber.Ctx) error
 // PasswordResetHandler handles the password reset using a token
 func (h *SuperUserFiberHandler) PasswordResetHandler(c *fiber.Ctx) error {
 	var request struct {
-		Password string `json:"password" binding:"required,min=8"`
+		Password string `json:"password" binding:"required"`
 	}
 
 	// Parse and validate the request payload
@@ -189,6 +185,10 @@ func (h *SuperUserFiberHandler) PasswordResetHandler(c *fiber.Ctx) error {
 	// Call the service to reset the password
 	err := h.service.ResetPassword(c.Context(), token, request.Password)
 	if err != nil {
+		if newerrors.IsValidationError(err) {
+			response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Failed to reset password", nil, err.Error())
+			return c.Status(fiber.StatusBadRequest).JSON(response)
+		}
 		response := responses.NewFiberResponse(c, fiber.StatusInternalServerError, "Failed to reset password", nil, err.Error())
 		return c.Status(fiber.StatusInternalServerError).JSON(response)
 	}
@@ -197,3 +197,161 @@ func (h *SuperUserFiberHandler) PasswordResetHandler(c *fiber.Ctx) error {
 	response := responses.NewFiberResponse(c, fiber.StatusOK, "Password reset successful", nil, nil)
 	return c.Status(fiber.StatusOK).JSON(response)
 }
+
+// ChangePasswordHandler changes the password of the logged-in superuser and revokes their other sessions
+func (h *SuperUserFiberHandler) ChangePasswordHandler(c *fiber.Ctx) error {
+	userID, ok := userIDFromFiberContext(c)
+	if !ok {
+		return nil
+	}
+
+	var req utils.ChangePasswordRequest
+	if err := c.BodyParser(&req); err != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Invalid input", nil, err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+	if err := validators.ValidateChangePasswordRequest(req); err != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Validation error", nil, err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+
+	loggedInSuperUser, err := h.service.ChangePassword(c.Context(), userID, &req)
+	if err != nil {
+		switch {
+		case newerrors.IsValidationError(err):
+			response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Failed to change password", nil, err.Error())
+			return c.Status(fiber.StatusBadRequest).JSON(response)
+		default:
+			response := responses.NewFiberResponse(c, fiber.StatusInternalServerError, "Failed to change password", nil, err.Error())
+			return c.Status(fiber.StatusInternalServerError).JSON(response)
+		}
+	}
+
+	// The change revoked every session, including the token in the cookie; keep this one logged in
+	setAuthTokenFiberCookie(c, loggedInSuperUser.Role, loggedInSuperUser.Token)
+
+	response := responses.NewFiberResponse(c, fiber.StatusOK, "Password changed successfully", nil, nil)
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// UpdateProfileHandler updates the profile of the logged-in superuser
+func (h *SuperUserFiberHandler) UpdateProfileHandler(c *fiber.Ctx) error {
+	userID, ok := userIDFromFiberContext(c)
+	if !ok {
+		return nil
+	}
+
+	var req utils.UpdateProfileRequest
+	if err := c.BodyParser(&req); err != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Invalid input", nil, err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+	if err := validators.ValidateUpdateProfileRequest(req); err != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Validation error", nil, err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+
+	superUser, err := h.service.UpdateProfile(c.Context(), userID, &req)
+	if err != nil {
+		switch {
+		case newerrors.IsValidationError(err):
+			response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Failed to update profile", nil, err.Error())
+			return c.Status(fiber.StatusBadRequest).JSON(response)
+		default:
+			response := responses.NewFiberResponse(c, fiber.StatusInternalServerError, "Failed to update profile", nil, err.Error())
+			return c.Status(fiber.StatusInternalServerError).JSON(response)
+		}
+	}
+
+	response := responses.NewFiberResponse(c, fiber.StatusOK, "Profile updated successfully", superUser, nil)
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// ChangeEmailHandler sends a link to the new email address of the logged-in superuser; the change takes
+// effect once the link is opened
+func (h *SuperUserFiberHandler) ChangeEmailHandler(c *fiber.Ctx) error {
+	userID, ok := userIDFromFiberContext(c)
+	if !ok {
+		return nil
+	}
+
+	var req utils.ChangeEmailRequest
+	if err := c.BodyParser(&req); err != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Invalid input", nil, err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+	if err := validators.ValidateChangeEmailRequest(req); err != nil {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Validation error", nil, err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+
+	if err := h.service.RequestEmailChange(c.Context(), userID, &req); err != nil {
+		switch {
+		case newerrors.IsValidationError(err):
+			response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Failed to change email", nil, err.Error())
+			return c.Status(fiber.StatusBadRequest).JSON(response)
+		case newerrors.IsConflictError(err):
+			response := responses.NewFiberResponse(c, fiber.StatusConflict, "Failed to change email", nil, err.Error())
+			return c.Status(fiber.StatusConflict).JSON(response)
+		default:
+			response := responses.NewFiberResponse(c, fiber.StatusInternalServerError, "Failed to change email", nil, err.Error())
+			return c.Status(fiber.StatusInternalServerError).JSON(response)
+		}
+	}
+
+	response := responses.NewFiberResponse(c, fiber.StatusAccepted, "Verification email sent to the new address", nil, nil)
+	return c.Status(fiber.StatusAccepted).JSON(response)
+}
+
+// ConfirmEmailChangeHandler makes the email change verified by the token from the link sent to the new address
+func (h *SuperUserFiberHandler) ConfirmEmailChangeHandler(c *fiber.Ctx) error {
+	token := c.Query("token")
+	if token == "" {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Token is required", nil, nil)
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+
+	superUser, err := h.service.ConfirmEmailChange(c.Context(), token)
+	if err != nil {
+		switch {
+		case newerrors.IsValidationError(err):
+			response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Failed to confirm email change", nil, err.Error())
+			return c.Status(fiber.StatusBadRequest).JSON(response)
+		case newerrors.IsConflictError(err):
+			response := responses.NewFiberResponse(c, fiber.StatusConflict, "Failed to confirm email change", nil, err.Error())
+			return c.Status(fiber.StatusConflict).JSON(response)
+		default:
+			response := responses.NewFiberResponse(c, fiber.StatusInternalServerError, "Failed to confirm email change", nil, err.Error())
+			return c.Status(fiber.StatusInternalServerError).JSON(response)
+		}
+	}
+
+	response := responses.NewFiberResponse(c, fiber.StatusOK, "Email address changed successfully", superUser, nil)
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// UndoEmailChangeHandler restores the old email address with the token from the notice sent to it
+func (h *SuperUserFiberHandler) UndoEmailChangeHandler(c *fiber.Ctx) error {
+	token := c.Query("token")
+	if token == "" {
+		response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Token is required", nil, nil)
+		return c.Status(fiber.StatusBadRequest).JSON(response)
+	}
+
+	if err := h.service.UndoEmailChange(c.Context(), token); err != nil {
+		switch {
+		case newerrors.IsValidationError(err):
+			response := responses.NewFiberResponse(c, fiber.StatusBadRequest, "Failed to undo email change", nil, err.Error())
+			return c.Status(fiber.StatusBadRequest).JSON(response)
+		case newerrors.IsConflictError(err):
+			response := responses.NewFiberResponse(c, fiber.StatusConflict, "Failed to undo email change", nil, err.Error())
+			return c.Status(fiber.StatusConflict).JSON(response)
+		default:
+			response := responses.NewFiberResponse(c, fiber.StatusInternalServerError, "Failed to undo email change", nil, err.Error())
+			return c.Status(fiber.StatusInternalServerError).JSON(response)
+		}
+	}
+
+	response := responses.NewFiberResponse(c, fiber.StatusOK, "Email change undone; check your email to set a new password", nil, nil)
+	return c.Status(fiber.StatusOK).JSON(response)
+}
@@ -7,9 +7,11 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
 	"github.com/lordofthemind/EventureGo/internals/responses"
 	"github.com/lordofthemind/EventureGo/internals/services"
 	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
 )
 
 type SuperUserGinHandler struct {
@@ -39,6 +41,9 @@ func (h *SuperUserGinHandler) RegisterSuperUserHandler(c *gin.Context) {
 		if err.Error() == "email already in use" || err.Error() == "username already in use" {
 			response := responses.NewGinResponse(c, http.StatusConflict, err.Error(), nil, nil)
 			c.JSON(http.StatusConflict, response)
+		} else if newerrors.IsValidationError(err) {
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Failed to register superuser", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
 		} else {
 			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to register superuser", nil, err.Error())
 			c.JSON(http.StatusInternalServerError, response)
@@ -92,6 +97,9 @@ func (h *SuperUserGinHandler) LogInSuperUserHandler(c *gin.Context) {
 		if err.Error() == "invalid email/username or password" {
 			response := responses.NewGinResponse(c, http.StatusUnauthorized, "Invalid email/username or password", nil, nil)
 			c.JSON(http.StatusUnauthorized, response)
+		} else if newerrors.IsForbiddenError(err) {
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Login not allowed", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
 		} else {
 			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to log in", nil, err.Error())
 			c.JSON(http.StatusInternalServerError, response)
@@ -99,19 +107,8 @@ func (h *SuperUserGinHandler) LogInSuperUserHandler(c *gin.Context) {
 		return
 	}
 
-	// Generate dynamic cookie name using the superuser's role
-	cookieName := loggedInSuperUser.Role + "|_|" + configs.TokenBaseCookieName
-
-	c.SetSameSite(http.SameSiteLaxMode)
-	c.SetCookie(
-		cookieName,
-		loggedInSuperUser.Token,
-		int(configs.TokenExpiryDuration.Seconds()),
-		"/",
-		"",
-		configs.SecureCookieHTTPS,
-		true,
-	)
+	// Store the token in a cookie named after the superuser's role
+	setAuthTokenGinCookie(c, loggedInSuperUser.Role, loggedInSuperUser.Token)
 
 	// Use standardized response for successful login
 	response := responses.NewGinResponse(c, http.StatusOK, "Login successful", loggedInSuperUser, nil)
@@ -175,7 +172,7 @@ func (h *SuperUserGinHandler) PasswordResetRequestHandler(c *gin.Context) {
 // PasswordResetHandler handles the password reset using a token
 func (h *SuperUserGinHandler) PasswordResetHandler(c *gin.Context) {
 	var request struct {
-		Password string `json:"password" binding:"required,min=8"`
+		Password string `json:"password" binding:"required"`
 	}
 
 	// Bind and validate the request payload
@@ -196,8 +193,13 @@ func (h *SuperUserGinHandler) PasswordResetHandler(c *gin.Context) {
 	// Call the service to reset the password
 	err := h.service.ResetPassword(c.Request.Context(), token, request.Password)
 	if err != nil {
-		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to reset password", nil, err.Error())
-		c.JSON(http.StatusInternalServerError, response)
+		if newerrors.IsValidationError(err) {
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Failed to reset password", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		} else {
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to reset password", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
 		return
 	}
 
@@ -206,6 +208,177 @@ func (h *SuperUserGinHandler) PasswordResetHandler(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// ChangePasswordHandler changes the password of the logged-in superuser and revokes their other sessions
+func (h *SuperUserGinHandler) ChangePasswordHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+
+	var req utils.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid input", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+	if err := validators.ValidateChangePasswordRequest(req); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	loggedInSuperUser, err := h.service.ChangePassword(c.Request.Context(), userID, &req)
+	if err != nil {
+		switch {
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Failed to change password", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to change password", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	// The change revoked every session, including the token in the cookie; keep this one logged in
+	setAuthTokenGinCookie(c, loggedInSuperUser.Role, loggedInSuperUser.Token)
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Password changed successfully", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateProfileHandler updates the profile of the logged-in superuser
+func (h *SuperUserGinHandler) UpdateProfileHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+
+	var req utils.UpdateProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid input", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+	if err := validators.ValidateUpdateProfileRequest(req); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	superUser, err := h.service.UpdateProfile(c.Request.Context(), userID, &req)
+	if err != nil {
+		switch {
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Failed to update profile", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to update profile", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Profile updated successfully", superUser, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// ChangeEmailHandler sends a link to the new email address of the logged-in superuser; the change takes
+// effect once the link is opened
+func (h *SuperUserGinHandler) ChangeEmailHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+
+	var req utils.ChangeEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid input", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+	if err := validators.ValidateChangeEmailRequest(req); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if err := h.service.RequestEmailChange(c.Request.Context(), userID, &req); err != nil {
+		switch {
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Failed to change email", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		case newerrors.IsConflictError(err):
+			response := responses.NewGinResponse(c, http.StatusConflict, "Failed to change email", nil, err.Error())
+			c.JSON(http.StatusConflict, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to change email", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusAccepted, "Verification email sent to the new address", nil, nil)
+	c.JSON(http.StatusAccepted, response)
+}
+
+// ConfirmEmailChangeHandler makes the email change verified by the token from the link sent to the new address
+func (h *SuperUserGinHandler) ConfirmEmailChangeHandler(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Token is required", nil, nil)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	superUser, err := h.service.ConfirmEmailChange(c.Request.Context(), token)
+	if err != nil {
+		switch {
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Failed to confirm email change", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		case newerrors.IsConflictError(err):
+			response := responses.NewGinResponse(c, http.StatusConflict, "Failed to confirm email change", nil, err.Error())
+			c.JSON(http.StatusConflict, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to confirm email change", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Email address changed successfully", superUser, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// UndoEmailChangeHandler restores the old email address with the token from the notice sent to it
+func (h *SuperUserGinHandler) UndoEmailChangeHandler(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Token is required", nil, nil)
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if err := h.service.UndoEmailChange(c.Request.Context(), token); err != nil {
+		switch {
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Failed to undo email change", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		case newerrors.IsConflictError(err):
+			response := responses.NewGinResponse(c, http.StatusConflict, "Failed to undo email change", nil, err.Error())
+			c.JSON(http.StatusConflict, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to undo email change", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Email change undone; check your email to set a new password", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
 // LogContextHandler is a test handler that logs the values set in the context by the middleware
 func (h *SuperUserGinHandler) LogContextHandler(c *gin.Context) {
 	// Retrieve values from the context
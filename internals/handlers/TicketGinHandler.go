@@ -0,0 +1,211 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
+)
+
+type TicketGinHandler struct {
+	service services.TicketServiceInterface
+}
+
+func NewTicketGinHandler(service services.TicketServiceInterface) *TicketGinHandler {
+	return &TicketGinHandler{
+		service: service,
+	}
+}
+
+// CheckInHandler admits the guest of a ticket scanned at the door
+func (h *TicketGinHandler) CheckInHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var checkInRequest utils.CheckInRequest
+	if err := c.ShouldBindJSON(&checkInRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	result, err := h.service.CheckInGuestService(c.Request.Context(), userID, eventID, utils.TransformToCheckInScanDTO(checkInRequest))
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to check in guest", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	switch result.Status {
+	case utils.CheckInStatusCheckedIn:
+		response := responses.NewGinResponse(c, http.StatusOK, "Guest checked in", result, nil)
+		c.JSON(http.StatusOK, response)
+	case utils.CheckInStatusInvalid:
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid ticket", result, result.Error)
+		c.JSON(http.StatusBadRequest, response)
+	default:
+		response := responses.NewGinResponse(c, http.StatusConflict, "Ticket cannot be used", result, result.Error)
+		c.JSON(http.StatusConflict, response)
+	}
+}
+
+// SyncCheckInsHandler replays the scans a check-in app made while offline and reports each outcome
+func (h *TicketGinHandler) SyncCheckInsHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var syncRequest utils.SyncCheckInsRequest
+	if err := c.ShouldBindJSON(&syncRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	scans := utils.TransformToCheckInScanDTOs(syncRequest)
+	if validationErr := validators.ValidateCheckInSync(scans); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	results, err := h.service.SyncCheckInsService(c.Request.Context(), userID, eventID, scans)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to sync check-ins", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Check-ins synced successfully", results, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// CheckInManifestHandler returns the public key and ticket states a check-in app caches for offline use
+func (h *TicketGinHandler) CheckInManifestHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	manifest, err := h.service.CheckInManifestService(c.Request.Context(), userID, eventID)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to load check-in manifest", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Check-in manifest retrieved successfully", manifest, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// RevokeTicketHandler lets the organizer cancel a guest's ticket so it is refused at the door
+func (h *TicketGinHandler) RevokeTicketHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+	guestID, ok := uuidParamFromGinContext(c, "guestId")
+	if !ok {
+		return
+	}
+
+	// The body is optional
+	var revokeRequest utils.RevokeTicketRequest
+	if err := c.ShouldBindJSON(&revokeRequest); err != nil && !errors.Is(err, io.EOF) {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	ticket, err := h.service.RevokeGuestTicketService(c.Request.Context(), userID, eventID, guestID, revokeRequest.Reason)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		case newerrors.IsConflictError(err):
+			response := responses.NewGinResponse(c, http.StatusConflict, "Ticket changed concurrently", nil, err.Error())
+			c.JSON(http.StatusConflict, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to revoke ticket", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Ticket revoked successfully", ticket, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// TicketQRCodeHandler serves the QR image of a ticket code; it is public so the link in the ticket email works
+func (h *TicketGinHandler) TicketQRCodeHandler(c *gin.Context) {
+	png, err := h.service.TicketQRCodeService(c.Param("code"))
+	if err != nil {
+		if newerrors.IsValidationError(err) {
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Ticket not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+			return
+		}
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to render ticket", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	c.Header("Cache-Control", "private, max-age=86400")
+	c.Data(http.StatusOK, "image/png", png)
+}
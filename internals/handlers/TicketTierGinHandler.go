@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
+)
+
+type TicketTierGinHandler struct {
+	service services.TicketTierServiceInterface
+}
+
+func NewTicketTierGinHandler(service services.TicketTierServiceInterface) *TicketTierGinHandler {
+	return &TicketTierGinHandler{
+		service: service,
+	}
+}
+
+// CreateTicketTierHandler adds a ticket tier to an event of the current user
+func (h *TicketTierGinHandler) CreateTicketTierHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+	tierDTO, ok := bindTicketTierRequest(c)
+	if !ok {
+		return
+	}
+
+	tier, err := h.service.CreateTicketTierService(c.Request.Context(), userID, eventID, tierDTO)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Event not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to create ticket tier", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusCreated, "Ticket tier created successfully", utils.TransformToTicketTierResponse(tier), nil)
+	c.JSON(http.StatusCreated, response)
+}
+
+// ListTicketTiersHandler lists the ticket tiers of an event with how many tickets are left
+func (h *TicketTierGinHandler) ListTicketTiersHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	tiers, err := h.service.FindTicketTiersService(c.Request.Context(), userID, eventID)
+	if err != nil {
+		if newerrors.IsValidationError(err) {
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Event not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+			return
+		}
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to list ticket tiers", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Ticket tiers retrieved successfully", utils.TransformToTicketTierResponses(tiers), nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateTicketTierHandler replaces the details of a ticket tier
+func (h *TicketTierGinHandler) UpdateTicketTierHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+	tierID, ok := uuidParamFromGinContext(c, "tierId")
+	if !ok {
+		return
+	}
+	tierDTO, ok := bindTicketTierRequest(c)
+	if !ok {
+		return
+	}
+
+	tier, err := h.service.UpdateTicketTierService(c.Request.Context(), userID, eventID, tierID, tierDTO)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Ticket tier not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		case newerrors.IsConflictError(err):
+			response := responses.NewGinResponse(c, http.StatusConflict, "Tickets already sold", nil, err.Error())
+			c.JSON(http.StatusConflict, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to update ticket tier", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Ticket tier updated successfully", utils.TransformToTicketTierResponse(tier), nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteTicketTierHandler removes a ticket tier nobody has ordered from
+func (h *TicketTierGinHandler) DeleteTicketTierHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	eventID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+	tierID, ok := uuidParamFromGinContext(c, "tierId")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteTicketTierService(c.Request.Context(), userID, eventID, tierID); err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Ticket tier not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		case newerrors.IsConflictError(err):
+			response := responses.NewGinResponse(c, http.StatusConflict, "Ticket tier has orders", nil, err.Error())
+			c.JSON(http.StatusConflict, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to delete ticket tier", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Ticket tier deleted successfully", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// bindTicketTierRequest reads and validates a ticket tier from the request body, answering the request itself when it is invalid
+func bindTicketTierRequest(c *gin.Context) (*utils.TicketTierDTO, bool) {
+	var tierRequest utils.TicketTierRequest
+	if err := c.ShouldBindJSON(&tierRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return nil, false
+	}
+
+	tierDTO := utils.TransformToTicketTierDTO(tierRequest)
+	if validationErr := validators.ValidateTicketTierRequest(tierDTO); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return nil, false
+	}
+	return tierDTO, true
+}
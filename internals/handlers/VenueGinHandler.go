@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
+)
+
+type VenueGinHandler struct {
+	service services.VenueServiceInterface
+}
+
+func NewVenueGinHandler(service services.VenueServiceInterface) *VenueGinHandler {
+	return &VenueGinHandler{
+		service: service,
+	}
+}
+
+// CreateVenueHandler handles the creation of a new venue
+func (h *VenueGinHandler) CreateVenueHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+
+	var venueRequest utils.RegisterVenueRequest
+	if err := c.ShouldBindJSON(&venueRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if validationErr := validators.ValidateVenueRequest(venueRequest); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	createdVenue, err := h.service.CreateVenueService(c.Request.Context(), userID, utils.TransformToVenueDTO(venueRequest))
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to create venue", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusCreated, "Venue created successfully", utils.TransformToVenueResponse(createdVenue), nil)
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetVenueHandler returns a single venue by ID
+func (h *VenueGinHandler) GetVenueHandler(c *gin.Context) {
+	venueID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	venue, err := h.service.FindVenueByIDService(c.Request.Context(), venueID)
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusNotFound, "Venue not found", nil, err.Error())
+		c.JSON(http.StatusNotFound, response)
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Venue retrieved successfully", utils.TransformToVenueResponse(venue), nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// FindVenuesNearHandler returns venues within a radius of the given coordinates
+func (h *VenueGinHandler) FindVenuesNearHandler(c *gin.Context) {
+	var query utils.GeoRadiusQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid query parameters", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if validationErr := validators.ValidateGeoRadiusQuery(query); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	venues, err := h.service.FindVenuesNearService(c.Request.Context(), *query.Latitude, *query.Longitude, query.RadiusKm)
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to search venues", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	venueResponses := make([]*utils.VenueResponse, 0, len(venues))
+	for _, venue := range venues {
+		venueResponses = append(venueResponses, utils.TransformToVenueResponse(venue))
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Venues retrieved successfully", venueResponses, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// FindVenuesWithinBoundsHandler returns venues inside a bounding box
+func (h *VenueGinHandler) FindVenuesWithinBoundsHandler(c *gin.Context) {
+	var query utils.GeoBoundingBoxQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid query parameters", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if validationErr := validators.ValidateGeoBoundingBoxQuery(query); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	venues, err := h.service.FindVenuesWithinBoundingBoxService(c.Request.Context(), *query.MinLatitude, *query.MinLongitude, *query.MaxLatitude, *query.MaxLongitude)
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to search venues", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	venueResponses := make([]*utils.VenueResponse, 0, len(venues))
+	for _, venue := range venues {
+		venueResponses = append(venueResponses, utils.TransformToVenueResponse(venue))
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Venues retrieved successfully", venueResponses, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// FindVenueFreeSlotsHandler lists the free time of a venue on a given day
+func (h *VenueGinHandler) FindVenueFreeSlotsHandler(c *gin.Context) {
+	venueID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var query utils.FreeSlotsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid query parameters", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if validationErr := validators.ValidateFreeSlotsQuery(query); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	freeSlots, err := h.service.FindVenueFreeSlotsService(c.Request.Context(), venueID, query.Date, time.Duration(query.MinMinutes)*time.Minute)
+	if err != nil {
+		if newerrors.IsValidationError(err) {
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Venue not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+			return
+		}
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to find free slots", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Free slots retrieved successfully", freeSlots, nil)
+	c.JSON(http.StatusOK, response)
+}
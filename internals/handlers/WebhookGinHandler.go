@@ -0,0 +1,255 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/EventureGo/internals/validators"
+)
+
+type WebhookGinHandler struct {
+	service services.WebhookServiceInterface
+}
+
+func NewWebhookGinHandler(service services.WebhookServiceInterface) *WebhookGinHandler {
+	return &WebhookGinHandler{
+		service: service,
+	}
+}
+
+// CreateWebhookHandler registers a webhook for the events of the current user and returns its signing secret once
+func (h *WebhookGinHandler) CreateWebhookHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+
+	var webhookRequest utils.RegisterWebhookRequest
+	if err := c.ShouldBindJSON(&webhookRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if validationErr := validators.ValidateWebhookRequest(webhookRequest); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	webhook, err := h.service.CreateWebhookService(c.Request.Context(), userID, utils.TransformToWebhookDTO(webhookRequest))
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to create webhook", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusCreated, "Webhook created successfully", utils.TransformToCreatedWebhookResponse(webhook), nil)
+	c.JSON(http.StatusCreated, response)
+}
+
+// ListWebhooksHandler lists the webhooks of the current user
+func (h *WebhookGinHandler) ListWebhooksHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+
+	webhooks, err := h.service.FindWebhooksService(c.Request.Context(), userID)
+	if err != nil {
+		response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to list webhooks", nil, err.Error())
+		c.JSON(http.StatusInternalServerError, response)
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Webhooks retrieved successfully", utils.TransformToWebhookResponses(webhooks), nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetWebhookHandler returns a single webhook of the current user
+func (h *WebhookGinHandler) GetWebhookHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	webhookID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	webhook, err := h.service.FindWebhookService(c.Request.Context(), userID, webhookID)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Webhook not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Webhook retrieved successfully", utils.TransformToWebhookResponse(webhook), nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateWebhookHandler changes the URL, event types, description or active flag of a webhook
+func (h *WebhookGinHandler) UpdateWebhookHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	webhookID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var updateRequest utils.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&updateRequest); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid request body", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if validationErr := validators.ValidateUpdateWebhookRequest(updateRequest); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	webhook, err := h.service.UpdateWebhookService(c.Request.Context(), userID, webhookID, utils.TransformToUpdateWebhookDTO(updateRequest))
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Webhook not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to update webhook", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Webhook updated successfully", utils.TransformToWebhookResponse(webhook), nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteWebhookHandler removes a webhook together with its delivery log
+func (h *WebhookGinHandler) DeleteWebhookHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	webhookID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteWebhookService(c.Request.Context(), userID, webhookID); err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Webhook not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to delete webhook", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Webhook deleted successfully", nil, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// ListWebhookDeliveriesHandler lists the latest deliveries of a webhook with the outcome of their last attempt
+func (h *WebhookGinHandler) ListWebhookDeliveriesHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	webhookID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+
+	var query utils.WebhookDeliveriesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Invalid query parameters", nil, err.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+
+	if validationErr := validators.ValidateWebhookDeliveriesQuery(query); validationErr != nil {
+		response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, validationErr.Error())
+		c.JSON(http.StatusBadRequest, response)
+		return
+	}
+	if query.Limit == 0 {
+		query.Limit = utils.DefaultWebhookDeliveriesLimit
+	}
+
+	deliveries, err := h.service.FindWebhookDeliveriesService(c.Request.Context(), userID, webhookID, query.Limit)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusNotFound, "Webhook not found", nil, err.Error())
+			c.JSON(http.StatusNotFound, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to list webhook deliveries", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusOK, "Webhook deliveries retrieved successfully", utils.TransformToWebhookDeliveryResponses(deliveries), nil)
+	c.JSON(http.StatusOK, response)
+}
+
+// RedeliverWebhookHandler sends an earlier delivery again and returns the new delivery after its first attempt
+func (h *WebhookGinHandler) RedeliverWebhookHandler(c *gin.Context) {
+	userID, ok := userIDFromGinContext(c)
+	if !ok {
+		return
+	}
+	webhookID, ok := uuidParamFromGinContext(c, "id")
+	if !ok {
+		return
+	}
+	deliveryID, ok := uuidParamFromGinContext(c, "deliveryId")
+	if !ok {
+		return
+	}
+
+	delivery, err := h.service.RedeliverWebhookService(c.Request.Context(), userID, webhookID, deliveryID)
+	if err != nil {
+		switch {
+		case newerrors.IsForbiddenError(err):
+			response := responses.NewGinResponse(c, http.StatusForbidden, "Forbidden", nil, err.Error())
+			c.JSON(http.StatusForbidden, response)
+		case newerrors.IsValidationError(err):
+			response := responses.NewGinResponse(c, http.StatusBadRequest, "Validation error", nil, err.Error())
+			c.JSON(http.StatusBadRequest, response)
+		default:
+			response := responses.NewGinResponse(c, http.StatusInternalServerError, "Failed to redeliver webhook", nil, err.Error())
+			c.JSON(http.StatusInternalServerError, response)
+		}
+		return
+	}
+
+	response := responses.NewGinResponse(c, http.StatusCreated, "Webhook redelivered", utils.TransformToWebhookDeliveryResponse(delivery), nil)
+	c.JSON(http.StatusCreated, response)
+}
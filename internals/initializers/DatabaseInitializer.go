@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/repositories/postgresdb"
 	"github.com/lordofthemind/EventureGo/internals/types"
 
 	"github.com/lordofthemind/mygopher/gophermongo"
@@ -27,8 +28,15 @@ func DatabaseInitializer() {
 			log.Fatalf("Failed to confirm UUID extension: %v", err)
 		}
 
+		// Enable PostGIS, or earthdistance as a fallback, for venue radius queries
+		geoExtension, err := postgresdb.EnableGeoExtension(gormDB, configs.PostgresGeoExtension)
+		if err != nil {
+			log.Fatalf("Failed to enable geo extension: %v", err)
+		}
+		configs.PostgresGeoExtension = geoExtension
+
 		// Auto migrate for GORM (Postgres)
-		if err := gormDB.AutoMigrate(&types.SuperUserType{}, &types.EventType{}, &types.GuestType{}); err != nil {
+		if err := gormDB.AutoMigrate(&types.SuperUserType{}, &types.VenueType{}, &types.EventType{}, &types.GuestType{}, &types.ReminderType{}, &types.JobType{}, &types.JobRunType{}, &types.TicketType{}, &types.WebhookType{}, &types.WebhookDeliveryType{}, &types.TicketTierType{}, &types.OrderType{}, &types.PromoCodeType{}, &types.PromoRedemptionType{}, &types.RegistrationFormType{}, &types.AuditRecordType{}, &types.OrganizationType{}, &types.OrganizationMemberType{}, &types.OrganizationInvitationType{}, &types.EventGrantType{}, &types.LoginChallengeType{}); err != nil {
 			log.Fatalf("Failed to migrate Postgres database: %v", err)
 		}
 
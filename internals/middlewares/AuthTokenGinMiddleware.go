@@ -29,14 +29,7 @@ func AuthTokenGinMiddleware(tokenManager gophertoken.TokenManager) gin.HandlerFu
 
 		// If no token is found, return unauthorized
 		if !found {
-			response := responses.NewGinResponse(
-				c,
-				http.StatusUnauthorized,
-				"Unauthorized",
-				nil,
-				"Failed to find a valid role-based token in cookies",
-			)
-			c.JSON(http.StatusUnauthorized, response)
+			responses.RespondGinError(c, http.StatusUnauthorized, "Unauthorized", "Failed to find a valid role-based token in cookies")
 			c.Abort()
 			return
 		}
@@ -44,14 +37,7 @@ func AuthTokenGinMiddleware(tokenManager gophertoken.TokenManager) gin.HandlerFu
 		// Validate the token
 		payload, err := tokenManager.ValidateToken(token)
 		if err != nil {
-			response := responses.NewGinResponse(
-				c,
-				http.StatusUnauthorized,
-				"Invalid token",
-				nil,
-				err.Error(),
-			)
-			c.JSON(http.StatusUnauthorized, response)
+			responses.RespondGinError(c, http.StatusUnauthorized, "Invalid token", err.Error())
 			c.Abort()
 			return
 		}
@@ -0,0 +1,14 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+)
+
+// HTMLRendererGinMiddleware makes the renderer available to responses.RespondGin, so handlers can answer browsers and HTMX with HTML
+func HTMLRendererGinMiddleware(renderer *responses.HTMLRenderer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(responses.HTMLRendererContextKey, renderer)
+		c.Next()
+	}
+}
@@ -0,0 +1,56 @@
+package middlewares
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+)
+
+// RateLimitFiberMiddleware lets each client IP make at most limit requests per window and answers the rest with
+// 429 Too Many Requests. Counts are kept in memory, so every instance limits on its own. A limit of 0 or less
+// disables the middleware.
+func RateLimitFiberMiddleware(limit int, window time.Duration) fiber.Handler {
+	if limit <= 0 || window <= 0 {
+		return func(c *fiber.Ctx) error { return c.Next() }
+	}
+
+	var mu sync.Mutex
+	windows := make(map[string]*rateLimitWindow)
+	lastSweep := time.Now()
+
+	return func(c *fiber.Ctx) error {
+		now := time.Now()
+		clientIP := c.IP()
+
+		mu.Lock()
+		// Forget clients whose window has passed, at most once per window
+		if now.Sub(lastSweep) >= window {
+			for ip, w := range windows {
+				if now.Sub(w.startedAt) >= window {
+					delete(windows, ip)
+				}
+			}
+			lastSweep = now
+		}
+		w, exists := windows[clientIP]
+		if !exists || now.Sub(w.startedAt) >= window {
+			w = &rateLimitWindow{startedAt: now}
+			windows[clientIP] = w
+		}
+		w.requests++
+		allowed := w.requests <= limit
+		retryAfter := w.startedAt.Add(window).Sub(now)
+		mu.Unlock()
+
+		if allowed {
+			return c.Next()
+		}
+
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(retryAfter.Seconds())+1))
+		response := responses.NewFiberResponse(c, fiber.StatusTooManyRequests, "Too many requests", nil, "rate limit exceeded, please try again later")
+		return c.Status(fiber.StatusTooManyRequests).JSON(response)
+	}
+}
@@ -0,0 +1,70 @@
+package middlewares
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+)
+
+// rateLimitWindow counts the requests of one client since the window started
+type rateLimitWindow struct {
+	startedAt time.Time
+	requests  int
+}
+
+// RateLimitGinMiddleware lets each client IP make at most limit requests per window and answers the rest with
+// 429 Too Many Requests. Counts are kept in memory, so every instance limits on its own. A limit of 0 or less
+// disables the middleware.
+func RateLimitGinMiddleware(limit int, window time.Duration) gin.HandlerFunc {
+	if limit <= 0 || window <= 0 {
+		return func(c *gin.Context) { c.Next() }
+	}
+
+	var mu sync.Mutex
+	windows := make(map[string]*rateLimitWindow)
+	lastSweep := time.Now()
+
+	return func(c *gin.Context) {
+		now := time.Now()
+		clientIP := c.ClientIP()
+
+		mu.Lock()
+		// Forget clients whose window has passed, at most once per window
+		if now.Sub(lastSweep) >= window {
+			for ip, w := range windows {
+				if now.Sub(w.startedAt) >= window {
+					delete(windows, ip)
+				}
+			}
+			lastSweep = now
+		}
+		w, exists := windows[clientIP]
+		if !exists || now.Sub(w.startedAt) >= window {
+			w = &rateLimitWindow{startedAt: now}
+			windows[clientIP] = w
+		}
+		w.requests++
+		allowed := w.requests <= limit
+		retryAfter := w.startedAt.Add(window).Sub(now)
+		mu.Unlock()
+
+		if allowed {
+			c.Next()
+			return
+		}
+
+		c.Header("Retry-After", strconv.Itoa(int(retryAfter.Seconds())+1))
+		if strings.Contains(c.GetHeader("Accept"), "text/html") {
+			c.String(http.StatusTooManyRequests, "Too many requests, please try again later.")
+		} else {
+			response := responses.NewGinResponse(c, http.StatusTooManyRequests, "Too many requests", nil, "rate limit exceeded, please try again later")
+			c.JSON(http.StatusTooManyRequests, response)
+		}
+		c.Abort()
+	}
+}
@@ -3,6 +3,7 @@ package middlewares
 import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/utils"
 )
 
 func RequestIDFiberMiddleware() fiber.Handler {
@@ -18,6 +19,9 @@ func RequestIDFiberMiddleware() fiber.Handler {
 		// Add the request ID to the context
 		c.Locals("RequestID", requestID)
 
+		// Services see the request ID and client IP through the request context, e.g. for the audit log
+		c.Locals(utils.RequestInfoContextKey, utils.RequestInfo{RequestID: requestID, ClientIP: c.IP()})
+
 		// Add the request ID to the response header
 		c.Set("X-Request-ID", requestID)
 
@@ -3,6 +3,7 @@ package middlewares
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/utils"
 )
 
 // RequestIDMiddleware generates a request ID and adds it to the context and response
@@ -18,6 +19,10 @@ func RequestIDGinMiddleware() gin.HandlerFunc {
 		// Add the request ID to the context
 		c.Set("RequestID", requestID)
 
+		// Services see the request ID and client IP through the request context, e.g. for the audit log
+		info := utils.RequestInfo{RequestID: requestID, ClientIP: c.ClientIP()}
+		c.Request = c.Request.WithContext(utils.ContextWithRequestInfo(c.Request.Context(), info))
+
 		// Add the request ID to the response header
 		c.Writer.Header().Set("X-Request-ID", requestID)
 
@@ -0,0 +1,34 @@
+package middlewares
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+)
+
+// RequireRoleFiberMiddleware only lets through active superusers whose stored role is one of roles.
+// It must run after AuthTokenFiberMiddleware; the role is read from the database because the role in the
+// cookie name is chosen by the client.
+func RequireRoleFiberMiddleware(superUserService services.SuperUserServiceInterface, roles ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, ok := c.Locals("userID").(uuid.UUID)
+		if !ok {
+			response := responses.NewFiberResponse(c, fiber.StatusUnauthorized, "Unauthorized", nil, "User ID not found in context")
+			return c.Status(fiber.StatusUnauthorized).JSON(response)
+		}
+
+		superUser, err := superUserService.FindSuperUserByID(c.Context(), userID)
+		if err == nil && superUser.IsActive {
+			for _, role := range roles {
+				if superUser.Role == role {
+					c.Locals("role", superUser.Role)
+					return c.Next()
+				}
+			}
+		}
+
+		response := responses.NewFiberResponse(c, fiber.StatusForbidden, "Forbidden", nil, "You do not have permission to access this resource")
+		return c.Status(fiber.StatusForbidden).JSON(response)
+	}
+}
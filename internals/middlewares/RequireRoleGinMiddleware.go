@@ -0,0 +1,39 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+)
+
+// RequireRoleGinMiddleware only lets through active superusers whose stored role is one of roles.
+// It must run after AuthTokenGinMiddleware; the role is read from the database because the role in the
+// cookie name is chosen by the client.
+func RequireRoleGinMiddleware(superUserService services.SuperUserServiceInterface, roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, _ := c.Get("userID")
+		userID, ok := value.(uuid.UUID)
+		if !ok {
+			responses.RespondGinError(c, http.StatusUnauthorized, "Unauthorized", "User ID not found in context")
+			c.Abort()
+			return
+		}
+
+		superUser, err := superUserService.FindSuperUserByID(c.Request.Context(), userID)
+		if err == nil && superUser.IsActive {
+			for _, role := range roles {
+				if superUser.Role == role {
+					c.Set("role", superUser.Role)
+					c.Next()
+					return
+				}
+			}
+		}
+
+		responses.RespondGinError(c, http.StatusForbidden, "Forbidden", "You do not have permission to access this resource")
+		c.Abort()
+	}
+}
@@ -0,0 +1,47 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/responses"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// TenantScopeGinMiddleware limits the event and guest queries of a request to the user's active organization,
+// or to personal events when none is active. It must run after AuthTokenGinMiddleware; membership is checked
+// against the database on every request, so removed members lose access right away.
+func TenantScopeGinMiddleware(organizationService services.OrganizationServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, _ := c.Get("userID")
+		userID, ok := value.(uuid.UUID)
+		if !ok {
+			responses.RespondGinError(c, http.StatusUnauthorized, "Unauthorized", "User ID not found in context")
+			c.Abort()
+			return
+		}
+
+		scope := repositories.TenantScope{}
+		if cookie, err := c.Cookie(utils.ActiveOrganizationCookieName()); err == nil && cookie != "" {
+			organizationID, err := uuid.Parse(cookie)
+			if err != nil {
+				responses.RespondGinError(c, http.StatusBadRequest, "Invalid active organization", err.Error())
+				c.Abort()
+				return
+			}
+			if _, err := organizationService.FindMembershipService(c.Request.Context(), userID, organizationID); err != nil {
+				responses.RespondGinError(c, http.StatusForbidden, "Forbidden", "You are no longer a member of the active organization; switch to another one")
+				c.Abort()
+				return
+			}
+			scope.OrganizationID = &organizationID
+			c.Set("organizationID", organizationID)
+		}
+
+		c.Request = c.Request.WithContext(repositories.ContextWithTenantScope(c.Request.Context(), scope))
+		c.Next()
+	}
+}
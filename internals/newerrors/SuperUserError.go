@@ -23,3 +23,39 @@ func IsValidationError(err error) bool {
 func Wrap(err error, message string) error {
 	return fmt.Errorf("%s: %w", message, err)
 }
+
+// ConflictError signals that an operation clashes with existing data, e.g. a double-booked venue
+type ConflictError struct {
+	Message string
+}
+
+func (e *ConflictError) Error() string {
+	return e.Message
+}
+
+func NewConflictError(message string) error {
+	return &ConflictError{Message: message}
+}
+
+func IsConflictError(err error) bool {
+	_, ok := err.(*ConflictError)
+	return ok
+}
+
+// ForbiddenError signals that the caller is not allowed to act on a resource
+type ForbiddenError struct {
+	Message string
+}
+
+func (e *ForbiddenError) Error() string {
+	return e.Message
+}
+
+func NewForbiddenError(message string) error {
+	return &ForbiddenError{Message: message}
+}
+
+func IsForbiddenError(err error) bool {
+	_, ok := err.(*ForbiddenError)
+	return ok
+}
@@ -0,0 +1,32 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// AuditRecordFilter narrows down an audit log listing; zero fields do not filter
+type AuditRecordFilter struct {
+	ActorID    *uuid.UUID // Only records of actions by this actor
+	Action     string     // Only records of this action
+	TargetType string     // Only records about targets of this type
+	TargetID   *uuid.UUID // Only records about this target
+	Since      *time.Time // Only records created at or after this time
+	Until      *time.Time // Only records created before this time
+}
+
+// AuditRepositoryInterface defines the methods for persisting the audit log
+type AuditRepositoryInterface interface {
+	// AppendAuditRecord chains a new record to the last one with record.ChainTo and stores it. Appends are
+	// serialized, so concurrent appends never chain to the same record; records are never changed afterwards.
+	AppendAuditRecord(ctx context.Context, record *types.AuditRecordType) error
+
+	// ListAuditRecords finds one page of the records matching a filter, latest first, and how many match in total
+	ListAuditRecords(ctx context.Context, filter AuditRecordFilter, offset, limit int) ([]*types.AuditRecordType, int64, error)
+
+	// FindAuditRecordsAfter finds up to limit records following the one at afterSequence, in chain order
+	FindAuditRecordsAfter(ctx context.Context, afterSequence int64, limit int) ([]*types.AuditRecordType, error)
+}
@@ -0,0 +1,30 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// ErrEventGrantExists is returned by CreateEventGrant when the user already holds a grant on the event
+var ErrEventGrantExists = errors.New("user already has access to the event")
+
+// EventGrantRepositoryInterface defines the methods for handling the access organizers delegate on their events
+type EventGrantRepositoryInterface interface {
+	// CreateEventGrant stores a new grant, or returns ErrEventGrantExists
+	CreateEventGrant(ctx context.Context, grant *types.EventGrantType) error
+
+	// FindEventGrant retrieves the grant of a user on an event, or nil when they hold none
+	FindEventGrant(ctx context.Context, eventID, superUserID uuid.UUID) (*types.EventGrantType, error)
+
+	// FindEventGrantsByEventID retrieves every grant on an event, oldest first
+	FindEventGrantsByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.EventGrantType, error)
+
+	// UpdateEventGrantRole stores the role of a grant
+	UpdateEventGrantRole(ctx context.Context, grant *types.EventGrantType) error
+
+	// DeleteEventGrant removes the grant of a user on an event
+	DeleteEventGrant(ctx context.Context, eventID, superUserID uuid.UUID) error
+}
@@ -2,13 +2,18 @@ package repositories
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/lordofthemind/EventureGo/internals/types"
 )
 
-// EventRepositoryInterface defines the methods for handling events in the system
+// ErrEventStatusChanged is returned by TransitionEventStatus when the event is no longer in the expected state
+var ErrEventStatusChanged = errors.New("event status was changed by another request")
+
+// EventRepositoryInterface defines the methods for handling events in the system. When ctx carries a tenant scope,
+// every method only sees and changes events of that tenant, and writing an event of another tenant fails with ErrOutsideTenant.
 type EventRepositoryInterface interface {
 	// CreateEvent creates a new event
 	CreateEvent(ctx context.Context, event *types.EventType) (*types.EventType, error)
@@ -16,6 +21,10 @@ type EventRepositoryInterface interface {
 	// FindEventByID finds an event by its ID
 	FindEventByID(ctx context.Context, eventID uuid.UUID) (*types.EventType, error)
 
+	// FindEventBySlug finds the event with the given public page slug, or nil when there is none.
+	// Slugs are public and unique across tenants, so the lookup ignores the tenant scope.
+	FindEventBySlug(ctx context.Context, slug string) (*types.EventType, error)
+
 	// FindEventsByOrganizerID retrieves events organized by a specific user
 	FindEventsByOrganizerID(ctx context.Context, organizerID uuid.UUID) ([]*types.EventType, error)
 
@@ -34,6 +43,13 @@ type EventRepositoryInterface interface {
 	// FindEventsByLocation finds events based on location
 	FindEventsByLocation(ctx context.Context, location string) ([]*types.EventType, error)
 
+	// FindEventsByVenueIDs retrieves events held at any of the specified venues
+	FindEventsByVenueIDs(ctx context.Context, venueIDs []uuid.UUID) ([]*types.EventType, error)
+
+	// FindOverlappingEvents retrieves active events at the venue or run by the organizer that may overlap [windowStart, windowEnd),
+	// including recurring series that started before the window; callers expand occurrences to confirm the overlap
+	FindOverlappingEvents(ctx context.Context, venueID, organizerID *uuid.UUID, windowStart, windowEnd time.Time) ([]*types.EventType, error)
+
 	// FindEventsByMultipleTags retrieves events that match any of the specified tags
 	FindEventsByMultipleTags(ctx context.Context, tags []string) ([]*types.EventType, error)
 
@@ -43,7 +59,7 @@ type EventRepositoryInterface interface {
 	// DeactivateEvent deactivates an event, making it inactive
 	DeactivateEvent(ctx context.Context, eventID uuid.UUID) error
 
-	// FindUpcomingEvents retrieves events scheduled for future dates
+	// FindUpcomingEvents retrieves events scheduled for future dates, including recurring series that may still have occurrences
 	FindUpcomingEvents(ctx context.Context) ([]*types.EventType, error)
 
 	// FindPastEvents retrieves events that have already occurred
@@ -58,10 +74,12 @@ type EventRepositoryInterface interface {
 	// SearchEventsByTitle finds events by searching their titles
 	SearchEventsByTitle(ctx context.Context, title string) ([]*types.EventType, error)
 
-	// CancelEvent cancels an event
-	CancelEvent(ctx context.Context, eventID uuid.UUID) error
+	// TransitionEventStatus moves an event from change.From to change.To and appends the change to its history.
+	// The update only applies while the event is still in change.From, otherwise ErrEventStatusChanged is returned.
+	// Cancelling also increments the iCalendar sequence.
+	TransitionEventStatus(ctx context.Context, eventID uuid.UUID, change *types.EventStatusChangeType) error
 
-	// RescheduleEvent reschedules an event to a new date and time
+	// RescheduleEvent reschedules an event to a new date and time and increments its iCalendar sequence
 	RescheduleEvent(ctx context.Context, eventID uuid.UUID, newStartTime, newEndTime time.Time) error
 
 	// CountTotalEvents returns the total number of events in the system
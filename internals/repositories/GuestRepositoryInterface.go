@@ -2,12 +2,17 @@ package repositories
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 	"github.com/lordofthemind/EventureGo/internals/types"
 )
 
-// GuestRepositoryInterface defines the methods for handling guests in the system
+// ErrEventFull is returned when a guest would accept an event whose every seat is already taken
+var ErrEventFull = errors.New("event is full")
+
+// GuestRepositoryInterface defines the methods for handling guests in the system. When ctx carries a tenant scope,
+// every method only sees and changes guests of that tenant, and writing a guest of another tenant fails with ErrOutsideTenant.
 type GuestRepositoryInterface interface {
 	// AddGuest adds a new guest to an event
 	AddGuest(ctx context.Context, guest *types.GuestType) (*types.GuestType, error)
@@ -18,15 +23,37 @@ type GuestRepositoryInterface interface {
 	// FindGuestsByEventID retrieves all guests for a given event
 	FindGuestsByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.GuestType, error)
 
+	// StreamGuestsByEventID calls visit for each guest of an event, ordered by name, without loading the whole list.
+	// An empty rsvpStatus matches every answer and a nil checkedIn matches guests whether or not they checked in.
+	// Iteration stops at the first error returned by visit.
+	StreamGuestsByEventID(ctx context.Context, eventID uuid.UUID, rsvpStatus string, checkedIn *bool, visit func(guest *types.GuestType) error) error
+
 	// FindGuestByID retrieves a guest by their ID
 	FindGuestByID(ctx context.Context, guestID uuid.UUID) (*types.GuestType, error)
 
+	// FindGuestByEventIDAndEmail retrieves the guest of an event with the given lower-case email, or nil when there is none
+	FindGuestByEventIDAndEmail(ctx context.Context, eventID uuid.UUID, email string) (*types.GuestType, error)
+
 	// UpdateGuest updates the information of an existing guest
 	UpdateGuest(ctx context.Context, guest *types.GuestType) error
 
+	// AcceptGuestWithinCapacity stores a guest who accepted, returning ErrEventFull when capacity other guests of the
+	// event have already accepted; a capacity of zero or less never fills up. Acceptances for the same event are
+	// serialised, so concurrent RSVPs cannot overbook it.
+	AcceptGuestWithinCapacity(ctx context.Context, guest *types.GuestType, capacity int) error
+
 	// DeleteGuestByID removes a guest by their ID
 	DeleteGuestByID(ctx context.Context, guestID uuid.UUID) error
 
 	// CountGuestsByEventID counts the number of guests for a given event
 	CountGuestsByEventID(ctx context.Context, eventID uuid.UUID) (int64, error)
+
+	// CountGuestsByRSVPStatus counts the guests of an event per RSVP status; statuses nobody has are left out
+	CountGuestsByRSVPStatus(ctx context.Context, eventID uuid.UUID) (map[string]int64, error)
+
+	// CountCheckedInGuestsByEventID counts the guests of an event who have checked in
+	CountCheckedInGuestsByEventID(ctx context.Context, eventID uuid.UUID) (int64, error)
+
+	// FindNextWaitlistedGuest retrieves the guest who has been on the waitlist of an event the longest, or nil when it is empty
+	FindNextWaitlistedGuest(ctx context.Context, eventID uuid.UUID) (*types.GuestType, error)
 }
@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// ErrJobLockLost is returned by ReleaseJob when another instance took over the job after the lease ran out
+var ErrJobLockLost = errors.New("job lock is held by another instance")
+
+// JobRepositoryInterface defines the methods for persisting background jobs and their runs
+type JobRepositoryInterface interface {
+	// UpsertJob creates the job, or updates the definition of the job with the same name.
+	// The stored NextRunAt is only replaced when overwriteNextRun is set, so restarts keep cron jobs on their timetable.
+	UpsertJob(ctx context.Context, job *types.JobType, overwriteNextRun bool) (*types.JobType, error)
+
+	// FindJobByName retrieves a job by its unique name, returning nil when there is none
+	FindJobByName(ctx context.Context, name string) (*types.JobType, error)
+
+	// FindAllJobs retrieves every job ordered by name
+	FindAllJobs(ctx context.Context) ([]*types.JobType, error)
+
+	// FindDueJobs retrieves active, unlocked jobs whose NextRunAt is at or before now, at most limit of them
+	FindDueJobs(ctx context.Context, now time.Time, limit int) ([]*types.JobType, error)
+
+	// AcquireJob takes the lease on a due job for instanceID until lockUntil.
+	// It is a single conditional update, so only one instance gets true for the same due run.
+	AcquireJob(ctx context.Context, jobID uuid.UUID, instanceID string, now, lockUntil time.Time) (bool, error)
+
+	// ReleaseJob stores the outcome of a run (NextRunAt, Attempts, IsActive, LastRunAt, LastError) and clears the lease.
+	// It returns ErrJobLockLost when job.LockedBy no longer holds the lease.
+	ReleaseJob(ctx context.Context, job *types.JobType) error
+
+	// CreateJobRun records the start of a job run
+	CreateJobRun(ctx context.Context, run *types.JobRunType) error
+
+	// FinishJobRun stores the status, error and finish time of a job run
+	FinishJobRun(ctx context.Context, run *types.JobRunType) error
+
+	// FindJobRuns retrieves the latest runs, newest first, optionally only those of one job
+	FindJobRuns(ctx context.Context, jobName string, limit int) ([]*types.JobRunType, error)
+}
@@ -0,0 +1,38 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// ErrLoginChallengeUsed is returned by UseLoginChallenge when the challenge already logged someone in
+var ErrLoginChallengeUsed = errors.New("login challenge has already been used")
+
+// LoginChallengeRepositoryInterface defines the methods for handling passwordless logins in progress
+type LoginChallengeRepositoryInterface interface {
+	// CreateLoginChallenge stores a new challenge
+	CreateLoginChallenge(ctx context.Context, challenge *types.LoginChallengeType) error
+
+	// FindLoginChallengeByTokenHash retrieves the challenge of a login link, or nil when there is none
+	FindLoginChallengeByTokenHash(ctx context.Context, tokenHash string) (*types.LoginChallengeType, error)
+
+	// FindLoginChallengeByNonceHash retrieves the challenge a browser started, or nil when there is none
+	FindLoginChallengeByNonceHash(ctx context.Context, nonceHash string) (*types.LoginChallengeType, error)
+
+	// CountLoginChallengesSince counts the challenges started for a superuser since the given time
+	CountLoginChallengesSince(ctx context.Context, superUserID uuid.UUID, since time.Time) (int64, error)
+
+	// RecordFailedLoginChallengeAttempt counts one more wrong code entered for a challenge
+	RecordFailedLoginChallengeAttempt(ctx context.Context, challengeID uuid.UUID) error
+
+	// UseLoginChallenge marks a challenge as used at usedAt, or returns ErrLoginChallengeUsed when it already is,
+	// so a link or code logs in at most once even when used twice at the same time
+	UseLoginChallenge(ctx context.Context, challengeID uuid.UUID, usedAt time.Time) error
+
+	// DeleteExpiredLoginChallenges removes challenges that expired before now and returns how many were removed
+	DeleteExpiredLoginChallenges(ctx context.Context, now time.Time) (int64, error)
+}
@@ -0,0 +1,33 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// ErrOrderStatusChanged is returned by UpdateOrder when the order is no longer in the expected state
+var ErrOrderStatusChanged = errors.New("order status was changed by another request")
+
+// OrderRepositoryInterface defines the methods for handling ticket orders
+type OrderRepositoryInterface interface {
+	// CreateOrder stores a new order
+	CreateOrder(ctx context.Context, order *types.OrderType) (*types.OrderType, error)
+
+	// FindOrderByID retrieves an order by its ID
+	FindOrderByID(ctx context.Context, orderID uuid.UUID) (*types.OrderType, error)
+
+	// FindOrdersByEventID retrieves every order of an event, newest first
+	FindOrdersByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.OrderType, error)
+
+	// FindExpiredPendingOrders retrieves pending orders whose hold ran out at or before now, at most limit of them
+	FindExpiredPendingOrders(ctx context.Context, now time.Time, limit int) ([]*types.OrderType, error)
+
+	// UpdateOrder stores the status, attendees, timestamps and close reason of an order, but only while it is still
+	// in fromStatus; otherwise ErrOrderStatusChanged is returned. Every status change goes through here, so two
+	// requests cannot both confirm, cancel or refund the same order.
+	UpdateOrder(ctx context.Context, order *types.OrderType, fromStatus string) error
+}
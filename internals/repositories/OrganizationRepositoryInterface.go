@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// ErrOrganizationMemberExists is returned by AddOrganizationMember when the user is already a member of the organization
+var ErrOrganizationMemberExists = errors.New("user is already a member of the organization")
+
+// ErrOrganizationInvitationSpent is returned by MarkOrganizationInvitationAccepted when the invitation was already accepted
+var ErrOrganizationInvitationSpent = errors.New("invitation was already accepted")
+
+// OrganizationRepositoryInterface defines the methods for handling organizations, their members and invitations
+type OrganizationRepositoryInterface interface {
+	// CreateOrganization stores a new organization
+	CreateOrganization(ctx context.Context, organization *types.OrganizationType) (*types.OrganizationType, error)
+
+	// FindOrganizationByID retrieves an organization by its ID
+	FindOrganizationByID(ctx context.Context, organizationID uuid.UUID) (*types.OrganizationType, error)
+
+	// AddOrganizationMember stores a new membership, or returns ErrOrganizationMemberExists
+	AddOrganizationMember(ctx context.Context, member *types.OrganizationMemberType) error
+
+	// FindOrganizationMember retrieves the membership of a user in an organization, or nil when they are not a member
+	FindOrganizationMember(ctx context.Context, organizationID, superUserID uuid.UUID) (*types.OrganizationMemberType, error)
+
+	// FindOrganizationMembers retrieves every member of an organization, longest-standing first
+	FindOrganizationMembers(ctx context.Context, organizationID uuid.UUID) ([]*types.OrganizationMemberType, error)
+
+	// FindMembershipsBySuperUserID retrieves every membership of a user, oldest first
+	FindMembershipsBySuperUserID(ctx context.Context, superUserID uuid.UUID) ([]*types.OrganizationMemberType, error)
+
+	// UpdateOrganizationMemberRole stores the role of a membership
+	UpdateOrganizationMemberRole(ctx context.Context, member *types.OrganizationMemberType) error
+
+	// RemoveOrganizationMember removes a user from an organization
+	RemoveOrganizationMember(ctx context.Context, organizationID, superUserID uuid.UUID) error
+
+	// CreateOrganizationInvitation stores a new invitation
+	CreateOrganizationInvitation(ctx context.Context, invitation *types.OrganizationInvitationType) (*types.OrganizationInvitationType, error)
+
+	// FindOrganizationInvitationByID retrieves an invitation by its ID
+	FindOrganizationInvitationByID(ctx context.Context, invitationID uuid.UUID) (*types.OrganizationInvitationType, error)
+
+	// FindOrganizationInvitationByTokenHash retrieves the invitation with the given token hash, or nil when there is none
+	FindOrganizationInvitationByTokenHash(ctx context.Context, tokenHash string) (*types.OrganizationInvitationType, error)
+
+	// FindPendingOrganizationInvitations retrieves the invitations of an organization that can still be accepted at now, newest first
+	FindPendingOrganizationInvitations(ctx context.Context, organizationID uuid.UUID, now time.Time) ([]*types.OrganizationInvitationType, error)
+
+	// MarkOrganizationInvitationAccepted records who accepted an invitation and when. It only applies while the
+	// invitation has not been accepted yet, otherwise ErrOrganizationInvitationSpent is returned.
+	MarkOrganizationInvitationAccepted(ctx context.Context, invitationID, superUserID uuid.UUID, acceptedAt time.Time) error
+
+	// DeleteOrganizationInvitation removes an invitation
+	DeleteOrganizationInvitation(ctx context.Context, invitationID uuid.UUID) error
+}
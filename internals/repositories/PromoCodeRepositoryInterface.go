@@ -0,0 +1,47 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// ErrPromoCodeExhausted is returned when a promo code has been redeemed as often as it may be
+var ErrPromoCodeExhausted = errors.New("promo code has been used up")
+
+// ErrPromoCodeUserLimit is returned when a user has redeemed a promo code as often as one user may
+var ErrPromoCodeUserLimit = errors.New("promo code was already used the maximum number of times by this user")
+
+// PromoCodeRepositoryInterface defines the methods for handling promo codes and their redemptions
+type PromoCodeRepositoryInterface interface {
+	// CreatePromoCode stores a new promo code
+	CreatePromoCode(ctx context.Context, promoCode *types.PromoCodeType) (*types.PromoCodeType, error)
+
+	// FindPromoCodeByID retrieves a promo code by its ID
+	FindPromoCodeByID(ctx context.Context, promoCodeID uuid.UUID) (*types.PromoCodeType, error)
+
+	// FindPromoCodeByCode retrieves the promo code of an event with the given code, or nil when there is none
+	FindPromoCodeByCode(ctx context.Context, eventID uuid.UUID, code string) (*types.PromoCodeType, error)
+
+	// FindPromoCodesByEventID retrieves every promo code of an event, ordered by code
+	FindPromoCodesByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.PromoCodeType, error)
+
+	// UpdatePromoCode stores the discount, limits, validity window, tiers and active flag of a promo code
+	UpdatePromoCode(ctx context.Context, promoCode *types.PromoCodeType) error
+
+	// DeletePromoCodeByID removes a promo code
+	DeletePromoCodeByID(ctx context.Context, promoCodeID uuid.UUID) error
+
+	// RedeemPromoCode records a redemption and counts it against the limits of its promo code, returning
+	// ErrPromoCodeExhausted or ErrPromoCodeUserLimit when a limit is reached. Checking the limits and recording
+	// the redemption happen atomically, so concurrent checkouts cannot over-redeem a code.
+	RedeemPromoCode(ctx context.Context, redemption *types.PromoRedemptionType) error
+
+	// ReleasePromoRedemption removes the redemption of an order, if any, and gives it back to its promo code
+	ReleasePromoRedemption(ctx context.Context, orderID uuid.UUID) error
+
+	// FindPromoRedemptionsByEventID retrieves every redemption of the promo codes of an event
+	FindPromoRedemptionsByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.PromoRedemptionType, error)
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// RegistrationFormRepositoryInterface defines the methods for storing the registration forms of events
+type RegistrationFormRepositoryInterface interface {
+	// FindRegistrationFormByEventID retrieves the form of an event, or nil when the event has none
+	FindRegistrationFormByEventID(ctx context.Context, eventID uuid.UUID) (*types.RegistrationFormType, error)
+
+	// SaveRegistrationForm stores the form of an event, replacing the fields of the form it already has
+	SaveRegistrationForm(ctx context.Context, form *types.RegistrationFormType) (*types.RegistrationFormType, error)
+
+	// DeleteRegistrationFormByEventID removes the form of an event; guests keep the answers they gave
+	DeleteRegistrationFormByEventID(ctx context.Context, eventID uuid.UUID) error
+}
@@ -0,0 +1,30 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// ReminderRepositoryInterface defines the methods for handling planned reminder emails
+type ReminderRepositoryInterface interface {
+	// CreateReminder stores a newly planned reminder
+	CreateReminder(ctx context.Context, reminder *types.ReminderType) (*types.ReminderType, error)
+
+	// FindRemindersByEventID retrieves every reminder planned for an event, sent or not
+	FindRemindersByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.ReminderType, error)
+
+	// FindDueReminders retrieves up to limit pending reminders whose SendAt is not after now, oldest first
+	FindDueReminders(ctx context.Context, now time.Time, limit int) ([]*types.ReminderType, error)
+
+	// MarkGuestNotified records that a guest has been emailed for a reminder
+	MarkGuestNotified(ctx context.Context, reminderID, guestID uuid.UUID) error
+
+	// UpdateReminderStatus moves a reminder to Sent or Skipped
+	UpdateReminderStatus(ctx context.Context, reminderID uuid.UUID, status string) error
+
+	// DeletePendingRemindersByEventID removes the reminders of an event that have not been sent yet
+	DeletePendingRemindersByEventID(ctx context.Context, eventID uuid.UUID) error
+}
@@ -8,8 +8,18 @@ import (
 	"github.com/lordofthemind/EventureGo/internals/types"
 )
 
+// SuperUserListFilter narrows a superuser listing; empty and nil fields do not filter
+type SuperUserListFilter struct {
+	Search         string // Part of the username, email or full name, ignoring case
+	Role           string // Exact role
+	IsVerified     *bool  // Whether the superuser verified their email address
+	IsActive       *bool  // Whether the superuser may log in
+	IncludeDeleted bool   // Whether soft-deleted superusers are listed too
+}
+
 type SuperUserRepositoryInterface interface {
 	CreateSuperUser(ctx context.Context, superUser *types.SuperUserType) (*types.SuperUserType, error)
+	FindSuperUserByID(ctx context.Context, superUserID uuid.UUID) (*types.SuperUserType, error)
 	FindSuperUserByEmail(ctx context.Context, email string) (*types.SuperUserType, error)
 	FindSuperUserByUsername(ctx context.Context, username string) (*types.SuperUserType, error)
 	FindSuperUserByResetToken(ctx context.Context, token string) (*types.SuperUserType, error)
@@ -17,4 +27,25 @@ type SuperUserRepositoryInterface interface {
 	UpdateSuperUser(ctx context.Context, superUser *types.SuperUserType) error
 	FindSuperUserByOTP(ctx context.Context, otp string) (*types.SuperUserType, error)
 	VerifySuperUserOTP(ctx context.Context, superUser *types.SuperUserType) error
+	// ClearExpiredOTPs removes OTPs that expired before now and returns how many superusers were updated
+	ClearExpiredOTPs(ctx context.Context, now time.Time) (int64, error)
+	// ListSuperUsers retrieves the superusers matching filter ordered by username. It skips offset matches,
+	// returns at most limit of them and counts all matches.
+	ListSuperUsers(ctx context.Context, filter SuperUserListFilter, offset, limit int) ([]*types.SuperUserType, int64, error)
+	// SetSuperUserActive activates or deactivates a superuser and stores when their current sessions were revoked
+	SetSuperUserActive(ctx context.Context, superUserID uuid.UUID, active bool, sessionsValidAfter time.Time) error
+	// UpdateSuperUserRole replaces the role and permission groups of a superuser
+	UpdateSuperUserRole(ctx context.Context, superUserID uuid.UUID, role string, permissionGroups []string) error
+	// ForcePasswordReset stores a new reset token and blocks logging in until the password is reset, revoking
+	// the sessions issued before sessionsValidAfter
+	ForcePasswordReset(ctx context.Context, superUserID uuid.UUID, resetToken string, resetTokenExpiry time.Time, sessionsValidAfter time.Time) error
+	// SoftDeleteSuperUser marks a superuser as deleted at deletedAt and deactivates them, revoking their sessions;
+	// the record is kept
+	SoftDeleteSuperUser(ctx context.Context, superUserID uuid.UUID, deletedAt time.Time) error
+	// ClearExpiredResetTokens removes password reset tokens that expired before now and returns how many superusers were updated
+	ClearExpiredResetTokens(ctx context.Context, now time.Time) (int64, error)
+	// FindSuperUserByEmailChangeToken retrieves the superuser whose pending email change has the token hash
+	FindSuperUserByEmailChangeToken(ctx context.Context, tokenHash string) (*types.SuperUserType, error)
+	// FindSuperUserByEmailUndoToken retrieves the superuser whose last email change can be undone with the token hash
+	FindSuperUserByEmailUndoToken(ctx context.Context, tokenHash string) (*types.SuperUserType, error)
 }
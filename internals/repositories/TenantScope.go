@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// ErrOutsideTenant is returned when a record is written to a tenant other than the one the request is scoped to
+var ErrOutsideTenant = errors.New("record belongs to another tenant")
+
+// TenantScope limits event and guest queries to the records of a single tenant: an organization, or the
+// personal events of users acting without one when OrganizationID is nil
+type TenantScope struct {
+	OrganizationID *uuid.UUID
+}
+
+type tenantScopeKey struct{}
+
+// ContextWithTenantScope returns a copy of ctx whose event and guest queries only see records of scope
+func ContextWithTenantScope(ctx context.Context, scope TenantScope) context.Context {
+	return context.WithValue(ctx, tenantScopeKey{}, scope)
+}
+
+// WithoutTenantScope returns a copy of ctx whose event and guest queries see the records of every tenant, for
+// checks about shared things such as venues and people that must not stop at tenant boundaries
+func WithoutTenantScope(ctx context.Context) context.Context {
+	return context.WithValue(ctx, tenantScopeKey{}, nil)
+}
+
+// TenantScopeFromContext returns the tenant scope ctx carries; queries made without one, such as by background
+// jobs or public pages, are not limited to a tenant
+func TenantScopeFromContext(ctx context.Context) (TenantScope, bool) {
+	scope, ok := ctx.Value(tenantScopeKey{}).(TenantScope)
+	return scope, ok
+}
+
+// Allows reports whether a record of the given organization belongs to the scope
+func (s TenantScope) Allows(organizationID *uuid.UUID) bool {
+	if s.OrganizationID == nil || organizationID == nil {
+		return s.OrganizationID == nil && organizationID == nil
+	}
+	return *s.OrganizationID == *organizationID
+}
+
+// CheckTenant returns ErrOutsideTenant when ctx is scoped to a tenant other than the record's organization
+func CheckTenant(ctx context.Context, organizationID *uuid.UUID) error {
+	if scope, ok := TenantScopeFromContext(ctx); ok && !scope.Allows(organizationID) {
+		return ErrOutsideTenant
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// ErrTicketNotValid is returned when a ticket that is already used or revoked is checked in or revoked
+var ErrTicketNotValid = errors.New("ticket is not valid")
+
+// TicketRepositoryInterface defines the methods for handling guest tickets
+type TicketRepositoryInterface interface {
+	// CreateTicket stores a newly issued ticket
+	CreateTicket(ctx context.Context, ticket *types.TicketType) (*types.TicketType, error)
+
+	// FindTicketByID retrieves a ticket by its ID
+	FindTicketByID(ctx context.Context, ticketID uuid.UUID) (*types.TicketType, error)
+
+	// FindValidTicketByGuestID retrieves the valid ticket of a guest, or nil when the guest has none
+	FindValidTicketByGuestID(ctx context.Context, guestID uuid.UUID) (*types.TicketType, error)
+
+	// FindTicketsByEventID retrieves every ticket issued for an event, whatever its status
+	FindTicketsByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.TicketType, error)
+
+	// CheckInTicket marks a valid ticket as used by staffID at checkedInAt.
+	// The update only applies while the ticket is valid, so a ticket scanned at two doors is admitted once;
+	// ErrTicketNotValid is returned for the losing scan.
+	CheckInTicket(ctx context.Context, ticketID, staffID uuid.UUID, checkedInAt time.Time) error
+
+	// RevokeTicket marks a valid ticket as revoked, returning ErrTicketNotValid when it is already used or revoked
+	RevokeTicket(ctx context.Context, ticketID uuid.UUID, reason string) error
+}
@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// ErrTicketTierSoldOut is returned when a tier has fewer tickets left than requested, or would end up with fewer
+// tickets than it already sold and holds
+var ErrTicketTierSoldOut = errors.New("not enough tickets left in this tier")
+
+// ErrTicketTierInUse is returned when a tier with sold or held tickets is deleted
+var ErrTicketTierInUse = errors.New("ticket tier has orders")
+
+// TicketTierRepositoryInterface defines the methods for handling ticket tiers and their inventory
+type TicketTierRepositoryInterface interface {
+	// CreateTicketTier stores a new ticket tier
+	CreateTicketTier(ctx context.Context, tier *types.TicketTierType) (*types.TicketTierType, error)
+
+	// FindTicketTierByID retrieves a ticket tier by its ID
+	FindTicketTierByID(ctx context.Context, tierID uuid.UUID) (*types.TicketTierType, error)
+
+	// FindTicketTiersByEventID retrieves the ticket tiers of an event, cheapest first
+	FindTicketTiersByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.TicketTierType, error)
+
+	// UpdateTicketTier stores the name, description, price, quantity and sale window of a tier.
+	// It returns ErrTicketTierSoldOut when the new quantity is below the tickets already sold and held.
+	UpdateTicketTier(ctx context.Context, tier *types.TicketTierType) error
+
+	// DeleteTicketTierByID removes a tier nobody ordered from, returning ErrTicketTierInUse otherwise
+	DeleteTicketTierByID(ctx context.Context, tierID uuid.UUID) error
+
+	// ReserveTicketTierInventory holds quantity tickets for a pending order, returning ErrTicketTierSoldOut when
+	// fewer are left. It is a single conditional update, so concurrent checkouts cannot oversell the tier.
+	ReserveTicketTierInventory(ctx context.Context, tierID uuid.UUID, quantity int) error
+
+	// ReleaseTicketTierInventory puts held tickets of a cancelled or expired order back on sale
+	ReleaseTicketTierInventory(ctx context.Context, tierID uuid.UUID, quantity int) error
+
+	// CommitTicketTierInventory turns held tickets of a confirmed order into sold ones
+	CommitTicketTierInventory(ctx context.Context, tierID uuid.UUID, quantity int) error
+
+	// RestockTicketTierInventory puts sold tickets of a refunded order back on sale
+	RestockTicketTierInventory(ctx context.Context, tierID uuid.UUID, quantity int) error
+}
@@ -0,0 +1,32 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// VenueRepositoryInterface defines the methods for handling venues in the system
+type VenueRepositoryInterface interface {
+	// CreateVenue creates a new venue
+	CreateVenue(ctx context.Context, venue *types.VenueType) (*types.VenueType, error)
+
+	// FindVenueByID finds a venue by its ID
+	FindVenueByID(ctx context.Context, venueID uuid.UUID) (*types.VenueType, error)
+
+	// UpdateVenue updates an existing venue
+	UpdateVenue(ctx context.Context, venue *types.VenueType) error
+
+	// DeleteVenueByID deletes a venue by its ID
+	DeleteVenueByID(ctx context.Context, venueID uuid.UUID) error
+
+	// FindAllVenues retrieves all venues in the system
+	FindAllVenues(ctx context.Context) ([]*types.VenueType, error)
+
+	// FindVenuesWithinRadius retrieves venues within radiusMeters of a point, nearest first
+	FindVenuesWithinRadius(ctx context.Context, latitude, longitude, radiusMeters float64) ([]*types.VenueType, error)
+
+	// FindVenuesWithinBoundingBox retrieves venues inside the box described by its south-west and north-east corners
+	FindVenuesWithinBoundingBox(ctx context.Context, minLatitude, minLongitude, maxLatitude, maxLongitude float64) ([]*types.VenueType, error)
+}
@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// WebhookRepositoryInterface defines the methods for handling webhooks and their delivery log
+type WebhookRepositoryInterface interface {
+	// CreateWebhook stores a new webhook
+	CreateWebhook(ctx context.Context, webhook *types.WebhookType) (*types.WebhookType, error)
+
+	// FindWebhookByID retrieves a webhook by its ID
+	FindWebhookByID(ctx context.Context, webhookID uuid.UUID) (*types.WebhookType, error)
+
+	// FindWebhooksByOrganizerID retrieves every webhook of an organizer, oldest first
+	FindWebhooksByOrganizerID(ctx context.Context, organizerID uuid.UUID) ([]*types.WebhookType, error)
+
+	// FindActiveWebhooksByEventType retrieves the active webhooks of an organizer that subscribe to eventType
+	FindActiveWebhooksByEventType(ctx context.Context, organizerID uuid.UUID, eventType string) ([]*types.WebhookType, error)
+
+	// UpdateWebhook stores the URL, event types, description and active flag of a webhook
+	UpdateWebhook(ctx context.Context, webhook *types.WebhookType) error
+
+	// DeleteWebhookByID removes a webhook together with its delivery log
+	DeleteWebhookByID(ctx context.Context, webhookID uuid.UUID) error
+
+	// CreateWebhookDelivery stores a new delivery
+	CreateWebhookDelivery(ctx context.Context, delivery *types.WebhookDeliveryType) (*types.WebhookDeliveryType, error)
+
+	// FindWebhookDeliveryByID retrieves a delivery by its ID
+	FindWebhookDeliveryByID(ctx context.Context, deliveryID uuid.UUID) (*types.WebhookDeliveryType, error)
+
+	// FindWebhookDeliveriesByWebhookID retrieves the latest deliveries of a webhook, newest first
+	FindWebhookDeliveriesByWebhookID(ctx context.Context, webhookID uuid.UUID, limit int) ([]*types.WebhookDeliveryType, error)
+
+	// FindDueWebhookDeliveries retrieves pending deliveries whose NextAttemptAt is at or before now, at most limit of them
+	FindDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]*types.WebhookDeliveryType, error)
+
+	// AcquireWebhookDelivery moves the NextAttemptAt of a due delivery to lockUntil before it is attempted.
+	// It is a single conditional update, so only one caller gets true for the same due attempt, and an attempt
+	// cut short by a crash is retried once lockUntil has passed.
+	AcquireWebhookDelivery(ctx context.Context, deliveryID uuid.UUID, now, lockUntil time.Time) (bool, error)
+
+	// UpdateWebhookDelivery stores the outcome of an attempt (Status, Attempts, NextAttemptAt and the last response)
+	UpdateWebhookDelivery(ctx context.Context, delivery *types.WebhookDeliveryType) error
+}
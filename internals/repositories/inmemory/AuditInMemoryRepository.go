@@ -0,0 +1,100 @@
+package inmemory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+type inMemoryAuditRepository struct {
+	mu      sync.RWMutex
+	records []*types.AuditRecordType // In chain order
+}
+
+func NewInMemoryAuditRepository() repositories.AuditRepositoryInterface {
+	return &inMemoryAuditRepository{}
+}
+
+func (r *inMemoryAuditRepository) AppendAuditRecord(ctx context.Context, record *types.AuditRecordType) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var previous *types.AuditRecordType
+	if len(r.records) > 0 {
+		previous = r.records[len(r.records)-1]
+	}
+	record.ChainTo(previous)
+	stored := *record
+	r.records = append(r.records, &stored)
+	return nil
+}
+
+func (r *inMemoryAuditRepository) ListAuditRecords(ctx context.Context, filter repositories.AuditRecordFilter, offset, limit int) ([]*types.AuditRecordType, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matches []*types.AuditRecordType
+	for i := len(r.records) - 1; i >= 0; i-- {
+		if matchesAuditRecordFilter(r.records[i], filter) {
+			matches = append(matches, r.records[i])
+		}
+	}
+
+	total := int64(len(matches))
+	if offset >= len(matches) {
+		return []*types.AuditRecordType{}, total, nil
+	}
+	matches = matches[offset:]
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	records := make([]*types.AuditRecordType, 0, len(matches))
+	for _, record := range matches {
+		copied := *record
+		records = append(records, &copied)
+	}
+	return records, total, nil
+}
+
+func (r *inMemoryAuditRepository) FindAuditRecordsAfter(ctx context.Context, afterSequence int64, limit int) ([]*types.AuditRecordType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	records := []*types.AuditRecordType{}
+	for _, record := range r.records {
+		if record.Sequence <= afterSequence {
+			continue
+		}
+		if limit > 0 && len(records) == limit {
+			break
+		}
+		copied := *record
+		records = append(records, &copied)
+	}
+	return records, nil
+}
+
+// matchesAuditRecordFilter reports whether a record passes every filter that is set
+func matchesAuditRecordFilter(record *types.AuditRecordType, filter repositories.AuditRecordFilter) bool {
+	if filter.ActorID != nil && record.ActorID != *filter.ActorID {
+		return false
+	}
+	if filter.Action != "" && record.Action != filter.Action {
+		return false
+	}
+	if filter.TargetType != "" && record.TargetType != filter.TargetType {
+		return false
+	}
+	if filter.TargetID != nil && record.TargetID != *filter.TargetID {
+		return false
+	}
+	if filter.Since != nil && record.CreatedAt.Before(*filter.Since) {
+		return false
+	}
+	if filter.Until != nil && !record.CreatedAt.Before(*filter.Until) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+type inMemoryEventGrantRepository struct {
+	mu     sync.RWMutex
+	grants map[uuid.UUID]*types.EventGrantType
+}
+
+func NewInMemoryEventGrantRepository() repositories.EventGrantRepositoryInterface {
+	return &inMemoryEventGrantRepository{
+		grants: make(map[uuid.UUID]*types.EventGrantType),
+	}
+}
+
+func (r *inMemoryEventGrantRepository) CreateEventGrant(ctx context.Context, grant *types.EventGrantType) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.grants {
+		if existing.EventID == grant.EventID && existing.SuperUserID == grant.SuperUserID {
+			return repositories.ErrEventGrantExists
+		}
+	}
+	grant.CreatedAt = time.Now()
+	grant.UpdatedAt = time.Now()
+	cloned := *grant
+	r.grants[grant.ID] = &cloned
+	return nil
+}
+
+func (r *inMemoryEventGrantRepository) FindEventGrant(ctx context.Context, eventID, superUserID uuid.UUID) (*types.EventGrantType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, grant := range r.grants {
+		if grant.EventID == eventID && grant.SuperUserID == superUserID {
+			cloned := *grant
+			return &cloned, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *inMemoryEventGrantRepository) FindEventGrantsByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.EventGrantType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var grants []*types.EventGrantType
+	for _, grant := range r.grants {
+		if grant.EventID == eventID {
+			cloned := *grant
+			grants = append(grants, &cloned)
+		}
+	}
+	sort.Slice(grants, func(i, j int) bool { return grants[i].CreatedAt.Before(grants[j].CreatedAt) })
+	return grants, nil
+}
+
+func (r *inMemoryEventGrantRepository) UpdateEventGrantRole(ctx context.Context, grant *types.EventGrantType) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.grants[grant.ID]
+	if !exists {
+		return errors.New("event grant not found")
+	}
+	stored.Role = grant.Role
+	stored.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *inMemoryEventGrantRepository) DeleteEventGrant(ctx context.Context, eventID, superUserID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, grant := range r.grants {
+		if grant.EventID == eventID && grant.SuperUserID == superUserID {
+			delete(r.grants, id)
+		}
+	}
+	return nil
+}
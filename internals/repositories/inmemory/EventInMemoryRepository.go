@@ -0,0 +1,287 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+type inMemoryEventRepository struct {
+	mu     sync.RWMutex
+	events map[uuid.UUID]*types.EventType
+}
+
+func NewInMemoryEventRepository() repositories.EventRepositoryInterface {
+	return &inMemoryEventRepository{
+		events: make(map[uuid.UUID]*types.EventType),
+	}
+}
+
+func (r *inMemoryEventRepository) CreateEvent(ctx context.Context, event *types.EventType) (*types.EventType, error) {
+	if err := repositories.CheckTenant(ctx, event.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event.ID = uuid.New()
+	event.CreatedAt = time.Now()
+	event.UpdatedAt = time.Now()
+
+	r.events[event.ID] = event
+	return event, nil
+}
+
+func (r *inMemoryEventRepository) FindEventByID(ctx context.Context, eventID uuid.UUID) (*types.EventType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	event, exists := r.events[eventID]
+	if !exists || !inTenant(ctx, event.OrganizationID) {
+		return nil, errors.New("event not found")
+	}
+	return cloneEvent(event), nil
+}
+
+func (r *inMemoryEventRepository) FindEventBySlug(ctx context.Context, slug string) (*types.EventType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, event := range r.events {
+		if event.Slug == slug {
+			return cloneEvent(event), nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *inMemoryEventRepository) FindEventsByOrganizerID(ctx context.Context, organizerID uuid.UUID) ([]*types.EventType, error) {
+	return r.filterEvents(ctx, func(event *types.EventType) bool {
+		return event.OrganizerID == organizerID
+	}), nil
+}
+
+func (r *inMemoryEventRepository) UpdateEvent(ctx context.Context, event *types.EventType) error {
+	if err := repositories.CheckTenant(ctx, event.OrganizationID); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if stored, exists := r.events[event.ID]; !exists || !inTenant(ctx, stored.OrganizationID) {
+		return errors.New("event not found")
+	}
+
+	event.UpdatedAt = time.Now()
+	r.events[event.ID] = event
+	return nil
+}
+
+func (r *inMemoryEventRepository) DeleteEventByID(ctx context.Context, eventID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if event, exists := r.events[eventID]; exists && inTenant(ctx, event.OrganizationID) {
+		delete(r.events, eventID)
+	}
+	return nil
+}
+
+func (r *inMemoryEventRepository) FindAllEvents(ctx context.Context) ([]*types.EventType, error) {
+	return r.filterEvents(ctx, func(event *types.EventType) bool { return true }), nil
+}
+
+func (r *inMemoryEventRepository) FindEventsByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*types.EventType, error) {
+	return r.filterEvents(ctx, func(event *types.EventType) bool {
+		return !event.StartTime.Before(startDate) && !event.EndTime.After(endDate)
+	}), nil
+}
+
+func (r *inMemoryEventRepository) FindEventsByLocation(ctx context.Context, location string) ([]*types.EventType, error) {
+	return r.filterEvents(ctx, func(event *types.EventType) bool {
+		return event.Location == location
+	}), nil
+}
+
+func (r *inMemoryEventRepository) FindEventsByVenueIDs(ctx context.Context, venueIDs []uuid.UUID) ([]*types.EventType, error) {
+	wanted := make(map[uuid.UUID]bool, len(venueIDs))
+	for _, venueID := range venueIDs {
+		wanted[venueID] = true
+	}
+
+	return r.filterEvents(ctx, func(event *types.EventType) bool {
+		return event.VenueID != nil && wanted[*event.VenueID]
+	}), nil
+}
+
+func (r *inMemoryEventRepository) FindOverlappingEvents(ctx context.Context, venueID, organizerID *uuid.UUID, windowStart, windowEnd time.Time) ([]*types.EventType, error) {
+	return r.filterEvents(ctx, func(event *types.EventType) bool {
+		if !event.IsActive || event.Status == types.EventStatusCancelled || !event.StartTime.Before(windowEnd) {
+			return false
+		}
+		sameVenue := venueID != nil && event.VenueID != nil && *event.VenueID == *venueID
+		sameOrganizer := organizerID != nil && event.OrganizerID == *organizerID
+		if !sameVenue && !sameOrganizer {
+			return false
+		}
+		if event.EndTime.After(windowStart) {
+			return true
+		}
+		return event.Recurrence != nil && (event.Recurrence.Until == nil || event.Recurrence.Until.After(windowStart))
+	}), nil
+}
+
+func (r *inMemoryEventRepository) FindEventsByMultipleTags(ctx context.Context, tags []string) ([]*types.EventType, error) {
+	return r.filterEvents(ctx, func(event *types.EventType) bool {
+		for _, eventTag := range event.Tags {
+			for _, tag := range tags {
+				if eventTag == tag {
+					return true
+				}
+			}
+		}
+		return false
+	}), nil
+}
+
+func (r *inMemoryEventRepository) ActivateEvent(ctx context.Context, eventID uuid.UUID) error {
+	return r.updateEventStatus(ctx, eventID, true)
+}
+
+func (r *inMemoryEventRepository) DeactivateEvent(ctx context.Context, eventID uuid.UUID) error {
+	return r.updateEventStatus(ctx, eventID, false)
+}
+
+func (r *inMemoryEventRepository) FindUpcomingEvents(ctx context.Context) ([]*types.EventType, error) {
+	now := time.Now().UTC()
+	return r.filterEvents(ctx, func(event *types.EventType) bool {
+		if event.StartTime.After(now) {
+			return true
+		}
+		return event.Recurrence != nil && (event.Recurrence.Until == nil || event.Recurrence.Until.After(now))
+	}), nil
+}
+
+func (r *inMemoryEventRepository) FindPastEvents(ctx context.Context) ([]*types.EventType, error) {
+	now := time.Now().UTC()
+	return r.filterEvents(ctx, func(event *types.EventType) bool {
+		return event.EndTime.Before(now)
+	}), nil
+}
+
+func (r *inMemoryEventRepository) FindActiveEvents(ctx context.Context) ([]*types.EventType, error) {
+	return r.filterEvents(ctx, func(event *types.EventType) bool {
+		return event.IsActive
+	}), nil
+}
+
+func (r *inMemoryEventRepository) FindInactiveEvents(ctx context.Context) ([]*types.EventType, error) {
+	return r.filterEvents(ctx, func(event *types.EventType) bool {
+		return !event.IsActive
+	}), nil
+}
+
+func (r *inMemoryEventRepository) SearchEventsByTitle(ctx context.Context, title string) ([]*types.EventType, error) {
+	title = strings.ToLower(title)
+	return r.filterEvents(ctx, func(event *types.EventType) bool {
+		return strings.Contains(strings.ToLower(event.Title), title)
+	}), nil
+}
+
+func (r *inMemoryEventRepository) TransitionEventStatus(ctx context.Context, eventID uuid.UUID, change *types.EventStatusChangeType) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event, exists := r.events[eventID]
+	if !exists || !inTenant(ctx, event.OrganizationID) {
+		return errors.New("event not found")
+	}
+	if event.CurrentStatus() != change.From {
+		return repositories.ErrEventStatusChanged
+	}
+
+	event.Status = change.To
+	if change.To == types.EventStatusCancelled {
+		event.CancellationReason = change.Reason
+		event.Sequence++
+	}
+	event.StatusHistory = append(event.StatusHistory, *change)
+	event.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *inMemoryEventRepository) RescheduleEvent(ctx context.Context, eventID uuid.UUID, newStartTime, newEndTime time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event, exists := r.events[eventID]
+	if !exists || !inTenant(ctx, event.OrganizationID) {
+		return errors.New("event not found")
+	}
+
+	event.StartTime = newStartTime.UTC()
+	event.EndTime = newEndTime.UTC()
+	event.Sequence++
+	event.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *inMemoryEventRepository) CountTotalEvents(ctx context.Context) (int64, error) {
+	events := r.filterEvents(ctx, func(event *types.EventType) bool { return true })
+	return int64(len(events)), nil
+}
+
+func (r *inMemoryEventRepository) CountEventsByOrganizerID(ctx context.Context, organizerID uuid.UUID) (int64, error) {
+	events, _ := r.FindEventsByOrganizerID(ctx, organizerID)
+	return int64(len(events)), nil
+}
+
+// cloneEvent copies a stored event so callers cannot change the store without going through the repository,
+// matching the behaviour of the database-backed repositories
+func cloneEvent(event *types.EventType) *types.EventType {
+	cloned := *event
+	cloned.StatusHistory = append([]types.EventStatusChangeType(nil), event.StatusHistory...)
+	return &cloned
+}
+
+// filterEvents returns every stored event of the tenant in ctx matching the predicate
+func (r *inMemoryEventRepository) filterEvents(ctx context.Context, match func(event *types.EventType) bool) []*types.EventType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var events []*types.EventType
+	for _, event := range r.events {
+		if inTenant(ctx, event.OrganizationID) && match(event) {
+			events = append(events, cloneEvent(event))
+		}
+	}
+	return events
+}
+
+// updateEventStatus is a helper function to activate or deactivate an event
+func (r *inMemoryEventRepository) updateEventStatus(ctx context.Context, eventID uuid.UUID, isActive bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event, exists := r.events[eventID]
+	if !exists || !inTenant(ctx, event.OrganizationID) {
+		return errors.New("event not found")
+	}
+
+	event.IsActive = isActive
+	event.UpdatedAt = time.Now()
+	return nil
+}
+
+// inTenant reports whether a record of the given organization is visible to the tenant scope of ctx, if any
+func inTenant(ctx context.Context, organizationID *uuid.UUID) bool {
+	return repositories.CheckTenant(ctx, organizationID) == nil
+}
@@ -0,0 +1,219 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+type inMemoryGuestRepository struct {
+	mu     sync.RWMutex
+	guests map[uuid.UUID]*types.GuestType
+}
+
+func NewInMemoryGuestRepository() repositories.GuestRepositoryInterface {
+	return &inMemoryGuestRepository{
+		guests: make(map[uuid.UUID]*types.GuestType),
+	}
+}
+
+func (r *inMemoryGuestRepository) AddGuest(ctx context.Context, guest *types.GuestType) (*types.GuestType, error) {
+	if err := repositories.CheckTenant(ctx, guest.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	guest.ID = uuid.New()
+	guest.InvitedAt = time.Now()
+	r.guests[guest.ID] = guest
+	return guest, nil
+}
+
+func (r *inMemoryGuestRepository) AddBulkGuest(ctx context.Context, guests []*types.GuestType) ([]*types.GuestType, error) {
+	for _, guest := range guests {
+		if err := repositories.CheckTenant(ctx, guest.OrganizationID); err != nil {
+			return nil, err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, guest := range guests {
+		guest.ID = uuid.New()
+		guest.InvitedAt = time.Now()
+		r.guests[guest.ID] = guest
+	}
+	return guests, nil
+}
+
+func (r *inMemoryGuestRepository) FindGuestsByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.GuestType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var guests []*types.GuestType
+	for _, guest := range r.guests {
+		if guest.EventID == eventID && inTenant(ctx, guest.OrganizationID) {
+			guests = append(guests, guest)
+		}
+	}
+	return guests, nil
+}
+
+func (r *inMemoryGuestRepository) StreamGuestsByEventID(ctx context.Context, eventID uuid.UUID, rsvpStatus string, checkedIn *bool, visit func(guest *types.GuestType) error) error {
+	r.mu.RLock()
+	var guests []*types.GuestType
+	for _, guest := range r.guests {
+		if guest.EventID != eventID || !inTenant(ctx, guest.OrganizationID) || (rsvpStatus != "" && guest.RSVPStatus != rsvpStatus) {
+			continue
+		}
+		if checkedIn != nil && *checkedIn != (guest.CheckedInAt != nil) {
+			continue
+		}
+		cloned := *guest
+		guests = append(guests, &cloned)
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(guests, func(i, j int) bool { return strings.ToLower(guests[i].FullName) < strings.ToLower(guests[j].FullName) })
+	for _, guest := range guests {
+		if err := visit(guest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *inMemoryGuestRepository) FindGuestByID(ctx context.Context, guestID uuid.UUID) (*types.GuestType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	guest, exists := r.guests[guestID]
+	if !exists || !inTenant(ctx, guest.OrganizationID) {
+		return nil, errors.New("guest not found")
+	}
+	return guest, nil
+}
+
+func (r *inMemoryGuestRepository) FindGuestByEventIDAndEmail(ctx context.Context, eventID uuid.UUID, email string) (*types.GuestType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, guest := range r.guests {
+		if guest.EventID == eventID && guest.Email == email && inTenant(ctx, guest.OrganizationID) {
+			return guest, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *inMemoryGuestRepository) UpdateGuest(ctx context.Context, guest *types.GuestType) error {
+	if err := repositories.CheckTenant(ctx, guest.OrganizationID); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if stored, exists := r.guests[guest.ID]; !exists || !inTenant(ctx, stored.OrganizationID) {
+		return errors.New("guest not found")
+	}
+
+	guest.UpdatedAt = time.Now()
+	r.guests[guest.ID] = guest
+	return nil
+}
+
+func (r *inMemoryGuestRepository) AcceptGuestWithinCapacity(ctx context.Context, guest *types.GuestType, capacity int) error {
+	if err := repositories.CheckTenant(ctx, guest.OrganizationID); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if stored, exists := r.guests[guest.ID]; !exists || !inTenant(ctx, stored.OrganizationID) {
+		return errors.New("guest not found")
+	}
+	if capacity > 0 {
+		accepted := 0
+		for _, other := range r.guests {
+			if other.EventID == guest.EventID && other.ID != guest.ID && other.RSVPStatus == types.RSVPStatusAccepted {
+				accepted++
+			}
+		}
+		if accepted >= capacity {
+			return repositories.ErrEventFull
+		}
+	}
+
+	guest.UpdatedAt = time.Now()
+	r.guests[guest.ID] = guest
+	return nil
+}
+
+func (r *inMemoryGuestRepository) DeleteGuestByID(ctx context.Context, guestID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if guest, exists := r.guests[guestID]; exists && inTenant(ctx, guest.OrganizationID) {
+		delete(r.guests, guestID)
+	}
+	return nil
+}
+
+func (r *inMemoryGuestRepository) CountGuestsByEventID(ctx context.Context, eventID uuid.UUID) (int64, error) {
+	guests, _ := r.FindGuestsByEventID(ctx, eventID)
+	return int64(len(guests)), nil
+}
+
+func (r *inMemoryGuestRepository) CountGuestsByRSVPStatus(ctx context.Context, eventID uuid.UUID) (map[string]int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	counts := make(map[string]int64)
+	for _, guest := range r.guests {
+		if guest.EventID == eventID && inTenant(ctx, guest.OrganizationID) {
+			counts[guest.RSVPStatus]++
+		}
+	}
+	return counts, nil
+}
+
+func (r *inMemoryGuestRepository) CountCheckedInGuestsByEventID(ctx context.Context, eventID uuid.UUID) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, guest := range r.guests {
+		if guest.EventID == eventID && guest.CheckedInAt != nil && inTenant(ctx, guest.OrganizationID) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *inMemoryGuestRepository) FindNextWaitlistedGuest(ctx context.Context, eventID uuid.UUID) (*types.GuestType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var next *types.GuestType
+	for _, guest := range r.guests {
+		if guest.EventID != eventID || guest.RSVPStatus != types.RSVPStatusWaitlisted || !inTenant(ctx, guest.OrganizationID) {
+			continue
+		}
+		if next == nil || guest.UpdatedAt.Before(next.UpdatedAt) {
+			next = guest
+		}
+	}
+	return next, nil
+}
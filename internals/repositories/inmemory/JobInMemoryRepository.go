@@ -0,0 +1,185 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+type inMemoryJobRepository struct {
+	mu   sync.RWMutex
+	jobs map[uuid.UUID]*types.JobType
+	runs []*types.JobRunType
+}
+
+func NewInMemoryJobRepository() repositories.JobRepositoryInterface {
+	return &inMemoryJobRepository{
+		jobs: make(map[uuid.UUID]*types.JobType),
+	}
+}
+
+func (r *inMemoryJobRepository) UpsertJob(ctx context.Context, job *types.JobType, overwriteNextRun bool) (*types.JobType, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, stored := range r.jobs {
+		if stored.Name != job.Name {
+			continue
+		}
+		stored.Kind = job.Kind
+		stored.Schedule = job.Schedule
+		stored.Payload = job.Payload
+		stored.MaxAttempts = job.MaxAttempts
+		stored.IsActive = job.IsActive
+		if overwriteNextRun {
+			stored.NextRunAt = job.NextRunAt
+			stored.Attempts = 0
+		}
+		stored.UpdatedAt = time.Now()
+		return cloneJob(stored), nil
+	}
+
+	stored := cloneJob(job)
+	stored.CreatedAt = time.Now()
+	stored.UpdatedAt = time.Now()
+	r.jobs[stored.ID] = stored
+	return cloneJob(stored), nil
+}
+
+func (r *inMemoryJobRepository) FindJobByName(ctx context.Context, name string) (*types.JobType, error) {
+	jobs := r.filterJobs(func(job *types.JobType) bool { return job.Name == name })
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+	return jobs[0], nil
+}
+
+func (r *inMemoryJobRepository) FindAllJobs(ctx context.Context) ([]*types.JobType, error) {
+	jobs := r.filterJobs(func(job *types.JobType) bool { return true })
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].Name < jobs[j].Name })
+	return jobs, nil
+}
+
+func (r *inMemoryJobRepository) FindDueJobs(ctx context.Context, now time.Time, limit int) ([]*types.JobType, error) {
+	jobs := r.filterJobs(func(job *types.JobType) bool { return isJobDue(job, now) })
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].NextRunAt.Before(jobs[j].NextRunAt) })
+	if limit > 0 && len(jobs) > limit {
+		jobs = jobs[:limit]
+	}
+	return jobs, nil
+}
+
+func (r *inMemoryJobRepository) AcquireJob(ctx context.Context, jobID uuid.UUID, instanceID string, now, lockUntil time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job, exists := r.jobs[jobID]
+	if !exists {
+		return false, errors.New("job not found")
+	}
+	if !isJobDue(job, now) {
+		return false, nil
+	}
+
+	job.LockedBy = instanceID
+	job.LockedUntil = &lockUntil
+	job.UpdatedAt = time.Now()
+	return true, nil
+}
+
+func (r *inMemoryJobRepository) ReleaseJob(ctx context.Context, job *types.JobType) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.jobs[job.ID]
+	if !exists {
+		return errors.New("job not found")
+	}
+	if stored.LockedBy != job.LockedBy {
+		return repositories.ErrJobLockLost
+	}
+
+	stored.NextRunAt = job.NextRunAt
+	stored.Attempts = job.Attempts
+	stored.IsActive = job.IsActive
+	stored.LastRunAt = job.LastRunAt
+	stored.LastError = job.LastError
+	stored.LockedBy = ""
+	stored.LockedUntil = nil
+	stored.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *inMemoryJobRepository) CreateJobRun(ctx context.Context, run *types.JobRunType) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cloned := *run
+	r.runs = append(r.runs, &cloned)
+	return nil
+}
+
+func (r *inMemoryJobRepository) FinishJobRun(ctx context.Context, run *types.JobRunType) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, stored := range r.runs {
+		if stored.ID == run.ID {
+			stored.Status = run.Status
+			stored.Error = run.Error
+			stored.FinishedAt = run.FinishedAt
+			return nil
+		}
+	}
+	return errors.New("job run not found")
+}
+
+func (r *inMemoryJobRepository) FindJobRuns(ctx context.Context, jobName string, limit int) ([]*types.JobRunType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// Runs are appended in start order, so walk backwards for newest first
+	var runs []*types.JobRunType
+	for i := len(r.runs) - 1; i >= 0; i-- {
+		if jobName != "" && r.runs[i].JobName != jobName {
+			continue
+		}
+		cloned := *r.runs[i]
+		runs = append(runs, &cloned)
+		if limit > 0 && len(runs) == limit {
+			break
+		}
+	}
+	return runs, nil
+}
+
+// filterJobs returns copies of the stored jobs matching the predicate
+func (r *inMemoryJobRepository) filterJobs(match func(job *types.JobType) bool) []*types.JobType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var jobs []*types.JobType
+	for _, job := range r.jobs {
+		if match(job) {
+			jobs = append(jobs, cloneJob(job))
+		}
+	}
+	return jobs
+}
+
+// isJobDue reports whether an active job is due and its lease, if any, has run out
+func isJobDue(job *types.JobType, now time.Time) bool {
+	return job.IsActive && !job.NextRunAt.After(now) && (job.LockedUntil == nil || job.LockedUntil.Before(now))
+}
+
+// cloneJob copies a job so callers never share the stored pointer
+func cloneJob(job *types.JobType) *types.JobType {
+	cloned := *job
+	return &cloned
+}
@@ -0,0 +1,107 @@
+package inmemory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+type inMemoryLoginChallengeRepository struct {
+	mu         sync.RWMutex
+	challenges map[uuid.UUID]*types.LoginChallengeType
+}
+
+func NewInMemoryLoginChallengeRepository() repositories.LoginChallengeRepositoryInterface {
+	return &inMemoryLoginChallengeRepository{
+		challenges: make(map[uuid.UUID]*types.LoginChallengeType),
+	}
+}
+
+func (r *inMemoryLoginChallengeRepository) CreateLoginChallenge(ctx context.Context, challenge *types.LoginChallengeType) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	challenge.CreatedAt = time.Now()
+	cloned := *challenge
+	r.challenges[challenge.ID] = &cloned
+	return nil
+}
+
+func (r *inMemoryLoginChallengeRepository) FindLoginChallengeByTokenHash(ctx context.Context, tokenHash string) (*types.LoginChallengeType, error) {
+	return r.findLoginChallenge(func(challenge *types.LoginChallengeType) bool {
+		return challenge.TokenHash == tokenHash
+	}), nil
+}
+
+func (r *inMemoryLoginChallengeRepository) FindLoginChallengeByNonceHash(ctx context.Context, nonceHash string) (*types.LoginChallengeType, error) {
+	return r.findLoginChallenge(func(challenge *types.LoginChallengeType) bool {
+		return challenge.NonceHash == nonceHash
+	}), nil
+}
+
+func (r *inMemoryLoginChallengeRepository) CountLoginChallengesSince(ctx context.Context, superUserID uuid.UUID, since time.Time) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, challenge := range r.challenges {
+		if challenge.SuperUserID == superUserID && !challenge.CreatedAt.Before(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *inMemoryLoginChallengeRepository) RecordFailedLoginChallengeAttempt(ctx context.Context, challengeID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if challenge, exists := r.challenges[challengeID]; exists {
+		challenge.Attempts++
+	}
+	return nil
+}
+
+func (r *inMemoryLoginChallengeRepository) UseLoginChallenge(ctx context.Context, challengeID uuid.UUID, usedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	challenge, exists := r.challenges[challengeID]
+	if !exists || challenge.UsedAt != nil {
+		return repositories.ErrLoginChallengeUsed
+	}
+	challenge.UsedAt = &usedAt
+	return nil
+}
+
+func (r *inMemoryLoginChallengeRepository) DeleteExpiredLoginChallenges(ctx context.Context, now time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int64
+	for id, challenge := range r.challenges {
+		if challenge.ExpiresAt.Before(now) {
+			delete(r.challenges, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// findLoginChallenge returns a copy of the first challenge matching, or nil
+func (r *inMemoryLoginChallengeRepository) findLoginChallenge(matches func(challenge *types.LoginChallengeType) bool) *types.LoginChallengeType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, challenge := range r.challenges {
+		if matches(challenge) {
+			cloned := *challenge
+			return &cloned
+		}
+	}
+	return nil
+}
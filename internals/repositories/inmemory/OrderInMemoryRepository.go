@@ -0,0 +1,100 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+type inMemoryOrderRepository struct {
+	mu     sync.RWMutex
+	orders map[uuid.UUID]*types.OrderType
+}
+
+func NewInMemoryOrderRepository() repositories.OrderRepositoryInterface {
+	return &inMemoryOrderRepository{
+		orders: make(map[uuid.UUID]*types.OrderType),
+	}
+}
+
+func (r *inMemoryOrderRepository) CreateOrder(ctx context.Context, order *types.OrderType) (*types.OrderType, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	order.CreatedAt = time.Now()
+	order.UpdatedAt = time.Now()
+	r.orders[order.ID] = cloneOrder(order)
+	return order, nil
+}
+
+func (r *inMemoryOrderRepository) FindOrderByID(ctx context.Context, orderID uuid.UUID) (*types.OrderType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	order, exists := r.orders[orderID]
+	if !exists {
+		return nil, errors.New("order not found")
+	}
+	return cloneOrder(order), nil
+}
+
+func (r *inMemoryOrderRepository) FindOrdersByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.OrderType, error) {
+	orders := r.filterOrders(func(order *types.OrderType) bool { return order.EventID == eventID })
+	sort.Slice(orders, func(i, j int) bool { return orders[i].CreatedAt.After(orders[j].CreatedAt) })
+	return orders, nil
+}
+
+func (r *inMemoryOrderRepository) FindExpiredPendingOrders(ctx context.Context, now time.Time, limit int) ([]*types.OrderType, error) {
+	orders := r.filterOrders(func(order *types.OrderType) bool {
+		return order.Status == types.OrderStatusPending && !order.ExpiresAt.After(now)
+	})
+	sort.Slice(orders, func(i, j int) bool { return orders[i].ExpiresAt.Before(orders[j].ExpiresAt) })
+	if limit > 0 && len(orders) > limit {
+		orders = orders[:limit]
+	}
+	return orders, nil
+}
+
+func (r *inMemoryOrderRepository) UpdateOrder(ctx context.Context, order *types.OrderType, fromStatus string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.orders[order.ID]
+	if !exists {
+		return errors.New("order not found")
+	}
+	if stored.Status != fromStatus {
+		return repositories.ErrOrderStatusChanged
+	}
+	updated := cloneOrder(order)
+	updated.UpdatedAt = time.Now()
+	r.orders[order.ID] = updated
+	return nil
+}
+
+// filterOrders returns copies of the orders matching keep
+func (r *inMemoryOrderRepository) filterOrders(keep func(order *types.OrderType) bool) []*types.OrderType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var orders []*types.OrderType
+	for _, order := range r.orders {
+		if keep(order) {
+			orders = append(orders, cloneOrder(order))
+		}
+	}
+	return orders
+}
+
+// cloneOrder copies an order including its attendees, so callers cannot change the stored one
+func cloneOrder(order *types.OrderType) *types.OrderType {
+	cloned := *order
+	cloned.Attendees = append([]types.OrderAttendeeType(nil), order.Attendees...)
+	return &cloned
+}
@@ -0,0 +1,200 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+type inMemoryOrganizationRepository struct {
+	mu            sync.RWMutex
+	organizations map[uuid.UUID]*types.OrganizationType
+	members       map[uuid.UUID]*types.OrganizationMemberType
+	invitations   map[uuid.UUID]*types.OrganizationInvitationType
+}
+
+func NewInMemoryOrganizationRepository() repositories.OrganizationRepositoryInterface {
+	return &inMemoryOrganizationRepository{
+		organizations: make(map[uuid.UUID]*types.OrganizationType),
+		members:       make(map[uuid.UUID]*types.OrganizationMemberType),
+		invitations:   make(map[uuid.UUID]*types.OrganizationInvitationType),
+	}
+}
+
+func (r *inMemoryOrganizationRepository) CreateOrganization(ctx context.Context, organization *types.OrganizationType) (*types.OrganizationType, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	organization.CreatedAt = time.Now()
+	organization.UpdatedAt = time.Now()
+	cloned := *organization
+	r.organizations[organization.ID] = &cloned
+	return organization, nil
+}
+
+func (r *inMemoryOrganizationRepository) FindOrganizationByID(ctx context.Context, organizationID uuid.UUID) (*types.OrganizationType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	organization, exists := r.organizations[organizationID]
+	if !exists {
+		return nil, errors.New("organization not found")
+	}
+	cloned := *organization
+	return &cloned, nil
+}
+
+func (r *inMemoryOrganizationRepository) AddOrganizationMember(ctx context.Context, member *types.OrganizationMemberType) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.members {
+		if existing.OrganizationID == member.OrganizationID && existing.SuperUserID == member.SuperUserID {
+			return repositories.ErrOrganizationMemberExists
+		}
+	}
+	member.CreatedAt = time.Now()
+	member.UpdatedAt = time.Now()
+	cloned := *member
+	r.members[member.ID] = &cloned
+	return nil
+}
+
+func (r *inMemoryOrganizationRepository) FindOrganizationMember(ctx context.Context, organizationID, superUserID uuid.UUID) (*types.OrganizationMemberType, error) {
+	members := r.filterMembers(func(member *types.OrganizationMemberType) bool {
+		return member.OrganizationID == organizationID && member.SuperUserID == superUserID
+	})
+	if len(members) == 0 {
+		return nil, nil
+	}
+	return members[0], nil
+}
+
+func (r *inMemoryOrganizationRepository) FindOrganizationMembers(ctx context.Context, organizationID uuid.UUID) ([]*types.OrganizationMemberType, error) {
+	return r.filterMembers(func(member *types.OrganizationMemberType) bool { return member.OrganizationID == organizationID }), nil
+}
+
+func (r *inMemoryOrganizationRepository) FindMembershipsBySuperUserID(ctx context.Context, superUserID uuid.UUID) ([]*types.OrganizationMemberType, error) {
+	return r.filterMembers(func(member *types.OrganizationMemberType) bool { return member.SuperUserID == superUserID }), nil
+}
+
+func (r *inMemoryOrganizationRepository) UpdateOrganizationMemberRole(ctx context.Context, member *types.OrganizationMemberType) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.members[member.ID]
+	if !exists {
+		return errors.New("organization member not found")
+	}
+	stored.Role = member.Role
+	stored.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *inMemoryOrganizationRepository) RemoveOrganizationMember(ctx context.Context, organizationID, superUserID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, member := range r.members {
+		if member.OrganizationID == organizationID && member.SuperUserID == superUserID {
+			delete(r.members, id)
+		}
+	}
+	return nil
+}
+
+func (r *inMemoryOrganizationRepository) CreateOrganizationInvitation(ctx context.Context, invitation *types.OrganizationInvitationType) (*types.OrganizationInvitationType, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	invitation.CreatedAt = time.Now()
+	cloned := *invitation
+	r.invitations[invitation.ID] = &cloned
+	return invitation, nil
+}
+
+func (r *inMemoryOrganizationRepository) FindOrganizationInvitationByID(ctx context.Context, invitationID uuid.UUID) (*types.OrganizationInvitationType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	invitation, exists := r.invitations[invitationID]
+	if !exists {
+		return nil, errors.New("organization invitation not found")
+	}
+	cloned := *invitation
+	return &cloned, nil
+}
+
+func (r *inMemoryOrganizationRepository) FindOrganizationInvitationByTokenHash(ctx context.Context, tokenHash string) (*types.OrganizationInvitationType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, invitation := range r.invitations {
+		if invitation.TokenHash == tokenHash {
+			cloned := *invitation
+			return &cloned, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *inMemoryOrganizationRepository) FindPendingOrganizationInvitations(ctx context.Context, organizationID uuid.UUID, now time.Time) ([]*types.OrganizationInvitationType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var invitations []*types.OrganizationInvitationType
+	for _, invitation := range r.invitations {
+		if invitation.OrganizationID == organizationID && invitation.IsPending(now) {
+			cloned := *invitation
+			invitations = append(invitations, &cloned)
+		}
+	}
+	sort.Slice(invitations, func(i, j int) bool { return invitations[i].CreatedAt.After(invitations[j].CreatedAt) })
+	return invitations, nil
+}
+
+func (r *inMemoryOrganizationRepository) MarkOrganizationInvitationAccepted(ctx context.Context, invitationID, superUserID uuid.UUID, acceptedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	invitation, exists := r.invitations[invitationID]
+	if !exists {
+		return errors.New("organization invitation not found")
+	}
+	if invitation.AcceptedAt != nil {
+		return repositories.ErrOrganizationInvitationSpent
+	}
+	invitation.AcceptedAt = &acceptedAt
+	invitation.AcceptedByID = &superUserID
+	return nil
+}
+
+func (r *inMemoryOrganizationRepository) DeleteOrganizationInvitation(ctx context.Context, invitationID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.invitations, invitationID)
+	return nil
+}
+
+// filterMembers returns copies of the memberships matching keep, oldest first
+func (r *inMemoryOrganizationRepository) filterMembers(keep func(member *types.OrganizationMemberType) bool) []*types.OrganizationMemberType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var members []*types.OrganizationMemberType
+	for _, member := range r.members {
+		if keep(member) {
+			cloned := *member
+			members = append(members, &cloned)
+		}
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].CreatedAt.Before(members[j].CreatedAt) })
+	return members
+}
@@ -0,0 +1,171 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+type inMemoryPromoCodeRepository struct {
+	mu          sync.RWMutex
+	promoCodes  map[uuid.UUID]*types.PromoCodeType
+	redemptions map[uuid.UUID]*types.PromoRedemptionType // Keyed by order ID
+}
+
+func NewInMemoryPromoCodeRepository() repositories.PromoCodeRepositoryInterface {
+	return &inMemoryPromoCodeRepository{
+		promoCodes:  make(map[uuid.UUID]*types.PromoCodeType),
+		redemptions: make(map[uuid.UUID]*types.PromoRedemptionType),
+	}
+}
+
+func (r *inMemoryPromoCodeRepository) CreatePromoCode(ctx context.Context, promoCode *types.PromoCodeType) (*types.PromoCodeType, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.promoCodes {
+		if existing.EventID == promoCode.EventID && existing.Code == promoCode.Code {
+			return nil, errors.New("promo code already exists")
+		}
+	}
+	promoCode.CreatedAt = time.Now()
+	promoCode.UpdatedAt = time.Now()
+	r.promoCodes[promoCode.ID] = clonePromoCode(promoCode)
+	return promoCode, nil
+}
+
+func (r *inMemoryPromoCodeRepository) FindPromoCodeByID(ctx context.Context, promoCodeID uuid.UUID) (*types.PromoCodeType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	promoCode, exists := r.promoCodes[promoCodeID]
+	if !exists {
+		return nil, errors.New("promo code not found")
+	}
+	return clonePromoCode(promoCode), nil
+}
+
+func (r *inMemoryPromoCodeRepository) FindPromoCodeByCode(ctx context.Context, eventID uuid.UUID, code string) (*types.PromoCodeType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, promoCode := range r.promoCodes {
+		if promoCode.EventID == eventID && promoCode.Code == code {
+			return clonePromoCode(promoCode), nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *inMemoryPromoCodeRepository) FindPromoCodesByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.PromoCodeType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var promoCodes []*types.PromoCodeType
+	for _, promoCode := range r.promoCodes {
+		if promoCode.EventID == eventID {
+			promoCodes = append(promoCodes, clonePromoCode(promoCode))
+		}
+	}
+	sort.Slice(promoCodes, func(i, j int) bool { return promoCodes[i].Code < promoCodes[j].Code })
+	return promoCodes, nil
+}
+
+func (r *inMemoryPromoCodeRepository) UpdatePromoCode(ctx context.Context, promoCode *types.PromoCodeType) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.promoCodes[promoCode.ID]
+	if !exists {
+		return errors.New("promo code not found")
+	}
+	updated := clonePromoCode(promoCode)
+	updated.Redemptions = stored.Redemptions // Only changed by redemptions
+	updated.UpdatedAt = time.Now()
+	r.promoCodes[promoCode.ID] = updated
+	return nil
+}
+
+func (r *inMemoryPromoCodeRepository) DeletePromoCodeByID(ctx context.Context, promoCodeID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.promoCodes[promoCodeID]; !exists {
+		return errors.New("promo code not found")
+	}
+	delete(r.promoCodes, promoCodeID)
+	return nil
+}
+
+func (r *inMemoryPromoCodeRepository) RedeemPromoCode(ctx context.Context, redemption *types.PromoRedemptionType) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	promoCode, exists := r.promoCodes[redemption.PromoCodeID]
+	if !exists {
+		return errors.New("promo code not found")
+	}
+	if promoCode.MaxRedemptions > 0 && promoCode.Redemptions >= promoCode.MaxRedemptions {
+		return repositories.ErrPromoCodeExhausted
+	}
+	if promoCode.MaxRedemptionsPerUser > 0 {
+		used := 0
+		for _, existing := range r.redemptions {
+			if existing.PromoCodeID == promoCode.ID && existing.UserID == redemption.UserID {
+				used++
+			}
+		}
+		if used >= promoCode.MaxRedemptionsPerUser {
+			return repositories.ErrPromoCodeUserLimit
+		}
+	}
+
+	promoCode.Redemptions++
+	redemption.CreatedAt = time.Now()
+	cloned := *redemption
+	r.redemptions[redemption.OrderID] = &cloned
+	return nil
+}
+
+func (r *inMemoryPromoCodeRepository) ReleasePromoRedemption(ctx context.Context, orderID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	redemption, exists := r.redemptions[orderID]
+	if !exists {
+		return nil
+	}
+	delete(r.redemptions, orderID)
+	if promoCode, exists := r.promoCodes[redemption.PromoCodeID]; exists && promoCode.Redemptions > 0 {
+		promoCode.Redemptions--
+	}
+	return nil
+}
+
+func (r *inMemoryPromoCodeRepository) FindPromoRedemptionsByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.PromoRedemptionType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var redemptions []*types.PromoRedemptionType
+	for _, redemption := range r.redemptions {
+		if redemption.EventID == eventID {
+			cloned := *redemption
+			redemptions = append(redemptions, &cloned)
+		}
+	}
+	sort.Slice(redemptions, func(i, j int) bool { return redemptions[i].CreatedAt.Before(redemptions[j].CreatedAt) })
+	return redemptions, nil
+}
+
+// clonePromoCode copies a promo code including its tier list, so callers cannot change the stored one
+func clonePromoCode(promoCode *types.PromoCodeType) *types.PromoCodeType {
+	cloned := *promoCode
+	cloned.TicketTierIDs = append([]uuid.UUID(nil), promoCode.TicketTierIDs...)
+	return &cloned
+}
@@ -0,0 +1,65 @@
+package inmemory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+type inMemoryRegistrationFormRepository struct {
+	mu    sync.RWMutex
+	forms map[uuid.UUID]*types.RegistrationFormType // Keyed by event ID
+}
+
+func NewInMemoryRegistrationFormRepository() repositories.RegistrationFormRepositoryInterface {
+	return &inMemoryRegistrationFormRepository{
+		forms: make(map[uuid.UUID]*types.RegistrationFormType),
+	}
+}
+
+func (r *inMemoryRegistrationFormRepository) FindRegistrationFormByEventID(ctx context.Context, eventID uuid.UUID) (*types.RegistrationFormType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	form, exists := r.forms[eventID]
+	if !exists {
+		return nil, nil
+	}
+	return cloneRegistrationForm(form), nil
+}
+
+func (r *inMemoryRegistrationFormRepository) SaveRegistrationForm(ctx context.Context, form *types.RegistrationFormType) (*types.RegistrationFormType, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, exists := r.forms[form.EventID]; exists {
+		form.ID = existing.ID
+		form.CreatedAt = existing.CreatedAt
+	}
+	form.UpdatedAt = time.Now()
+	r.forms[form.EventID] = cloneRegistrationForm(form)
+	return form, nil
+}
+
+func (r *inMemoryRegistrationFormRepository) DeleteRegistrationFormByEventID(ctx context.Context, eventID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.forms, eventID)
+	return nil
+}
+
+// cloneRegistrationForm copies a form deep enough that callers cannot change the stored fields
+func cloneRegistrationForm(form *types.RegistrationFormType) *types.RegistrationFormType {
+	cloned := *form
+	cloned.Fields = make([]types.RegistrationFieldType, len(form.Fields))
+	for i, field := range form.Fields {
+		field.Options = append([]string(nil), field.Options...)
+		cloned.Fields[i] = field
+	}
+	return &cloned
+}
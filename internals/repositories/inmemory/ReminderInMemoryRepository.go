@@ -0,0 +1,112 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+type inMemoryReminderRepository struct {
+	mu        sync.RWMutex
+	reminders map[uuid.UUID]*types.ReminderType
+}
+
+func NewInMemoryReminderRepository() repositories.ReminderRepositoryInterface {
+	return &inMemoryReminderRepository{
+		reminders: make(map[uuid.UUID]*types.ReminderType),
+	}
+}
+
+func (r *inMemoryReminderRepository) CreateReminder(ctx context.Context, reminder *types.ReminderType) (*types.ReminderType, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reminder.CreatedAt = time.Now()
+	reminder.UpdatedAt = time.Now()
+	r.reminders[reminder.ID] = reminder
+	return reminder, nil
+}
+
+func (r *inMemoryReminderRepository) FindRemindersByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.ReminderType, error) {
+	return r.filterReminders(func(reminder *types.ReminderType) bool {
+		return reminder.EventID == eventID
+	}, 0), nil
+}
+
+func (r *inMemoryReminderRepository) FindDueReminders(ctx context.Context, now time.Time, limit int) ([]*types.ReminderType, error) {
+	return r.filterReminders(func(reminder *types.ReminderType) bool {
+		return reminder.Status == types.ReminderStatusPending && !reminder.SendAt.After(now)
+	}, limit), nil
+}
+
+func (r *inMemoryReminderRepository) MarkGuestNotified(ctx context.Context, reminderID, guestID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reminder, exists := r.reminders[reminderID]
+	if !exists {
+		return errors.New("reminder not found")
+	}
+	if !reminder.HasNotified(guestID) {
+		reminder.GuestsNotified = append(reminder.GuestsNotified, guestID)
+	}
+	reminder.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *inMemoryReminderRepository) UpdateReminderStatus(ctx context.Context, reminderID uuid.UUID, status string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reminder, exists := r.reminders[reminderID]
+	if !exists {
+		return errors.New("reminder not found")
+	}
+
+	now := time.Now()
+	reminder.Status = status
+	if status == types.ReminderStatusSent {
+		reminder.SentAt = &now
+	}
+	reminder.UpdatedAt = now
+	return nil
+}
+
+func (r *inMemoryReminderRepository) DeletePendingRemindersByEventID(ctx context.Context, eventID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, reminder := range r.reminders {
+		if reminder.EventID == eventID && reminder.Status == types.ReminderStatusPending {
+			delete(r.reminders, id)
+		}
+	}
+	return nil
+}
+
+// filterReminders returns copies of the stored reminders matching the predicate, ordered by SendAt; limit 0 means no limit
+func (r *inMemoryReminderRepository) filterReminders(match func(reminder *types.ReminderType) bool, limit int) []*types.ReminderType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var reminders []*types.ReminderType
+	for _, reminder := range r.reminders {
+		if match(reminder) {
+			cloned := *reminder
+			cloned.GuestsNotified = append([]uuid.UUID(nil), reminder.GuestsNotified...)
+			reminders = append(reminders, &cloned)
+		}
+	}
+
+	sort.Slice(reminders, func(i, j int) bool { return reminders[i].SendAt.Before(reminders[j].SendAt) })
+	if limit > 0 && len(reminders) > limit {
+		reminders = reminders[:limit]
+	}
+	return reminders
+}
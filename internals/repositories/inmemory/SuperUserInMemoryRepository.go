@@ -3,6 +3,8 @@ package inmemory
 import (
 	"context"
 	"errors"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -34,6 +36,17 @@ func (r *inMemorySuperUserRepository) CreateSuperUser(ctx context.Context, super
 	return superUser, nil
 }
 
+func (r *inMemorySuperUserRepository) FindSuperUserByID(ctx context.Context, superUserID uuid.UUID) (*types.SuperUserType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	superUser, exists := r.superUsers[superUserID]
+	if !exists {
+		return nil, errors.New("superuser not found")
+	}
+	return superUser, nil
+}
+
 func (r *inMemorySuperUserRepository) FindSuperUserByEmail(ctx context.Context, email string) (*types.SuperUserType, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -89,13 +102,12 @@ func (r *inMemorySuperUserRepository) UpdateSuperUser(ctx context.Context, super
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	existingSuperUser, exists := r.superUsers[superUser.ID]
-	if !exists {
+	if _, exists := r.superUsers[superUser.ID]; !exists {
 		return errors.New("superuser not found")
 	}
 
-	existingSuperUser = superUser
-	existingSuperUser.UpdatedAt = time.Now()
+	superUser.UpdatedAt = time.Now()
+	r.superUsers[superUser.ID] = superUser
 	return nil
 }
 
@@ -129,3 +141,147 @@ func (r *inMemorySuperUserRepository) VerifySuperUserOTP(ctx context.Context, su
 	r.superUsers[superUser.ID] = superUser
 	return nil
 }
+
+// ClearExpiredOTPs removes expired OTPs in-memory
+func (r *inMemorySuperUserRepository) ClearExpiredOTPs(ctx context.Context, now time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var cleared int64
+	for _, superUser := range r.superUsers {
+		if superUser.OTP != nil && superUser.OTPExpiry.Before(now) {
+			superUser.OTP = nil
+			superUser.OTPExpiry = time.Time{}
+			superUser.UpdatedAt = time.Now()
+			cleared++
+		}
+	}
+	return cleared, nil
+}
+
+// ClearExpiredResetTokens removes expired password reset tokens in-memory
+func (r *inMemorySuperUserRepository) ClearExpiredResetTokens(ctx context.Context, now time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var cleared int64
+	for _, superUser := range r.superUsers {
+		if superUser.ResetToken != nil && superUser.ResetTokenExpiry.Before(now) {
+			superUser.ResetToken = nil
+			superUser.ResetTokenExpiry = time.Time{}
+			superUser.UpdatedAt = time.Now()
+			cleared++
+		}
+	}
+	return cleared, nil
+}
+
+// ListSuperUsers finds the superusers matching a filter in-memory
+func (r *inMemorySuperUserRepository) ListSuperUsers(ctx context.Context, filter repositories.SuperUserListFilter, offset, limit int) ([]*types.SuperUserType, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	search := strings.ToLower(filter.Search)
+	var matches []*types.SuperUserType
+	for _, superUser := range r.superUsers {
+		if search != "" &&
+			!strings.Contains(strings.ToLower(superUser.Username), search) &&
+			!strings.Contains(strings.ToLower(superUser.Email), search) &&
+			!strings.Contains(strings.ToLower(superUser.FullName), search) {
+			continue
+		}
+		if (filter.Role != "" && superUser.Role != filter.Role) ||
+			(filter.IsVerified != nil && superUser.IsOTPVerified != *filter.IsVerified) ||
+			(filter.IsActive != nil && superUser.IsActive != *filter.IsActive) ||
+			(!filter.IncludeDeleted && superUser.DeletedAt != nil) {
+			continue
+		}
+		matches = append(matches, superUser)
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Username < matches[j].Username })
+
+	total := int64(len(matches))
+	if offset >= len(matches) {
+		return []*types.SuperUserType{}, total, nil
+	}
+	matches = matches[offset:]
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches, total, nil
+}
+
+// SetSuperUserActive activates or deactivates a superuser in-memory
+func (r *inMemorySuperUserRepository) SetSuperUserActive(ctx context.Context, superUserID uuid.UUID, active bool, sessionsValidAfter time.Time) error {
+	return r.updateSuperUser(superUserID, func(superUser *types.SuperUserType) {
+		superUser.IsActive = active
+		superUser.SessionsValidAfter = sessionsValidAfter
+	})
+}
+
+// UpdateSuperUserRole replaces the role and permission groups of a superuser in-memory
+func (r *inMemorySuperUserRepository) UpdateSuperUserRole(ctx context.Context, superUserID uuid.UUID, role string, permissionGroups []string) error {
+	return r.updateSuperUser(superUserID, func(superUser *types.SuperUserType) {
+		superUser.Role = role
+		superUser.PermissionGroups = permissionGroups
+	})
+}
+
+// ForcePasswordReset stores a reset token and requires a password reset in-memory
+func (r *inMemorySuperUserRepository) ForcePasswordReset(ctx context.Context, superUserID uuid.UUID, resetToken string, resetTokenExpiry time.Time, sessionsValidAfter time.Time) error {
+	return r.updateSuperUser(superUserID, func(superUser *types.SuperUserType) {
+		superUser.ResetToken = &resetToken
+		superUser.ResetTokenExpiry = resetTokenExpiry
+		superUser.PasswordResetRequired = true
+		superUser.SessionsValidAfter = sessionsValidAfter
+	})
+}
+
+// SoftDeleteSuperUser marks a superuser as deleted in-memory
+func (r *inMemorySuperUserRepository) SoftDeleteSuperUser(ctx context.Context, superUserID uuid.UUID, deletedAt time.Time) error {
+	return r.updateSuperUser(superUserID, func(superUser *types.SuperUserType) {
+		superUser.DeletedAt = &deletedAt
+		superUser.IsActive = false
+		superUser.SessionsValidAfter = deletedAt
+	})
+}
+
+// updateSuperUser applies change to a stored superuser under the write lock
+func (r *inMemorySuperUserRepository) updateSuperUser(superUserID uuid.UUID, change func(superUser *types.SuperUserType)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	superUser, exists := r.superUsers[superUserID]
+	if !exists {
+		return errors.New("superuser not found")
+	}
+	change(superUser)
+	superUser.UpdatedAt = time.Now()
+	return nil
+}
+
+// FindSuperUserByEmailChangeToken retrieves the superuser with a pending email change in-memory
+func (r *inMemorySuperUserRepository) FindSuperUserByEmailChangeToken(ctx context.Context, tokenHash string) (*types.SuperUserType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, superUser := range r.superUsers {
+		if superUser.EmailChangeToken != nil && *superUser.EmailChangeToken == tokenHash {
+			return superUser, nil
+		}
+	}
+	return nil, errors.New("superuser not found")
+}
+
+// FindSuperUserByEmailUndoToken retrieves the superuser whose email change can be undone in-memory
+func (r *inMemorySuperUserRepository) FindSuperUserByEmailUndoToken(ctx context.Context, tokenHash string) (*types.SuperUserType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, superUser := range r.superUsers {
+		if superUser.EmailUndoToken != nil && *superUser.EmailUndoToken == tokenHash {
+			return superUser, nil
+		}
+	}
+	return nil, errors.New("superuser not found")
+}
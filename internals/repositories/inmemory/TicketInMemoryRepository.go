@@ -0,0 +1,109 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+type inMemoryTicketRepository struct {
+	mu      sync.RWMutex
+	tickets map[uuid.UUID]*types.TicketType
+}
+
+func NewInMemoryTicketRepository() repositories.TicketRepositoryInterface {
+	return &inMemoryTicketRepository{
+		tickets: make(map[uuid.UUID]*types.TicketType),
+	}
+}
+
+func (r *inMemoryTicketRepository) CreateTicket(ctx context.Context, ticket *types.TicketType) (*types.TicketType, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ticket.CreatedAt = time.Now()
+	ticket.UpdatedAt = time.Now()
+	cloned := *ticket
+	r.tickets[ticket.ID] = &cloned
+	return ticket, nil
+}
+
+func (r *inMemoryTicketRepository) FindTicketByID(ctx context.Context, ticketID uuid.UUID) (*types.TicketType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ticket, exists := r.tickets[ticketID]
+	if !exists {
+		return nil, errors.New("ticket not found")
+	}
+	cloned := *ticket
+	return &cloned, nil
+}
+
+func (r *inMemoryTicketRepository) FindValidTicketByGuestID(ctx context.Context, guestID uuid.UUID) (*types.TicketType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, ticket := range r.tickets {
+		if ticket.GuestID == guestID && ticket.Status == types.TicketStatusValid {
+			cloned := *ticket
+			return &cloned, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *inMemoryTicketRepository) FindTicketsByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.TicketType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var tickets []*types.TicketType
+	for _, ticket := range r.tickets {
+		if ticket.EventID == eventID {
+			cloned := *ticket
+			tickets = append(tickets, &cloned)
+		}
+	}
+	sort.Slice(tickets, func(i, j int) bool { return tickets[i].IssuedAt.Before(tickets[j].IssuedAt) })
+	return tickets, nil
+}
+
+func (r *inMemoryTicketRepository) CheckInTicket(ctx context.Context, ticketID, staffID uuid.UUID, checkedInAt time.Time) error {
+	return r.updateValidTicket(ticketID, func(ticket *types.TicketType) {
+		ticket.Status = types.TicketStatusUsed
+		ticket.CheckedInAt = &checkedInAt
+		ticket.CheckedInBy = &staffID
+	})
+}
+
+func (r *inMemoryTicketRepository) RevokeTicket(ctx context.Context, ticketID uuid.UUID, reason string) error {
+	return r.updateValidTicket(ticketID, func(ticket *types.TicketType) {
+		now := time.Now()
+		ticket.Status = types.TicketStatusRevoked
+		ticket.RevokedAt = &now
+		ticket.RevokedReason = reason
+	})
+}
+
+// updateValidTicket applies update under the lock only while the ticket is still valid
+func (r *inMemoryTicketRepository) updateValidTicket(ticketID uuid.UUID, update func(ticket *types.TicketType)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ticket, exists := r.tickets[ticketID]
+	if !exists {
+		return errors.New("ticket not found")
+	}
+	if ticket.Status != types.TicketStatusValid {
+		return repositories.ErrTicketNotValid
+	}
+	update(ticket)
+	ticket.UpdatedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,148 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+type inMemoryTicketTierRepository struct {
+	mu    sync.RWMutex
+	tiers map[uuid.UUID]*types.TicketTierType
+}
+
+func NewInMemoryTicketTierRepository() repositories.TicketTierRepositoryInterface {
+	return &inMemoryTicketTierRepository{
+		tiers: make(map[uuid.UUID]*types.TicketTierType),
+	}
+}
+
+func (r *inMemoryTicketTierRepository) CreateTicketTier(ctx context.Context, tier *types.TicketTierType) (*types.TicketTierType, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tier.CreatedAt = time.Now()
+	tier.UpdatedAt = time.Now()
+	cloned := *tier
+	r.tiers[tier.ID] = &cloned
+	return tier, nil
+}
+
+func (r *inMemoryTicketTierRepository) FindTicketTierByID(ctx context.Context, tierID uuid.UUID) (*types.TicketTierType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tier, exists := r.tiers[tierID]
+	if !exists {
+		return nil, errors.New("ticket tier not found")
+	}
+	cloned := *tier
+	return &cloned, nil
+}
+
+func (r *inMemoryTicketTierRepository) FindTicketTiersByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.TicketTierType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var tiers []*types.TicketTierType
+	for _, tier := range r.tiers {
+		if tier.EventID == eventID {
+			cloned := *tier
+			tiers = append(tiers, &cloned)
+		}
+	}
+	sort.Slice(tiers, func(i, j int) bool {
+		if tiers[i].PriceCents != tiers[j].PriceCents {
+			return tiers[i].PriceCents < tiers[j].PriceCents
+		}
+		return tiers[i].CreatedAt.Before(tiers[j].CreatedAt)
+	})
+	return tiers, nil
+}
+
+func (r *inMemoryTicketTierRepository) UpdateTicketTier(ctx context.Context, tier *types.TicketTierType) error {
+	return r.updateTier(tier.ID, func(stored *types.TicketTierType) error {
+		if tier.Quantity < stored.Sold+stored.Held {
+			return repositories.ErrTicketTierSoldOut
+		}
+		stored.Name = tier.Name
+		stored.Description = tier.Description
+		stored.PriceCents = tier.PriceCents
+		stored.Currency = tier.Currency
+		stored.Quantity = tier.Quantity
+		stored.SalesStartAt = tier.SalesStartAt
+		stored.SalesEndAt = tier.SalesEndAt
+		return nil
+	})
+}
+
+func (r *inMemoryTicketTierRepository) DeleteTicketTierByID(ctx context.Context, tierID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tier, exists := r.tiers[tierID]
+	if !exists {
+		return errors.New("ticket tier not found")
+	}
+	if tier.Sold+tier.Held > 0 {
+		return repositories.ErrTicketTierInUse
+	}
+	delete(r.tiers, tierID)
+	return nil
+}
+
+func (r *inMemoryTicketTierRepository) ReserveTicketTierInventory(ctx context.Context, tierID uuid.UUID, quantity int) error {
+	return r.updateTier(tierID, func(tier *types.TicketTierType) error {
+		if tier.Available() < quantity {
+			return repositories.ErrTicketTierSoldOut
+		}
+		tier.Held += quantity
+		return nil
+	})
+}
+
+func (r *inMemoryTicketTierRepository) ReleaseTicketTierInventory(ctx context.Context, tierID uuid.UUID, quantity int) error {
+	return r.updateTier(tierID, func(tier *types.TicketTierType) error {
+		tier.Held = max(tier.Held-quantity, 0)
+		return nil
+	})
+}
+
+func (r *inMemoryTicketTierRepository) CommitTicketTierInventory(ctx context.Context, tierID uuid.UUID, quantity int) error {
+	return r.updateTier(tierID, func(tier *types.TicketTierType) error {
+		tier.Held = max(tier.Held-quantity, 0)
+		tier.Sold += quantity
+		return nil
+	})
+}
+
+func (r *inMemoryTicketTierRepository) RestockTicketTierInventory(ctx context.Context, tierID uuid.UUID, quantity int) error {
+	return r.updateTier(tierID, func(tier *types.TicketTierType) error {
+		tier.Sold = max(tier.Sold-quantity, 0)
+		return nil
+	})
+}
+
+// updateTier applies update to the stored tier under the lock; an error from update leaves the tier unchanged
+func (r *inMemoryTicketTierRepository) updateTier(tierID uuid.UUID, update func(tier *types.TicketTierType) error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tier, exists := r.tiers[tierID]
+	if !exists {
+		return errors.New("ticket tier not found")
+	}
+	updated := *tier
+	if err := update(&updated); err != nil {
+		return err
+	}
+	updated.UpdatedAt = time.Now()
+	r.tiers[tierID] = &updated
+	return nil
+}
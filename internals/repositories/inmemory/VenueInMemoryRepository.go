@@ -0,0 +1,116 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+type inMemoryVenueRepository struct {
+	mu     sync.RWMutex
+	venues map[uuid.UUID]*types.VenueType
+}
+
+func NewInMemoryVenueRepository() repositories.VenueRepositoryInterface {
+	return &inMemoryVenueRepository{
+		venues: make(map[uuid.UUID]*types.VenueType),
+	}
+}
+
+func (r *inMemoryVenueRepository) CreateVenue(ctx context.Context, venue *types.VenueType) (*types.VenueType, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	venue.ID = uuid.New()
+	venue.GeoLocation = types.NewGeoPoint(venue.Latitude, venue.Longitude)
+	venue.CreatedAt = time.Now()
+	venue.UpdatedAt = time.Now()
+
+	r.venues[venue.ID] = venue
+	return venue, nil
+}
+
+func (r *inMemoryVenueRepository) FindVenueByID(ctx context.Context, venueID uuid.UUID) (*types.VenueType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	venue, exists := r.venues[venueID]
+	if !exists {
+		return nil, errors.New("venue not found")
+	}
+	return venue, nil
+}
+
+func (r *inMemoryVenueRepository) UpdateVenue(ctx context.Context, venue *types.VenueType) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.venues[venue.ID]; !exists {
+		return errors.New("venue not found")
+	}
+
+	venue.GeoLocation = types.NewGeoPoint(venue.Latitude, venue.Longitude)
+	venue.UpdatedAt = time.Now()
+	r.venues[venue.ID] = venue
+	return nil
+}
+
+func (r *inMemoryVenueRepository) DeleteVenueByID(ctx context.Context, venueID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.venues, venueID)
+	return nil
+}
+
+func (r *inMemoryVenueRepository) FindAllVenues(ctx context.Context) ([]*types.VenueType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	venues := make([]*types.VenueType, 0, len(r.venues))
+	for _, venue := range r.venues {
+		venues = append(venues, venue)
+	}
+	return venues, nil
+}
+
+// FindVenuesWithinRadius computes the haversine distance to every venue and returns the matches nearest first
+func (r *inMemoryVenueRepository) FindVenuesWithinRadius(ctx context.Context, latitude, longitude, radiusMeters float64) ([]*types.VenueType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	distances := make(map[uuid.UUID]float64)
+	var venues []*types.VenueType
+	for _, venue := range r.venues {
+		distance := utils.HaversineDistance(latitude, longitude, venue.Latitude, venue.Longitude)
+		if distance <= radiusMeters {
+			distances[venue.ID] = distance
+			venues = append(venues, venue)
+		}
+	}
+
+	sort.Slice(venues, func(i, j int) bool {
+		return distances[venues[i].ID] < distances[venues[j].ID]
+	})
+	return venues, nil
+}
+
+func (r *inMemoryVenueRepository) FindVenuesWithinBoundingBox(ctx context.Context, minLatitude, minLongitude, maxLatitude, maxLongitude float64) ([]*types.VenueType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var venues []*types.VenueType
+	for _, venue := range r.venues {
+		if utils.IsWithinBoundingBox(venue.Latitude, venue.Longitude, minLatitude, minLongitude, maxLatitude, maxLongitude) {
+			venues = append(venues, venue)
+		}
+	}
+	return venues, nil
+}
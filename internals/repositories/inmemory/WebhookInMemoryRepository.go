@@ -0,0 +1,206 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+type inMemoryWebhookRepository struct {
+	mu         sync.RWMutex
+	webhooks   map[uuid.UUID]*types.WebhookType
+	deliveries map[uuid.UUID]*types.WebhookDeliveryType
+}
+
+func NewInMemoryWebhookRepository() repositories.WebhookRepositoryInterface {
+	return &inMemoryWebhookRepository{
+		webhooks:   make(map[uuid.UUID]*types.WebhookType),
+		deliveries: make(map[uuid.UUID]*types.WebhookDeliveryType),
+	}
+}
+
+func (r *inMemoryWebhookRepository) CreateWebhook(ctx context.Context, webhook *types.WebhookType) (*types.WebhookType, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	webhook.CreatedAt = time.Now()
+	webhook.UpdatedAt = time.Now()
+	r.webhooks[webhook.ID] = cloneWebhook(webhook)
+	return webhook, nil
+}
+
+func (r *inMemoryWebhookRepository) FindWebhookByID(ctx context.Context, webhookID uuid.UUID) (*types.WebhookType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	webhook, exists := r.webhooks[webhookID]
+	if !exists {
+		return nil, errors.New("webhook not found")
+	}
+	return cloneWebhook(webhook), nil
+}
+
+func (r *inMemoryWebhookRepository) FindWebhooksByOrganizerID(ctx context.Context, organizerID uuid.UUID) ([]*types.WebhookType, error) {
+	return r.filterWebhooks(func(webhook *types.WebhookType) bool { return webhook.OrganizerID == organizerID }), nil
+}
+
+func (r *inMemoryWebhookRepository) FindActiveWebhooksByEventType(ctx context.Context, organizerID uuid.UUID, eventType string) ([]*types.WebhookType, error) {
+	return r.filterWebhooks(func(webhook *types.WebhookType) bool {
+		if webhook.OrganizerID != organizerID || !webhook.IsActive {
+			return false
+		}
+		for _, subscribed := range webhook.EventTypes {
+			if subscribed == eventType {
+				return true
+			}
+		}
+		return false
+	}), nil
+}
+
+func (r *inMemoryWebhookRepository) UpdateWebhook(ctx context.Context, webhook *types.WebhookType) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.webhooks[webhook.ID]
+	if !exists {
+		return errors.New("webhook not found")
+	}
+	stored.URL = webhook.URL
+	stored.EventTypes = append([]string(nil), webhook.EventTypes...)
+	stored.Description = webhook.Description
+	stored.IsActive = webhook.IsActive
+	stored.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *inMemoryWebhookRepository) DeleteWebhookByID(ctx context.Context, webhookID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.webhooks[webhookID]; !exists {
+		return errors.New("webhook not found")
+	}
+	delete(r.webhooks, webhookID)
+	for id, delivery := range r.deliveries {
+		if delivery.WebhookID == webhookID {
+			delete(r.deliveries, id)
+		}
+	}
+	return nil
+}
+
+func (r *inMemoryWebhookRepository) CreateWebhookDelivery(ctx context.Context, delivery *types.WebhookDeliveryType) (*types.WebhookDeliveryType, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delivery.CreatedAt = time.Now()
+	delivery.UpdatedAt = time.Now()
+	cloned := *delivery
+	r.deliveries[delivery.ID] = &cloned
+	return delivery, nil
+}
+
+func (r *inMemoryWebhookRepository) FindWebhookDeliveryByID(ctx context.Context, deliveryID uuid.UUID) (*types.WebhookDeliveryType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	delivery, exists := r.deliveries[deliveryID]
+	if !exists {
+		return nil, errors.New("webhook delivery not found")
+	}
+	cloned := *delivery
+	return &cloned, nil
+}
+
+func (r *inMemoryWebhookRepository) FindWebhookDeliveriesByWebhookID(ctx context.Context, webhookID uuid.UUID, limit int) ([]*types.WebhookDeliveryType, error) {
+	deliveries := r.filterDeliveries(func(delivery *types.WebhookDeliveryType) bool { return delivery.WebhookID == webhookID })
+	sort.Slice(deliveries, func(i, j int) bool { return deliveries[i].CreatedAt.After(deliveries[j].CreatedAt) })
+	if limit > 0 && len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+	}
+	return deliveries, nil
+}
+
+func (r *inMemoryWebhookRepository) FindDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]*types.WebhookDeliveryType, error) {
+	deliveries := r.filterDeliveries(func(delivery *types.WebhookDeliveryType) bool { return isWebhookDeliveryDue(delivery, now) })
+	sort.Slice(deliveries, func(i, j int) bool { return deliveries[i].NextAttemptAt.Before(*deliveries[j].NextAttemptAt) })
+	if limit > 0 && len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+	}
+	return deliveries, nil
+}
+
+func (r *inMemoryWebhookRepository) AcquireWebhookDelivery(ctx context.Context, deliveryID uuid.UUID, now, lockUntil time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delivery, exists := r.deliveries[deliveryID]
+	if !exists || !isWebhookDeliveryDue(delivery, now) {
+		return false, nil
+	}
+	delivery.NextAttemptAt = &lockUntil
+	delivery.UpdatedAt = time.Now()
+	return true, nil
+}
+
+func (r *inMemoryWebhookRepository) UpdateWebhookDelivery(ctx context.Context, delivery *types.WebhookDeliveryType) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.deliveries[delivery.ID]; !exists {
+		return errors.New("webhook delivery not found")
+	}
+	cloned := *delivery
+	cloned.UpdatedAt = time.Now()
+	r.deliveries[delivery.ID] = &cloned
+	return nil
+}
+
+// filterWebhooks returns copies of the webhooks matching keep, oldest first
+func (r *inMemoryWebhookRepository) filterWebhooks(keep func(webhook *types.WebhookType) bool) []*types.WebhookType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var webhooks []*types.WebhookType
+	for _, webhook := range r.webhooks {
+		if keep(webhook) {
+			webhooks = append(webhooks, cloneWebhook(webhook))
+		}
+	}
+	sort.Slice(webhooks, func(i, j int) bool { return webhooks[i].CreatedAt.Before(webhooks[j].CreatedAt) })
+	return webhooks
+}
+
+// filterDeliveries returns copies of the deliveries matching keep
+func (r *inMemoryWebhookRepository) filterDeliveries(keep func(delivery *types.WebhookDeliveryType) bool) []*types.WebhookDeliveryType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var deliveries []*types.WebhookDeliveryType
+	for _, delivery := range r.deliveries {
+		if keep(delivery) {
+			cloned := *delivery
+			deliveries = append(deliveries, &cloned)
+		}
+	}
+	return deliveries
+}
+
+// isWebhookDeliveryDue reports whether a delivery is pending and its next attempt is at or before now
+func isWebhookDeliveryDue(delivery *types.WebhookDeliveryType, now time.Time) bool {
+	return delivery.Status == types.WebhookDeliveryStatusPending && delivery.NextAttemptAt != nil && !delivery.NextAttemptAt.After(now)
+}
+
+// cloneWebhook copies a webhook including its event types, so callers cannot change the stored one
+func cloneWebhook(webhook *types.WebhookType) *types.WebhookType {
+	cloned := *webhook
+	cloned.EventTypes = append([]string(nil), webhook.EventTypes...)
+	return &cloned
+}
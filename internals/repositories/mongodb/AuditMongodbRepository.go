@@ -0,0 +1,114 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// maxAuditAppendAttempts bounds how often an append retries after losing its place in the chain to another one
+const maxAuditAppendAttempts = 10
+
+type mongoAuditRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoAuditRepository initializes a new instance of the audit repository.
+func NewMongoAuditRepository(db *mongo.Database) repositories.AuditRepositoryInterface {
+	collection := db.Collection("audit_records")
+
+	// The unique sequence is what keeps two appends from chaining to the same record
+	indexModel := mongo.IndexModel{Keys: bson.D{{Key: "sequence", Value: 1}}, Options: options.Index().SetUnique(true)}
+	if _, err := collection.Indexes().CreateOne(context.Background(), indexModel); err != nil {
+		log.Printf("Failed to create unique sequence index on audit_records: %v", err)
+	}
+
+	return &mongoAuditRepository{
+		collection: collection,
+	}
+}
+
+// AppendAuditRecord chains an audit record to the last one and inserts it in MongoDB. When another append
+// takes the sequence number first, the record is chained to that one and inserted again.
+func (r *mongoAuditRepository) AppendAuditRecord(ctx context.Context, record *types.AuditRecordType) error {
+	for attempt := 0; attempt < maxAuditAppendAttempts; attempt++ {
+		var previous *types.AuditRecordType
+		var last types.AuditRecordType
+		err := r.collection.FindOne(ctx, bson.M{}, options.FindOne().SetSort(bson.M{"sequence": -1})).Decode(&last)
+		switch {
+		case err == nil:
+			previous = &last
+		case !errors.Is(err, mongo.ErrNoDocuments):
+			return err
+		}
+
+		record.ChainTo(previous)
+		_, err = r.collection.InsertOne(ctx, record)
+		if !mongo.IsDuplicateKeyError(err) {
+			return err
+		}
+	}
+	return errors.New("audit chain is too busy; gave up appending")
+}
+
+// ListAuditRecords finds the audit records matching a filter in MongoDB, latest first.
+func (r *mongoAuditRepository) ListAuditRecords(ctx context.Context, filter repositories.AuditRecordFilter, offset, limit int) ([]*types.AuditRecordType, int64, error) {
+	query := bson.M{}
+	if filter.ActorID != nil {
+		query["actor_id"] = *filter.ActorID
+	}
+	if filter.Action != "" {
+		query["action"] = filter.Action
+	}
+	if filter.TargetType != "" {
+		query["target_type"] = filter.TargetType
+	}
+	if filter.TargetID != nil {
+		query["target_id"] = *filter.TargetID
+	}
+	if filter.Since != nil || filter.Until != nil {
+		createdAt := bson.M{}
+		if filter.Since != nil {
+			createdAt["$gte"] = *filter.Since
+		}
+		if filter.Until != nil {
+			createdAt["$lt"] = *filter.Until
+		}
+		query["created_at"] = createdAt
+	}
+
+	total, err := r.collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	findOptions := options.Find().SetSort(bson.M{"sequence": -1}).SetSkip(int64(offset)).SetLimit(int64(limit))
+	cursor, err := r.collection.Find(ctx, query, findOptions)
+	if err != nil {
+		return nil, 0, err
+	}
+	records := []*types.AuditRecordType{}
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, 0, err
+	}
+	return records, total, nil
+}
+
+// FindAuditRecordsAfter finds the audit records following a sequence number in MongoDB, in chain order.
+func (r *mongoAuditRepository) FindAuditRecordsAfter(ctx context.Context, afterSequence int64, limit int) ([]*types.AuditRecordType, error) {
+	findOptions := options.Find().SetSort(bson.M{"sequence": 1}).SetLimit(int64(limit))
+	cursor, err := r.collection.Find(ctx, bson.M{"sequence": bson.M{"$gt": afterSequence}}, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	records := []*types.AuditRecordType{}
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
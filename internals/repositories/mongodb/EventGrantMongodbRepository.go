@@ -0,0 +1,85 @@
+package mongodb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoEventGrantRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoEventGrantRepository initializes a new instance of the event grant repository.
+func NewMongoEventGrantRepository(db *mongo.Database) repositories.EventGrantRepositoryInterface {
+	collection := db.Collection("event_grants")
+
+	indexModel := mongo.IndexModel{
+		Keys:    bson.D{{Key: "event_id", Value: 1}, {Key: "super_user_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+	if _, err := collection.Indexes().CreateOne(context.Background(), indexModel); err != nil {
+		log.Printf("Failed to create unique grant index on event grants: %v", err)
+	}
+
+	return &mongoEventGrantRepository{
+		collection: collection,
+	}
+}
+
+// CreateEventGrant stores a new grant in MongoDB; the unique grant index rejects duplicates.
+func (r *mongoEventGrantRepository) CreateEventGrant(ctx context.Context, grant *types.EventGrantType) error {
+	grant.CreatedAt = time.Now()
+	grant.UpdatedAt = time.Now()
+
+	_, err := r.collection.InsertOne(ctx, grant)
+	if mongo.IsDuplicateKeyError(err) {
+		return repositories.ErrEventGrantExists
+	}
+	return err
+}
+
+// FindEventGrant retrieves the grant of a user on an event in MongoDB.
+func (r *mongoEventGrantRepository) FindEventGrant(ctx context.Context, eventID, superUserID uuid.UUID) (*types.EventGrantType, error) {
+	var grant types.EventGrantType
+	err := r.collection.FindOne(ctx, bson.M{"event_id": eventID, "super_user_id": superUserID}).Decode(&grant)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &grant, nil
+}
+
+// FindEventGrantsByEventID retrieves every grant on an event in MongoDB.
+func (r *mongoEventGrantRepository) FindEventGrantsByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.EventGrantType, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"event_id": eventID}, options.Find().SetSort(bson.M{"created_at": 1}))
+	if err != nil {
+		return nil, err
+	}
+	var grants []*types.EventGrantType
+	if err := cursor.All(ctx, &grants); err != nil {
+		return nil, err
+	}
+	return grants, nil
+}
+
+// UpdateEventGrantRole stores the role of a grant in MongoDB.
+func (r *mongoEventGrantRepository) UpdateEventGrantRole(ctx context.Context, grant *types.EventGrantType) error {
+	update := bson.M{"$set": bson.M{"role": grant.Role, "updated_at": time.Now()}}
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": grant.ID}, update)
+	return err
+}
+
+// DeleteEventGrant removes the grant of a user on an event in MongoDB.
+func (r *mongoEventGrantRepository) DeleteEventGrant(ctx context.Context, eventID, superUserID uuid.UUID) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"event_id": eventID, "super_user_id": superUserID})
+	return err
+}
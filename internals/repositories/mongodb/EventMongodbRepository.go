@@ -2,6 +2,7 @@ package mongodb
 
 import (
 	"context"
+	"regexp"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,6 +25,10 @@ func NewMongoEventRepository(db *mongo.Database) repositories.EventRepositoryInt
 
 // CreateEvent creates a new event record in MongoDB.
 func (r *mongoEventRepository) CreateEvent(ctx context.Context, event *types.EventType) (*types.EventType, error) {
+	if err := repositories.CheckTenant(ctx, event.OrganizationID); err != nil {
+		return nil, err
+	}
+
 	event.ID = uuid.New()
 	event.CreatedAt = time.Now()
 	event.UpdatedAt = time.Now()
@@ -38,7 +43,19 @@ func (r *mongoEventRepository) CreateEvent(ctx context.Context, event *types.Eve
 // FindEventByID finds an event by its ID in MongoDB.
 func (r *mongoEventRepository) FindEventByID(ctx context.Context, eventID uuid.UUID) (*types.EventType, error) {
 	var event types.EventType
-	err := r.collection.FindOne(ctx, bson.M{"id": eventID}).Decode(&event)
+	err := r.collection.FindOne(ctx, tenantFilter(ctx, bson.M{"_id": eventID})).Decode(&event)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// FindEventBySlug finds the event with the given public page slug in MongoDB.
+func (r *mongoEventRepository) FindEventBySlug(ctx context.Context, slug string) (*types.EventType, error) {
+	var event types.EventType
+	err := r.collection.FindOne(ctx, bson.M{"slug": slug}).Decode(&event)
 	if err == mongo.ErrNoDocuments {
 		return nil, nil
 	} else if err != nil {
@@ -50,7 +67,7 @@ func (r *mongoEventRepository) FindEventByID(ctx context.Context, eventID uuid.U
 // FindEventsByOrganizerID retrieves events organized by a specific user.
 func (r *mongoEventRepository) FindEventsByOrganizerID(ctx context.Context, organizerID uuid.UUID) ([]*types.EventType, error) {
 	var events []*types.EventType
-	cursor, err := r.collection.Find(ctx, bson.M{"organizer_id": organizerID})
+	cursor, err := r.collection.Find(ctx, tenantFilter(ctx, bson.M{"organizer_id": organizerID}))
 	if err != nil {
 		return nil, err
 	}
@@ -62,24 +79,28 @@ func (r *mongoEventRepository) FindEventsByOrganizerID(ctx context.Context, orga
 
 // UpdateEvent updates an existing event in MongoDB.
 func (r *mongoEventRepository) UpdateEvent(ctx context.Context, event *types.EventType) error {
+	if err := repositories.CheckTenant(ctx, event.OrganizationID); err != nil {
+		return err
+	}
+
 	event.UpdatedAt = time.Now()
-	filter := bson.M{"id": event.ID}
+	filter := bson.M{"_id": event.ID}
 	update := bson.M{"$set": event}
 
-	_, err := r.collection.UpdateOne(ctx, filter, update)
+	_, err := r.collection.UpdateOne(ctx, tenantFilter(ctx, filter), update)
 	return err
 }
 
 // DeleteEventByID deletes an event by its ID in MongoDB.
 func (r *mongoEventRepository) DeleteEventByID(ctx context.Context, eventID uuid.UUID) error {
-	_, err := r.collection.DeleteOne(ctx, bson.M{"id": eventID})
+	_, err := r.collection.DeleteOne(ctx, tenantFilter(ctx, bson.M{"_id": eventID}))
 	return err
 }
 
 // FindAllEvents retrieves all events from MongoDB.
 func (r *mongoEventRepository) FindAllEvents(ctx context.Context) ([]*types.EventType, error) {
 	var events []*types.EventType
-	cursor, err := r.collection.Find(ctx, bson.M{})
+	cursor, err := r.collection.Find(ctx, tenantFilter(ctx, bson.M{}))
 	if err != nil {
 		return nil, err
 	}
@@ -92,10 +113,10 @@ func (r *mongoEventRepository) FindAllEvents(ctx context.Context) ([]*types.Even
 // FindEventsByDateRange finds events occurring between a start and end date.
 func (r *mongoEventRepository) FindEventsByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*types.EventType, error) {
 	var events []*types.EventType
-	cursor, err := r.collection.Find(ctx, bson.M{
+	cursor, err := r.collection.Find(ctx, tenantFilter(ctx, bson.M{
 		"start_time": bson.M{"$gte": startDate},
 		"end_time":   bson.M{"$lte": endDate},
-	})
+	}))
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +129,64 @@ func (r *mongoEventRepository) FindEventsByDateRange(ctx context.Context, startD
 // FindEventsByLocation finds events based on location.
 func (r *mongoEventRepository) FindEventsByLocation(ctx context.Context, location string) ([]*types.EventType, error) {
 	var events []*types.EventType
-	cursor, err := r.collection.Find(ctx, bson.M{"location": location})
+	cursor, err := r.collection.Find(ctx, tenantFilter(ctx, bson.M{"location": location}))
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// FindEventsByVenueIDs retrieves events held at any of the specified venues.
+func (r *mongoEventRepository) FindEventsByVenueIDs(ctx context.Context, venueIDs []uuid.UUID) ([]*types.EventType, error) {
+	var events []*types.EventType
+	cursor, err := r.collection.Find(ctx, tenantFilter(ctx, bson.M{"venue_id": bson.M{"$in": venueIDs}}))
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// FindOverlappingEvents retrieves active events at the venue or run by the organizer that may overlap the window.
+func (r *mongoEventRepository) FindOverlappingEvents(ctx context.Context, venueID, organizerID *uuid.UUID, windowStart, windowEnd time.Time) ([]*types.EventType, error) {
+	var events []*types.EventType
+
+	parties := []bson.M{}
+	if venueID != nil {
+		parties = append(parties, bson.M{"venue_id": *venueID})
+	}
+	if organizerID != nil {
+		parties = append(parties, bson.M{"organizer_id": *organizerID})
+	}
+	if len(parties) == 0 {
+		return events, nil
+	}
+
+	filter := bson.M{
+		"is_active":  true,
+		"status":     bson.M{"$ne": types.EventStatusCancelled},
+		"start_time": bson.M{"$lt": windowEnd},
+		"$and": []bson.M{
+			{"$or": parties},
+			{"$or": []bson.M{
+				{"end_time": bson.M{"$gt": windowStart}},
+				{
+					"recurrence": bson.M{"$ne": nil},
+					"$or": []bson.M{
+						{"recurrence.until": bson.M{"$exists": false}},
+						{"recurrence.until": bson.M{"$gt": windowStart}},
+					},
+				},
+			}},
+		},
+	}
+
+	cursor, err := r.collection.Find(ctx, tenantFilter(ctx, filter))
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +199,7 @@ func (r *mongoEventRepository) FindEventsByLocation(ctx context.Context, locatio
 // FindEventsByMultipleTags retrieves events that match any of the specified tags.
 func (r *mongoEventRepository) FindEventsByMultipleTags(ctx context.Context, tags []string) ([]*types.EventType, error) {
 	var events []*types.EventType
-	cursor, err := r.collection.Find(ctx, bson.M{"tags": bson.M{"$in": tags}})
+	cursor, err := r.collection.Find(ctx, tenantFilter(ctx, bson.M{"tags": bson.M{"$in": tags}}))
 	if err != nil {
 		return nil, err
 	}
@@ -141,10 +219,22 @@ func (r *mongoEventRepository) DeactivateEvent(ctx context.Context, eventID uuid
 	return r.updateEventStatus(ctx, eventID, false)
 }
 
-// FindUpcomingEvents retrieves events scheduled for future dates.
+// FindUpcomingEvents retrieves events scheduled for future dates, including recurring series that have not ended.
 func (r *mongoEventRepository) FindUpcomingEvents(ctx context.Context) ([]*types.EventType, error) {
 	var events []*types.EventType
-	cursor, err := r.collection.Find(ctx, bson.M{"start_time": bson.M{"$gt": time.Now()}})
+	now := time.Now().UTC()
+	cursor, err := r.collection.Find(ctx, tenantFilter(ctx, bson.M{
+		"$or": []bson.M{
+			{"start_time": bson.M{"$gt": now}},
+			{
+				"recurrence": bson.M{"$ne": nil},
+				"$or": []bson.M{
+					{"recurrence.until": bson.M{"$exists": false}},
+					{"recurrence.until": bson.M{"$gt": now}},
+				},
+			},
+		},
+	}))
 	if err != nil {
 		return nil, err
 	}
@@ -157,7 +247,7 @@ func (r *mongoEventRepository) FindUpcomingEvents(ctx context.Context) ([]*types
 // FindPastEvents retrieves events that have already occurred.
 func (r *mongoEventRepository) FindPastEvents(ctx context.Context) ([]*types.EventType, error) {
 	var events []*types.EventType
-	cursor, err := r.collection.Find(ctx, bson.M{"end_time": bson.M{"$lt": time.Now()}})
+	cursor, err := r.collection.Find(ctx, tenantFilter(ctx, bson.M{"end_time": bson.M{"$lt": time.Now().UTC()}}))
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +260,7 @@ func (r *mongoEventRepository) FindPastEvents(ctx context.Context) ([]*types.Eve
 // FindActiveEvents retrieves all currently active events.
 func (r *mongoEventRepository) FindActiveEvents(ctx context.Context) ([]*types.EventType, error) {
 	var events []*types.EventType
-	cursor, err := r.collection.Find(ctx, bson.M{"is_active": true})
+	cursor, err := r.collection.Find(ctx, tenantFilter(ctx, bson.M{"is_active": true}))
 	if err != nil {
 		return nil, err
 	}
@@ -183,7 +273,7 @@ func (r *mongoEventRepository) FindActiveEvents(ctx context.Context) ([]*types.E
 // FindInactiveEvents retrieves all inactive events.
 func (r *mongoEventRepository) FindInactiveEvents(ctx context.Context) ([]*types.EventType, error) {
 	var events []*types.EventType
-	cursor, err := r.collection.Find(ctx, bson.M{"is_active": false})
+	cursor, err := r.collection.Find(ctx, tenantFilter(ctx, bson.M{"is_active": false}))
 	if err != nil {
 		return nil, err
 	}
@@ -196,7 +286,7 @@ func (r *mongoEventRepository) FindInactiveEvents(ctx context.Context) ([]*types
 // SearchEventsByTitle finds events by searching their titles in MongoDB.
 func (r *mongoEventRepository) SearchEventsByTitle(ctx context.Context, title string) ([]*types.EventType, error) {
 	var events []*types.EventType
-	cursor, err := r.collection.Find(ctx, bson.M{"title": bson.M{"$regex": title, "$options": "i"}})
+	cursor, err := r.collection.Find(ctx, tenantFilter(ctx, bson.M{"title": bson.M{"$regex": regexp.QuoteMeta(title), "$options": "i"}}))
 	if err != nil {
 		return nil, err
 	}
@@ -206,28 +296,59 @@ func (r *mongoEventRepository) SearchEventsByTitle(ctx context.Context, title st
 	return events, nil
 }
 
-// CancelEvent cancels an event by marking it as inactive in MongoDB.
-func (r *mongoEventRepository) CancelEvent(ctx context.Context, eventID uuid.UUID) error {
-	return r.updateEventStatus(ctx, eventID, false)
+// TransitionEventStatus moves an event to a new lifecycle state in MongoDB, guarded by its current state.
+func (r *mongoEventRepository) TransitionEventStatus(ctx context.Context, eventID uuid.UUID, change *types.EventStatusChangeType) error {
+	filter := bson.M{"_id": eventID, "status": change.From}
+	if change.From == types.EventStatusPublished {
+		// Events stored before lifecycle states existed have no status and count as published
+		filter["status"] = bson.M{"$in": []interface{}{types.EventStatusPublished, nil}}
+	}
+
+	set := bson.M{
+		"status":     change.To,
+		"updated_at": time.Now(),
+	}
+	if change.To == types.EventStatusCancelled {
+		set["cancellation_reason"] = change.Reason
+	}
+
+	update := bson.M{
+		"$set":  set,
+		"$push": bson.M{"status_history": change},
+	}
+	if change.To == types.EventStatusCancelled {
+		// A cancellation replaces the invitation guests already have
+		update["$inc"] = bson.M{"sequence": 1}
+	}
+
+	result, err := r.collection.UpdateOne(ctx, tenantFilter(ctx, filter), update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return repositories.ErrEventStatusChanged
+	}
+	return nil
 }
 
-// RescheduleEvent reschedules an event to a new date and time in MongoDB.
+// RescheduleEvent reschedules an event to a new date and time in MongoDB, bumping its iCalendar sequence.
 func (r *mongoEventRepository) RescheduleEvent(ctx context.Context, eventID uuid.UUID, newStartTime, newEndTime time.Time) error {
-	filter := bson.M{"id": eventID}
+	filter := bson.M{"_id": eventID}
 	update := bson.M{
 		"$set": bson.M{
-			"start_time": newStartTime,
-			"end_time":   newEndTime,
+			"start_time": newStartTime.UTC(),
+			"end_time":   newEndTime.UTC(),
 			"updated_at": time.Now(),
 		},
+		"$inc": bson.M{"sequence": 1},
 	}
-	_, err := r.collection.UpdateOne(ctx, filter, update)
+	_, err := r.collection.UpdateOne(ctx, tenantFilter(ctx, filter), update)
 	return err
 }
 
 // CountTotalEvents returns the total number of events in the system.
 func (r *mongoEventRepository) CountTotalEvents(ctx context.Context) (int64, error) {
-	count, err := r.collection.CountDocuments(ctx, bson.M{})
+	count, err := r.collection.CountDocuments(ctx, tenantFilter(ctx, bson.M{}))
 	if err != nil {
 		return 0, err
 	}
@@ -236,7 +357,7 @@ func (r *mongoEventRepository) CountTotalEvents(ctx context.Context) (int64, err
 
 // CountEventsByOrganizerID counts the number of events organized by a specific user.
 func (r *mongoEventRepository) CountEventsByOrganizerID(ctx context.Context, organizerID uuid.UUID) (int64, error) {
-	count, err := r.collection.CountDocuments(ctx, bson.M{"organizer_id": organizerID})
+	count, err := r.collection.CountDocuments(ctx, tenantFilter(ctx, bson.M{"organizer_id": organizerID}))
 	if err != nil {
 		return 0, err
 	}
@@ -245,13 +366,27 @@ func (r *mongoEventRepository) CountEventsByOrganizerID(ctx context.Context, org
 
 // updateEventStatus is a helper function to activate or deactivate an event.
 func (r *mongoEventRepository) updateEventStatus(ctx context.Context, eventID uuid.UUID, isActive bool) error {
-	filter := bson.M{"id": eventID}
+	filter := bson.M{"_id": eventID}
 	update := bson.M{
 		"$set": bson.M{
 			"is_active":  isActive,
 			"updated_at": time.Now(),
 		},
 	}
-	_, err := r.collection.UpdateOne(ctx, filter, update)
+	_, err := r.collection.UpdateOne(ctx, tenantFilter(ctx, filter), update)
 	return err
 }
+
+// tenantFilter limits a filter on events or guests to the tenant in ctx, if any; personal records have no organization
+func tenantFilter(ctx context.Context, filter bson.M) bson.M {
+	scope, ok := repositories.TenantScopeFromContext(ctx)
+	if !ok {
+		return filter
+	}
+	if scope.OrganizationID == nil {
+		filter["organization_id"] = nil
+	} else {
+		filter["organization_id"] = *scope.OrganizationID
+	}
+	return filter
+}
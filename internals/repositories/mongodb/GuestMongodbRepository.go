@@ -2,27 +2,44 @@ package mongodb
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lordofthemind/EventureGo/internals/repositories"
 	"github.com/lordofthemind/EventureGo/internals/types"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// guestStreamBatchSize is how many guests the MongoDB cursor fetches per round trip when streaming
+const guestStreamBatchSize = 500
+
+// seatLockLease bounds how long an acceptance holds the seat lock of an event, so a crashed instance cannot keep it
+const seatLockLease = 10 * time.Second
+
+// seatLockRetryInterval is the wait before trying again for a seat lock another acceptance holds
+const seatLockRetryInterval = 20 * time.Millisecond
+
 type mongoGuestRepository struct {
-	collection *mongo.Collection
+	collection         *mongo.Collection
+	seatLockCollection *mongo.Collection
 }
 
 // NewMongoGuestRepository initializes a new instance of the guest repository.
 func NewMongoGuestRepository(db *mongo.Database) repositories.GuestRepositoryInterface {
 	return &mongoGuestRepository{
-		collection: db.Collection("guests"),
+		collection:         db.Collection("guests"),
+		seatLockCollection: db.Collection("guest_seat_locks"),
 	}
 }
 
 // AddGuest adds a new guest to an event in MongoDB.
 func (r *mongoGuestRepository) AddGuest(ctx context.Context, guest *types.GuestType) (*types.GuestType, error) {
+	if err := repositories.CheckTenant(ctx, guest.OrganizationID); err != nil {
+		return nil, err
+	}
+
 	guest.ID = uuid.New()
 	_, err := r.collection.InsertOne(ctx, guest)
 	if err != nil {
@@ -35,6 +52,9 @@ func (r *mongoGuestRepository) AddGuest(ctx context.Context, guest *types.GuestT
 func (r *mongoGuestRepository) AddBulkGuest(ctx context.Context, guests []*types.GuestType) ([]*types.GuestType, error) {
 	var bulkOps []mongo.WriteModel
 	for _, guest := range guests {
+		if err := repositories.CheckTenant(ctx, guest.OrganizationID); err != nil {
+			return nil, err
+		}
 		guest.ID = uuid.New()
 		bulkOps = append(bulkOps, mongo.NewInsertOneModel().SetDocument(guest))
 	}
@@ -48,7 +68,7 @@ func (r *mongoGuestRepository) AddBulkGuest(ctx context.Context, guests []*types
 // FindGuestsByEventID retrieves all guests for a given event in MongoDB.
 func (r *mongoGuestRepository) FindGuestsByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.GuestType, error) {
 	filter := bson.M{"event_id": eventID}
-	cursor, err := r.collection.Find(ctx, filter)
+	cursor, err := r.collection.Find(ctx, tenantFilter(ctx, filter))
 	if err != nil {
 		return nil, err
 	}
@@ -61,35 +81,191 @@ func (r *mongoGuestRepository) FindGuestsByEventID(ctx context.Context, eventID
 	return guests, nil
 }
 
+// StreamGuestsByEventID iterates the guests of an event in MongoDB with a cursor.
+func (r *mongoGuestRepository) StreamGuestsByEventID(ctx context.Context, eventID uuid.UUID, rsvpStatus string, checkedIn *bool, visit func(guest *types.GuestType) error) error {
+	filter := bson.M{"event_id": eventID}
+	if rsvpStatus != "" {
+		filter["rsvp_status"] = rsvpStatus
+	}
+	if checkedIn != nil {
+		if *checkedIn {
+			filter["checked_in_at"] = bson.M{"$ne": nil}
+		} else {
+			filter["checked_in_at"] = nil
+		}
+	}
+
+	opts := options.Find().SetSort(bson.M{"baseusertype.full_name": 1}).SetBatchSize(guestStreamBatchSize)
+	cursor, err := r.collection.Find(ctx, tenantFilter(ctx, filter), opts)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var guest types.GuestType
+		if err := cursor.Decode(&guest); err != nil {
+			return err
+		}
+		if err := visit(&guest); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
 // FindGuestByID retrieves a guest by their ID in MongoDB.
 func (r *mongoGuestRepository) FindGuestByID(ctx context.Context, guestID uuid.UUID) (*types.GuestType, error) {
-	filter := bson.M{"id": guestID}
+	filter := bson.M{"baseusertype._id": guestID}
 	var guest types.GuestType
-	err := r.collection.FindOne(ctx, filter).Decode(&guest)
+	err := r.collection.FindOne(ctx, tenantFilter(ctx, filter)).Decode(&guest)
 	if err != nil {
 		return nil, err
 	}
 	return &guest, nil
 }
 
+// FindGuestByEventIDAndEmail retrieves the guest of an event with the given email in MongoDB.
+func (r *mongoGuestRepository) FindGuestByEventIDAndEmail(ctx context.Context, eventID uuid.UUID, email string) (*types.GuestType, error) {
+	filter := bson.M{"event_id": eventID, "baseusertype.email": email}
+	var guest types.GuestType
+	err := r.collection.FindOne(ctx, tenantFilter(ctx, filter)).Decode(&guest)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &guest, nil
+}
+
 // UpdateGuest updates the information of an existing guest in MongoDB.
 func (r *mongoGuestRepository) UpdateGuest(ctx context.Context, guest *types.GuestType) error {
-	filter := bson.M{"id": guest.ID}
+	if err := repositories.CheckTenant(ctx, guest.OrganizationID); err != nil {
+		return err
+	}
+	filter := bson.M{"baseusertype._id": guest.ID}
 	update := bson.M{"$set": guest}
-	_, err := r.collection.UpdateOne(ctx, filter, update)
+	_, err := r.collection.UpdateOne(ctx, tenantFilter(ctx, filter), update)
 	return err
 }
 
+// AcceptGuestWithinCapacity stores a guest who accepted in MongoDB. The seats are counted while holding the seat
+// lock of the event, so acceptances for the same event wait for each other.
+func (r *mongoGuestRepository) AcceptGuestWithinCapacity(ctx context.Context, guest *types.GuestType, capacity int) error {
+	if capacity <= 0 {
+		return r.UpdateGuest(ctx, guest)
+	}
+	if err := repositories.CheckTenant(ctx, guest.OrganizationID); err != nil {
+		return err
+	}
+
+	release, err := r.lockSeats(ctx, guest.EventID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	filter := bson.M{
+		"event_id":         guest.EventID,
+		"baseusertype._id": bson.M{"$ne": guest.ID},
+		"rsvp_status":      types.RSVPStatusAccepted,
+	}
+	accepted, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if accepted >= int64(capacity) {
+		return repositories.ErrEventFull
+	}
+	return r.UpdateGuest(ctx, guest)
+}
+
+// lockSeats takes the seat lock of an event in MongoDB, waiting while another acceptance holds it, and returns the
+// function that gives it back. A held lock that has not expired does not match, so the upsert tries to insert a
+// second lock and hits the unique _id.
+func (r *mongoGuestRepository) lockSeats(ctx context.Context, eventID uuid.UUID) (func(), error) {
+	token := uuid.New()
+	for {
+		now := time.Now()
+		filter := bson.M{"_id": eventID, "locked_until": bson.M{"$lt": now}}
+		update := bson.M{"$set": bson.M{"token": token, "locked_until": now.Add(seatLockLease)}}
+		_, err := r.seatLockCollection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+		if err == nil {
+			return func() {
+				// Given back even when the caller gave up meanwhile, so the next acceptance does not wait for the lease
+				r.seatLockCollection.DeleteOne(context.Background(), bson.M{"_id": eventID, "token": token})
+			}, nil
+		}
+		if !mongo.IsDuplicateKeyError(err) {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(seatLockRetryInterval):
+		}
+	}
+}
+
 // DeleteGuestByID removes a guest by their ID in MongoDB.
 func (r *mongoGuestRepository) DeleteGuestByID(ctx context.Context, guestID uuid.UUID) error {
-	filter := bson.M{"id": guestID}
-	_, err := r.collection.DeleteOne(ctx, filter)
+	filter := bson.M{"baseusertype._id": guestID}
+	_, err := r.collection.DeleteOne(ctx, tenantFilter(ctx, filter))
 	return err
 }
 
 // CountGuestsByEventID counts the number of guests for a given event in MongoDB.
 func (r *mongoGuestRepository) CountGuestsByEventID(ctx context.Context, eventID uuid.UUID) (int64, error) {
 	filter := bson.M{"event_id": eventID}
-	count, err := r.collection.CountDocuments(ctx, filter)
+	count, err := r.collection.CountDocuments(ctx, tenantFilter(ctx, filter))
 	return count, err
 }
+
+// CountGuestsByRSVPStatus counts the guests of an event per RSVP status in MongoDB.
+func (r *mongoGuestRepository) CountGuestsByRSVPStatus(ctx context.Context, eventID uuid.UUID) (map[string]int64, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: tenantFilter(ctx, bson.M{"event_id": eventID})}},
+		{{Key: "$group", Value: bson.M{"_id": "$rsvp_status", "count": bson.M{"$sum": 1}}}},
+	}
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var groups []struct {
+		Status string `bson:"_id"`
+		Count  int64  `bson:"count"`
+	}
+	if err = cursor.All(ctx, &groups); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(groups))
+	for _, group := range groups {
+		counts[group.Status] = group.Count
+	}
+	return counts, nil
+}
+
+// CountCheckedInGuestsByEventID counts the checked-in guests of an event in MongoDB.
+func (r *mongoGuestRepository) CountCheckedInGuestsByEventID(ctx context.Context, eventID uuid.UUID) (int64, error) {
+	filter := bson.M{"event_id": eventID, "checked_in_at": bson.M{"$ne": nil}}
+	return r.collection.CountDocuments(ctx, tenantFilter(ctx, filter))
+}
+
+// FindNextWaitlistedGuest retrieves the longest-waiting guest of an event in MongoDB.
+func (r *mongoGuestRepository) FindNextWaitlistedGuest(ctx context.Context, eventID uuid.UUID) (*types.GuestType, error) {
+	filter := bson.M{"event_id": eventID, "rsvp_status": types.RSVPStatusWaitlisted}
+	opts := options.FindOne().SetSort(bson.M{"baseusertype.updated_at": 1})
+
+	var guest types.GuestType
+	err := r.collection.FindOne(ctx, tenantFilter(ctx, filter), opts).Decode(&guest)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &guest, nil
+}
@@ -0,0 +1,192 @@
+package mongodb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoJobRepository struct {
+	collection    *mongo.Collection
+	runCollection *mongo.Collection
+}
+
+// NewMongoJobRepository initializes a new instance of the job repository.
+func NewMongoJobRepository(db *mongo.Database) repositories.JobRepositoryInterface {
+	collection := db.Collection("jobs")
+
+	indexModel := mongo.IndexModel{Keys: bson.D{{Key: "name", Value: 1}}, Options: options.Index().SetUnique(true)}
+	if _, err := collection.Indexes().CreateOne(context.Background(), indexModel); err != nil {
+		log.Printf("Failed to create unique name index on jobs: %v", err)
+	}
+
+	return &mongoJobRepository{
+		collection:    collection,
+		runCollection: db.Collection("job_runs"),
+	}
+}
+
+// UpsertJob creates or updates a job by name in MongoDB.
+func (r *mongoJobRepository) UpsertJob(ctx context.Context, job *types.JobType, overwriteNextRun bool) (*types.JobType, error) {
+	set := bson.M{
+		"kind":         job.Kind,
+		"schedule":     job.Schedule,
+		"payload":      job.Payload,
+		"max_attempts": job.MaxAttempts,
+		"is_active":    job.IsActive,
+		"updated_at":   time.Now(),
+	}
+	setOnInsert := bson.M{
+		"_id":        job.ID,
+		"attempts":   0,
+		"created_at": time.Now(),
+	}
+	if overwriteNextRun {
+		set["next_run_at"] = job.NextRunAt
+		set["attempts"] = 0
+		delete(setOnInsert, "attempts")
+	} else {
+		setOnInsert["next_run_at"] = job.NextRunAt
+	}
+
+	update := bson.M{"$set": set, "$setOnInsert": setOnInsert}
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"name": job.Name}, update, options.Update().SetUpsert(true)); err != nil {
+		return nil, err
+	}
+
+	var stored types.JobType
+	if err := r.collection.FindOne(ctx, bson.M{"name": job.Name}).Decode(&stored); err != nil {
+		return nil, err
+	}
+	return &stored, nil
+}
+
+// FindJobByName retrieves a job by name in MongoDB.
+func (r *mongoJobRepository) FindJobByName(ctx context.Context, name string) (*types.JobType, error) {
+	var job types.JobType
+	err := r.collection.FindOne(ctx, bson.M{"name": name}).Decode(&job)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// FindAllJobs retrieves every job in MongoDB ordered by name.
+func (r *mongoJobRepository) FindAllJobs(ctx context.Context) ([]*types.JobType, error) {
+	return r.findJobs(ctx, bson.M{}, options.Find().SetSort(bson.M{"name": 1}))
+}
+
+// FindDueJobs retrieves active, unlocked jobs that are due in MongoDB.
+func (r *mongoJobRepository) FindDueJobs(ctx context.Context, now time.Time, limit int) ([]*types.JobType, error) {
+	return r.findJobs(ctx, dueJobFilter(now), options.Find().SetSort(bson.M{"next_run_at": 1}).SetLimit(int64(limit)))
+}
+
+// AcquireJob takes the lease on a due job in MongoDB with a single conditional update.
+func (r *mongoJobRepository) AcquireJob(ctx context.Context, jobID uuid.UUID, instanceID string, now, lockUntil time.Time) (bool, error) {
+	filter := dueJobFilter(now)
+	filter["_id"] = jobID
+
+	update := bson.M{"$set": bson.M{
+		"locked_by":    instanceID,
+		"locked_until": lockUntil,
+		"updated_at":   time.Now(),
+	}}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount == 1, nil
+}
+
+// ReleaseJob stores the outcome of a run and clears the lease in MongoDB.
+func (r *mongoJobRepository) ReleaseJob(ctx context.Context, job *types.JobType) error {
+	update := bson.M{
+		"$set": bson.M{
+			"next_run_at": job.NextRunAt,
+			"attempts":    job.Attempts,
+			"is_active":   job.IsActive,
+			"last_run_at": job.LastRunAt,
+			"last_error":  job.LastError,
+			"updated_at":  time.Now(),
+		},
+		"$unset": bson.M{"locked_by": "", "locked_until": ""},
+	}
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": job.ID, "locked_by": job.LockedBy}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return repositories.ErrJobLockLost
+	}
+	return nil
+}
+
+// CreateJobRun records the start of a job run in MongoDB.
+func (r *mongoJobRepository) CreateJobRun(ctx context.Context, run *types.JobRunType) error {
+	_, err := r.runCollection.InsertOne(ctx, run)
+	return err
+}
+
+// FinishJobRun stores the outcome of a job run in MongoDB.
+func (r *mongoJobRepository) FinishJobRun(ctx context.Context, run *types.JobRunType) error {
+	update := bson.M{"$set": bson.M{
+		"status":      run.Status,
+		"error":       run.Error,
+		"finished_at": run.FinishedAt,
+	}}
+	_, err := r.runCollection.UpdateOne(ctx, bson.M{"_id": run.ID}, update)
+	return err
+}
+
+// FindJobRuns retrieves the latest job runs in MongoDB, newest first.
+func (r *mongoJobRepository) FindJobRuns(ctx context.Context, jobName string, limit int) ([]*types.JobRunType, error) {
+	filter := bson.M{}
+	if jobName != "" {
+		filter["job_name"] = jobName
+	}
+
+	var runs []*types.JobRunType
+	cursor, err := r.runCollection.Find(ctx, filter, options.Find().SetSort(bson.M{"started_at": -1}).SetLimit(int64(limit)))
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &runs); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+// dueJobFilter matches active jobs that are due and whose lease, if any, has run out
+func dueJobFilter(now time.Time) bson.M {
+	return bson.M{
+		"is_active":   true,
+		"next_run_at": bson.M{"$lte": now},
+		"$or": bson.A{
+			bson.M{"locked_until": nil},
+			bson.M{"locked_until": bson.M{"$lt": now}},
+		},
+	}
+}
+
+// findJobs runs a query and decodes every matching job.
+func (r *mongoJobRepository) findJobs(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*types.JobType, error) {
+	var jobs []*types.JobType
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &jobs); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
@@ -0,0 +1,100 @@
+package mongodb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoLoginChallengeRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoLoginChallengeRepository initializes a new instance of the login challenge repository.
+func NewMongoLoginChallengeRepository(db *mongo.Database) repositories.LoginChallengeRepositoryInterface {
+	collection := db.Collection("login_challenges")
+
+	indexModels := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "token_hash", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "nonce_hash", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "super_user_id", Value: 1}, {Key: "created_at", Value: 1}}},
+	}
+	if _, err := collection.Indexes().CreateMany(context.Background(), indexModels); err != nil {
+		log.Printf("Failed to create indexes on login challenges: %v", err)
+	}
+
+	return &mongoLoginChallengeRepository{
+		collection: collection,
+	}
+}
+
+// CreateLoginChallenge stores a new challenge in MongoDB.
+func (r *mongoLoginChallengeRepository) CreateLoginChallenge(ctx context.Context, challenge *types.LoginChallengeType) error {
+	challenge.CreatedAt = time.Now()
+	_, err := r.collection.InsertOne(ctx, challenge)
+	return err
+}
+
+// FindLoginChallengeByTokenHash retrieves the challenge of a login link in MongoDB.
+func (r *mongoLoginChallengeRepository) FindLoginChallengeByTokenHash(ctx context.Context, tokenHash string) (*types.LoginChallengeType, error) {
+	return r.findLoginChallenge(ctx, bson.M{"token_hash": tokenHash})
+}
+
+// FindLoginChallengeByNonceHash retrieves the challenge a browser started in MongoDB.
+func (r *mongoLoginChallengeRepository) FindLoginChallengeByNonceHash(ctx context.Context, nonceHash string) (*types.LoginChallengeType, error) {
+	return r.findLoginChallenge(ctx, bson.M{"nonce_hash": nonceHash})
+}
+
+// CountLoginChallengesSince counts the recent challenges of a superuser in MongoDB.
+func (r *mongoLoginChallengeRepository) CountLoginChallengesSince(ctx context.Context, superUserID uuid.UUID, since time.Time) (int64, error) {
+	return r.collection.CountDocuments(ctx, bson.M{"super_user_id": superUserID, "created_at": bson.M{"$gte": since}})
+}
+
+// RecordFailedLoginChallengeAttempt increments the wrong codes of a challenge in MongoDB.
+func (r *mongoLoginChallengeRepository) RecordFailedLoginChallengeAttempt(ctx context.Context, challengeID uuid.UUID) error {
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": challengeID}, bson.M{"$inc": bson.M{"attempts": 1}})
+	return err
+}
+
+// UseLoginChallenge marks a challenge as used in MongoDB; only an unused challenge matches the update.
+func (r *mongoLoginChallengeRepository) UseLoginChallenge(ctx context.Context, challengeID uuid.UUID, usedAt time.Time) error {
+	result, err := r.collection.UpdateOne(ctx,
+		bson.M{"_id": challengeID, "used_at": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"used_at": usedAt}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return repositories.ErrLoginChallengeUsed
+	}
+	return nil
+}
+
+// DeleteExpiredLoginChallenges removes expired challenges in MongoDB.
+func (r *mongoLoginChallengeRepository) DeleteExpiredLoginChallenges(ctx context.Context, now time.Time) (int64, error) {
+	result, err := r.collection.DeleteMany(ctx, bson.M{"expires_at": bson.M{"$lt": now}})
+	if err != nil {
+		return 0, err
+	}
+	return result.DeletedCount, nil
+}
+
+// findLoginChallenge retrieves the first challenge matching filter, or nil
+func (r *mongoLoginChallengeRepository) findLoginChallenge(ctx context.Context, filter bson.M) (*types.LoginChallengeType, error) {
+	var challenge types.LoginChallengeType
+	err := r.collection.FindOne(ctx, filter).Decode(&challenge)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &challenge, nil
+}
@@ -0,0 +1,97 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoOrderRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoOrderRepository initializes a new instance of the order repository.
+func NewMongoOrderRepository(db *mongo.Database) repositories.OrderRepositoryInterface {
+	return &mongoOrderRepository{
+		collection: db.Collection("orders"),
+	}
+}
+
+// CreateOrder stores a new order in MongoDB.
+func (r *mongoOrderRepository) CreateOrder(ctx context.Context, order *types.OrderType) (*types.OrderType, error) {
+	order.CreatedAt = time.Now()
+	order.UpdatedAt = time.Now()
+
+	_, err := r.collection.InsertOne(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// FindOrderByID retrieves an order by its ID in MongoDB.
+func (r *mongoOrderRepository) FindOrderByID(ctx context.Context, orderID uuid.UUID) (*types.OrderType, error) {
+	var order types.OrderType
+	if err := r.collection.FindOne(ctx, bson.M{"_id": orderID}).Decode(&order); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// FindOrdersByEventID retrieves every order of an event in MongoDB, newest first.
+func (r *mongoOrderRepository) FindOrdersByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.OrderType, error) {
+	return r.findOrders(ctx, bson.M{"event_id": eventID}, options.Find().SetSort(bson.M{"created_at": -1}))
+}
+
+// FindExpiredPendingOrders retrieves pending orders whose hold ran out in MongoDB.
+func (r *mongoOrderRepository) FindExpiredPendingOrders(ctx context.Context, now time.Time, limit int) ([]*types.OrderType, error) {
+	filter := bson.M{
+		"status":     types.OrderStatusPending,
+		"expires_at": bson.M{"$lte": now},
+	}
+	opts := options.Find().SetSort(bson.M{"expires_at": 1}).SetLimit(int64(limit))
+	return r.findOrders(ctx, filter, opts)
+}
+
+// UpdateOrder stores the state of an order in MongoDB while it is still in fromStatus.
+func (r *mongoOrderRepository) UpdateOrder(ctx context.Context, order *types.OrderType, fromStatus string) error {
+	order.UpdatedAt = time.Now()
+	update := bson.M{"$set": bson.M{
+		"status":       order.Status,
+		"attendees":    order.Attendees,
+		"confirmed_at": order.ConfirmedAt,
+		"closed_at":    order.ClosedAt,
+		"close_reason": order.CloseReason,
+		"updated_at":   order.UpdatedAt,
+	}}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": order.ID, "status": fromStatus}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return repositories.ErrOrderStatusChanged
+	}
+	return nil
+}
+
+// findOrders runs a query and decodes every matching order.
+func (r *mongoOrderRepository) findOrders(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*types.OrderType, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var orders []*types.OrderType
+	if err = cursor.All(ctx, &orders); err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
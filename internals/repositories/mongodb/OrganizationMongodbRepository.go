@@ -0,0 +1,188 @@
+package mongodb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoOrganizationRepository struct {
+	collection           *mongo.Collection
+	memberCollection     *mongo.Collection
+	invitationCollection *mongo.Collection
+}
+
+// NewMongoOrganizationRepository initializes a new instance of the organization repository.
+func NewMongoOrganizationRepository(db *mongo.Database) repositories.OrganizationRepositoryInterface {
+	memberCollection := db.Collection("organization_members")
+
+	indexModel := mongo.IndexModel{
+		Keys:    bson.D{{Key: "organization_id", Value: 1}, {Key: "super_user_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+	if _, err := memberCollection.Indexes().CreateOne(context.Background(), indexModel); err != nil {
+		log.Printf("Failed to create unique membership index on organization members: %v", err)
+	}
+
+	return &mongoOrganizationRepository{
+		collection:           db.Collection("organizations"),
+		memberCollection:     memberCollection,
+		invitationCollection: db.Collection("organization_invitations"),
+	}
+}
+
+// CreateOrganization stores a new organization in MongoDB.
+func (r *mongoOrganizationRepository) CreateOrganization(ctx context.Context, organization *types.OrganizationType) (*types.OrganizationType, error) {
+	organization.CreatedAt = time.Now()
+	organization.UpdatedAt = time.Now()
+
+	if _, err := r.collection.InsertOne(ctx, organization); err != nil {
+		return nil, err
+	}
+	return organization, nil
+}
+
+// FindOrganizationByID retrieves an organization by its ID in MongoDB.
+func (r *mongoOrganizationRepository) FindOrganizationByID(ctx context.Context, organizationID uuid.UUID) (*types.OrganizationType, error) {
+	var organization types.OrganizationType
+	if err := r.collection.FindOne(ctx, bson.M{"_id": organizationID}).Decode(&organization); err != nil {
+		return nil, err
+	}
+	return &organization, nil
+}
+
+// AddOrganizationMember stores a new membership in MongoDB; the unique membership index rejects duplicates.
+func (r *mongoOrganizationRepository) AddOrganizationMember(ctx context.Context, member *types.OrganizationMemberType) error {
+	member.CreatedAt = time.Now()
+	member.UpdatedAt = time.Now()
+
+	_, err := r.memberCollection.InsertOne(ctx, member)
+	if mongo.IsDuplicateKeyError(err) {
+		return repositories.ErrOrganizationMemberExists
+	}
+	return err
+}
+
+// FindOrganizationMember retrieves the membership of a user in an organization in MongoDB.
+func (r *mongoOrganizationRepository) FindOrganizationMember(ctx context.Context, organizationID, superUserID uuid.UUID) (*types.OrganizationMemberType, error) {
+	var member types.OrganizationMemberType
+	err := r.memberCollection.FindOne(ctx, bson.M{"organization_id": organizationID, "super_user_id": superUserID}).Decode(&member)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// FindOrganizationMembers retrieves every member of an organization in MongoDB.
+func (r *mongoOrganizationRepository) FindOrganizationMembers(ctx context.Context, organizationID uuid.UUID) ([]*types.OrganizationMemberType, error) {
+	return r.findMembers(ctx, bson.M{"organization_id": organizationID})
+}
+
+// FindMembershipsBySuperUserID retrieves every membership of a user in MongoDB.
+func (r *mongoOrganizationRepository) FindMembershipsBySuperUserID(ctx context.Context, superUserID uuid.UUID) ([]*types.OrganizationMemberType, error) {
+	return r.findMembers(ctx, bson.M{"super_user_id": superUserID})
+}
+
+// UpdateOrganizationMemberRole stores the role of a membership in MongoDB.
+func (r *mongoOrganizationRepository) UpdateOrganizationMemberRole(ctx context.Context, member *types.OrganizationMemberType) error {
+	update := bson.M{"$set": bson.M{"role": member.Role, "updated_at": time.Now()}}
+	_, err := r.memberCollection.UpdateOne(ctx, bson.M{"_id": member.ID}, update)
+	return err
+}
+
+// RemoveOrganizationMember removes a user from an organization in MongoDB.
+func (r *mongoOrganizationRepository) RemoveOrganizationMember(ctx context.Context, organizationID, superUserID uuid.UUID) error {
+	_, err := r.memberCollection.DeleteOne(ctx, bson.M{"organization_id": organizationID, "super_user_id": superUserID})
+	return err
+}
+
+// CreateOrganizationInvitation stores a new invitation in MongoDB.
+func (r *mongoOrganizationRepository) CreateOrganizationInvitation(ctx context.Context, invitation *types.OrganizationInvitationType) (*types.OrganizationInvitationType, error) {
+	invitation.CreatedAt = time.Now()
+
+	if _, err := r.invitationCollection.InsertOne(ctx, invitation); err != nil {
+		return nil, err
+	}
+	return invitation, nil
+}
+
+// FindOrganizationInvitationByID retrieves an invitation by its ID in MongoDB.
+func (r *mongoOrganizationRepository) FindOrganizationInvitationByID(ctx context.Context, invitationID uuid.UUID) (*types.OrganizationInvitationType, error) {
+	var invitation types.OrganizationInvitationType
+	if err := r.invitationCollection.FindOne(ctx, bson.M{"_id": invitationID}).Decode(&invitation); err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+// FindOrganizationInvitationByTokenHash retrieves the invitation with the given token hash in MongoDB.
+func (r *mongoOrganizationRepository) FindOrganizationInvitationByTokenHash(ctx context.Context, tokenHash string) (*types.OrganizationInvitationType, error) {
+	var invitation types.OrganizationInvitationType
+	err := r.invitationCollection.FindOne(ctx, bson.M{"token_hash": tokenHash}).Decode(&invitation)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+// FindPendingOrganizationInvitations retrieves the invitations of an organization that can still be accepted in MongoDB.
+func (r *mongoOrganizationRepository) FindPendingOrganizationInvitations(ctx context.Context, organizationID uuid.UUID, now time.Time) ([]*types.OrganizationInvitationType, error) {
+	filter := bson.M{
+		"organization_id": organizationID,
+		"accepted_at":     nil,
+		"expires_at":      bson.M{"$gt": now},
+	}
+	cursor, err := r.invitationCollection.Find(ctx, filter, options.Find().SetSort(bson.M{"created_at": -1}))
+	if err != nil {
+		return nil, err
+	}
+	var invitations []*types.OrganizationInvitationType
+	if err := cursor.All(ctx, &invitations); err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+// MarkOrganizationInvitationAccepted records the acceptance of an invitation in MongoDB, guarded by it still being unaccepted.
+func (r *mongoOrganizationRepository) MarkOrganizationInvitationAccepted(ctx context.Context, invitationID, superUserID uuid.UUID, acceptedAt time.Time) error {
+	filter := bson.M{"_id": invitationID, "accepted_at": nil}
+	update := bson.M{"$set": bson.M{"accepted_at": acceptedAt, "accepted_by_id": superUserID}}
+	result, err := r.invitationCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return repositories.ErrOrganizationInvitationSpent
+	}
+	return nil
+}
+
+// DeleteOrganizationInvitation removes an invitation in MongoDB.
+func (r *mongoOrganizationRepository) DeleteOrganizationInvitation(ctx context.Context, invitationID uuid.UUID) error {
+	_, err := r.invitationCollection.DeleteOne(ctx, bson.M{"_id": invitationID})
+	return err
+}
+
+// findMembers retrieves the memberships matching filter, oldest first
+func (r *mongoOrganizationRepository) findMembers(ctx context.Context, filter bson.M) ([]*types.OrganizationMemberType, error) {
+	cursor, err := r.memberCollection.Find(ctx, filter, options.Find().SetSort(bson.M{"created_at": 1}))
+	if err != nil {
+		return nil, err
+	}
+	var members []*types.OrganizationMemberType
+	if err := cursor.All(ctx, &members); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
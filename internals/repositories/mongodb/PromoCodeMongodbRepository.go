@@ -0,0 +1,192 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoPromoCodeRepository struct {
+	collection           *mongo.Collection
+	redemptionCollection *mongo.Collection
+	usageCollection      *mongo.Collection // One counter per promo code and user, for the per-user limit
+}
+
+// NewMongoPromoCodeRepository initializes a new instance of the promo code repository.
+func NewMongoPromoCodeRepository(db *mongo.Database) repositories.PromoCodeRepositoryInterface {
+	return &mongoPromoCodeRepository{
+		collection:           db.Collection("promo_codes"),
+		redemptionCollection: db.Collection("promo_redemptions"),
+		usageCollection:      db.Collection("promo_code_usages"),
+	}
+}
+
+// CreatePromoCode stores a new promo code in MongoDB.
+func (r *mongoPromoCodeRepository) CreatePromoCode(ctx context.Context, promoCode *types.PromoCodeType) (*types.PromoCodeType, error) {
+	promoCode.CreatedAt = time.Now()
+	promoCode.UpdatedAt = time.Now()
+
+	_, err := r.collection.InsertOne(ctx, promoCode)
+	if err != nil {
+		return nil, err
+	}
+	return promoCode, nil
+}
+
+// FindPromoCodeByID retrieves a promo code by its ID in MongoDB.
+func (r *mongoPromoCodeRepository) FindPromoCodeByID(ctx context.Context, promoCodeID uuid.UUID) (*types.PromoCodeType, error) {
+	var promoCode types.PromoCodeType
+	if err := r.collection.FindOne(ctx, bson.M{"_id": promoCodeID}).Decode(&promoCode); err != nil {
+		return nil, err
+	}
+	return &promoCode, nil
+}
+
+// FindPromoCodeByCode retrieves the promo code of an event with the given code in MongoDB.
+func (r *mongoPromoCodeRepository) FindPromoCodeByCode(ctx context.Context, eventID uuid.UUID, code string) (*types.PromoCodeType, error) {
+	var promoCode types.PromoCodeType
+	err := r.collection.FindOne(ctx, bson.M{"event_id": eventID, "code": code}).Decode(&promoCode)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &promoCode, nil
+}
+
+// FindPromoCodesByEventID retrieves every promo code of an event in MongoDB, ordered by code.
+func (r *mongoPromoCodeRepository) FindPromoCodesByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.PromoCodeType, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"event_id": eventID}, options.Find().SetSort(bson.M{"code": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var promoCodes []*types.PromoCodeType
+	if err = cursor.All(ctx, &promoCodes); err != nil {
+		return nil, err
+	}
+	return promoCodes, nil
+}
+
+// UpdatePromoCode stores the editable fields of a promo code in MongoDB.
+func (r *mongoPromoCodeRepository) UpdatePromoCode(ctx context.Context, promoCode *types.PromoCodeType) error {
+	update := bson.M{"$set": bson.M{
+		"discount_type":            promoCode.DiscountType,
+		"percent_off":              promoCode.PercentOff,
+		"amount_off_cents":         promoCode.AmountOffCents,
+		"currency":                 promoCode.Currency,
+		"max_redemptions":          promoCode.MaxRedemptions,
+		"max_redemptions_per_user": promoCode.MaxRedemptionsPerUser,
+		"valid_from":               promoCode.ValidFrom,
+		"valid_until":              promoCode.ValidUntil,
+		"ticket_tier_ids":          promoCode.TicketTierIDs,
+		"is_active":                promoCode.IsActive,
+		"updated_at":               time.Now(),
+	}}
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": promoCode.ID}, update)
+	return err
+}
+
+// DeletePromoCodeByID removes a promo code in MongoDB.
+func (r *mongoPromoCodeRepository) DeletePromoCodeByID(ctx context.Context, promoCodeID uuid.UUID) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": promoCodeID})
+	return err
+}
+
+// RedeemPromoCode records a redemption in MongoDB. Each limit is enforced by a single conditional increment;
+// the per-user counter is given back when the overall limit turns out to be reached.
+func (r *mongoPromoCodeRepository) RedeemPromoCode(ctx context.Context, redemption *types.PromoRedemptionType) error {
+	promoCode, err := r.FindPromoCodeByID(ctx, redemption.PromoCodeID)
+	if err != nil {
+		return err
+	}
+
+	usageID := promoCodeUsageID(redemption.PromoCodeID, redemption.UserID)
+	usageFilter := bson.M{"_id": usageID}
+	if promoCode.MaxRedemptionsPerUser > 0 {
+		usageFilter["count"] = bson.M{"$lt": promoCode.MaxRedemptionsPerUser}
+	}
+	// A user at the limit does not match, so the upsert tries to insert a second counter and hits the unique _id
+	_, err = r.usageCollection.UpdateOne(ctx, usageFilter, bson.M{"$inc": bson.M{"count": 1}}, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return repositories.ErrPromoCodeUserLimit
+	}
+	if err != nil {
+		return err
+	}
+
+	codeFilter := bson.M{
+		"_id": promoCode.ID,
+		"$or": bson.A{
+			bson.M{"max_redemptions": 0},
+			bson.M{"$expr": bson.M{"$lt": bson.A{"$redemptions", "$max_redemptions"}}},
+		},
+	}
+	result, err := r.collection.UpdateOne(ctx, codeFilter, bson.M{
+		"$inc": bson.M{"redemptions": 1},
+		"$set": bson.M{"updated_at": time.Now()},
+	})
+	if err == nil && result.MatchedCount == 0 {
+		err = repositories.ErrPromoCodeExhausted
+	}
+	if err != nil {
+		r.usageCollection.UpdateOne(ctx, bson.M{"_id": usageID}, bson.M{"$inc": bson.M{"count": -1}})
+		return err
+	}
+
+	redemption.CreatedAt = time.Now()
+	_, err = r.redemptionCollection.InsertOne(ctx, redemption)
+	return err
+}
+
+// ReleasePromoRedemption removes the redemption of an order in MongoDB and gives it back to its promo code and user.
+func (r *mongoPromoCodeRepository) ReleasePromoRedemption(ctx context.Context, orderID uuid.UUID) error {
+	var redemption types.PromoRedemptionType
+	err := r.redemptionCollection.FindOneAndDelete(ctx, bson.M{"order_id": orderID}).Decode(&redemption)
+	if err == mongo.ErrNoDocuments {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = r.collection.UpdateOne(ctx, bson.M{"_id": redemption.PromoCodeID, "redemptions": bson.M{"$gt": 0}}, bson.M{
+		"$inc": bson.M{"redemptions": -1},
+		"$set": bson.M{"updated_at": time.Now()},
+	})
+	if err != nil {
+		return err
+	}
+	usageFilter := bson.M{"_id": promoCodeUsageID(redemption.PromoCodeID, redemption.UserID), "count": bson.M{"$gt": 0}}
+	_, err = r.usageCollection.UpdateOne(ctx, usageFilter, bson.M{"$inc": bson.M{"count": -1}})
+	return err
+}
+
+// FindPromoRedemptionsByEventID retrieves every redemption of the promo codes of an event in MongoDB.
+func (r *mongoPromoCodeRepository) FindPromoRedemptionsByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.PromoRedemptionType, error) {
+	cursor, err := r.redemptionCollection.Find(ctx, bson.M{"event_id": eventID}, options.Find().SetSort(bson.M{"created_at": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var redemptions []*types.PromoRedemptionType
+	if err = cursor.All(ctx, &redemptions); err != nil {
+		return nil, err
+	}
+	return redemptions, nil
+}
+
+// promoCodeUsageID keys the redemption counter of a user for a promo code
+func promoCodeUsageID(promoCodeID, userID uuid.UUID) string {
+	return fmt.Sprintf("%s:%s", promoCodeID, userID)
+}
@@ -0,0 +1,63 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoRegistrationFormRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoRegistrationFormRepository initializes a new instance of the registration form repository.
+func NewMongoRegistrationFormRepository(db *mongo.Database) repositories.RegistrationFormRepositoryInterface {
+	return &mongoRegistrationFormRepository{
+		collection: db.Collection("registration_forms"),
+	}
+}
+
+// FindRegistrationFormByEventID retrieves the form of an event in MongoDB.
+func (r *mongoRegistrationFormRepository) FindRegistrationFormByEventID(ctx context.Context, eventID uuid.UUID) (*types.RegistrationFormType, error) {
+	var form types.RegistrationFormType
+	if err := r.collection.FindOne(ctx, bson.M{"event_id": eventID}).Decode(&form); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &form, nil
+}
+
+// SaveRegistrationForm upserts the form of an event in MongoDB, keeping the ID and creation time of an existing form.
+func (r *mongoRegistrationFormRepository) SaveRegistrationForm(ctx context.Context, form *types.RegistrationFormType) (*types.RegistrationFormType, error) {
+	update := bson.M{
+		"$set": bson.M{
+			"fields":     form.Fields,
+			"updated_at": time.Now(),
+		},
+		"$setOnInsert": bson.M{
+			"_id":        form.ID,
+			"created_at": form.CreatedAt,
+		},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var saved types.RegistrationFormType
+	if err := r.collection.FindOneAndUpdate(ctx, bson.M{"event_id": form.EventID}, update, opts).Decode(&saved); err != nil {
+		return nil, err
+	}
+	return &saved, nil
+}
+
+// DeleteRegistrationFormByEventID removes the form of an event in MongoDB.
+func (r *mongoRegistrationFormRepository) DeleteRegistrationFormByEventID(ctx context.Context, eventID uuid.UUID) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"event_id": eventID})
+	return err
+}
@@ -0,0 +1,90 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoReminderRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoReminderRepository initializes a new instance of the reminder repository.
+func NewMongoReminderRepository(db *mongo.Database) repositories.ReminderRepositoryInterface {
+	return &mongoReminderRepository{
+		collection: db.Collection("reminders"),
+	}
+}
+
+// CreateReminder stores a newly planned reminder in MongoDB.
+func (r *mongoReminderRepository) CreateReminder(ctx context.Context, reminder *types.ReminderType) (*types.ReminderType, error) {
+	reminder.CreatedAt = time.Now()
+	reminder.UpdatedAt = time.Now()
+
+	_, err := r.collection.InsertOne(ctx, reminder)
+	if err != nil {
+		return nil, err
+	}
+	return reminder, nil
+}
+
+// FindRemindersByEventID retrieves every reminder planned for an event in MongoDB.
+func (r *mongoReminderRepository) FindRemindersByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.ReminderType, error) {
+	return r.findReminders(ctx, bson.M{"event_id": eventID}, options.Find().SetSort(bson.M{"send_at": 1}))
+}
+
+// FindDueReminders retrieves pending reminders that are due in MongoDB, oldest first.
+func (r *mongoReminderRepository) FindDueReminders(ctx context.Context, now time.Time, limit int) ([]*types.ReminderType, error) {
+	filter := bson.M{
+		"status":  types.ReminderStatusPending,
+		"send_at": bson.M{"$lte": now},
+	}
+	return r.findReminders(ctx, filter, options.Find().SetSort(bson.M{"send_at": 1}).SetLimit(int64(limit)))
+}
+
+// MarkGuestNotified records that a guest has been emailed for a reminder in MongoDB.
+func (r *mongoReminderRepository) MarkGuestNotified(ctx context.Context, reminderID, guestID uuid.UUID) error {
+	update := bson.M{
+		"$addToSet": bson.M{"guests_notified": guestID},
+		"$set":      bson.M{"updated_at": time.Now()},
+	}
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": reminderID}, update)
+	return err
+}
+
+// UpdateReminderStatus moves a reminder to Sent or Skipped in MongoDB.
+func (r *mongoReminderRepository) UpdateReminderStatus(ctx context.Context, reminderID uuid.UUID, status string) error {
+	now := time.Now()
+	set := bson.M{"status": status, "updated_at": now}
+	if status == types.ReminderStatusSent {
+		set["sent_at"] = now
+	}
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": reminderID}, bson.M{"$set": set})
+	return err
+}
+
+// DeletePendingRemindersByEventID removes the unsent reminders of an event in MongoDB.
+func (r *mongoReminderRepository) DeletePendingRemindersByEventID(ctx context.Context, eventID uuid.UUID) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"event_id": eventID, "status": types.ReminderStatusPending})
+	return err
+}
+
+// findReminders runs a query and decodes every matching reminder.
+func (r *mongoReminderRepository) findReminders(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*types.ReminderType, error) {
+	var reminders []*types.ReminderType
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &reminders); err != nil {
+		return nil, err
+	}
+	return reminders, nil
+}
@@ -3,6 +3,7 @@ package mongodb
 import (
 	"context"
 	"errors"
+	"regexp"
 	"time"
 
 	"github.com/google/uuid"
@@ -35,9 +36,19 @@ func (r *mongoSuperUserRepository) CreateSuperUser(ctx context.Context, superUse
 	return superUser, nil
 }
 
+// FindSuperUserByID retrieves a superuser by ID from MongoDB; the embedded base fields are stored under "baseusertype"
+func (r *mongoSuperUserRepository) FindSuperUserByID(ctx context.Context, superUserID uuid.UUID) (*types.SuperUserType, error) {
+	var superUser types.SuperUserType
+	err := r.collection.FindOne(ctx, bson.M{"baseusertype._id": superUserID}).Decode(&superUser)
+	if err == mongo.ErrNoDocuments {
+		return nil, errors.New("superuser not found")
+	}
+	return &superUser, err
+}
+
 func (r *mongoSuperUserRepository) FindSuperUserByEmail(ctx context.Context, email string) (*types.SuperUserType, error) {
 	var superUser types.SuperUserType
-	err := r.collection.FindOne(ctx, bson.M{"email": email}).Decode(&superUser)
+	err := r.collection.FindOne(ctx, bson.M{"baseusertype.email": email}).Decode(&superUser)
 	if err == mongo.ErrNoDocuments {
 		return nil, errors.New("superuser not found")
 	}
@@ -65,12 +76,12 @@ func (r *mongoSuperUserRepository) FindSuperUserByResetToken(ctx context.Context
 func (r *mongoSuperUserRepository) UpdateResetToken(ctx context.Context, superUserID uuid.UUID, resetToken string, resetTokenExpiry time.Time) error {
 	_, err := r.collection.UpdateOne(
 		ctx,
-		bson.M{"id": superUserID},
+		bson.M{"baseusertype._id": superUserID},
 		bson.M{
 			"$set": bson.M{
-				"reset_token":        resetToken,
-				"reset_token_expiry": resetTokenExpiry,
-				"updated_at":         time.Now(),
+				"reset_token":             resetToken,
+				"reset_token_expiry":      resetTokenExpiry,
+				"baseusertype.updated_at": time.Now(),
 			},
 		},
 	)
@@ -81,7 +92,7 @@ func (r *mongoSuperUserRepository) UpdateSuperUser(ctx context.Context, superUse
 	superUser.UpdatedAt = time.Now()
 	_, err := r.collection.ReplaceOne(
 		ctx,
-		bson.M{"id": superUser.ID},
+		bson.M{"baseusertype._id": superUser.ID},
 		superUser,
 		options.Replace().SetUpsert(true),
 	)
@@ -112,15 +123,145 @@ func (r *mongoSuperUserRepository) VerifySuperUserOTP(ctx context.Context, super
 	superUser.OTPExpiry = time.Time{} // Reset OTP expiry
 	superUser.UpdatedAt = time.Now()
 
-	filter := bson.M{"_id": superUser.ID}
+	filter := bson.M{"baseusertype._id": superUser.ID}
 	update := bson.M{
 		"$set": bson.M{
-			"is_otp_verified": true,
-			"otp":             nil,
-			"otp_expiry":      time.Time{},
-			"updated_at":      superUser.UpdatedAt,
+			"is_otp_verified":         true,
+			"otp":                     nil,
+			"otp_expiry":              time.Time{},
+			"baseusertype.updated_at": superUser.UpdatedAt,
 		},
 	}
 	_, err := r.collection.UpdateOne(ctx, filter, update)
 	return err
 }
+
+// ClearExpiredOTPs removes expired OTPs in MongoDB
+func (r *mongoSuperUserRepository) ClearExpiredOTPs(ctx context.Context, now time.Time) (int64, error) {
+	filter := bson.M{"otp": bson.M{"$ne": nil}, "otp_expiry": bson.M{"$lt": now}}
+	update := bson.M{"$set": bson.M{"otp": nil, "otp_expiry": time.Time{}, "baseusertype.updated_at": time.Now()}}
+	result, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// ClearExpiredResetTokens removes expired password reset tokens in MongoDB
+func (r *mongoSuperUserRepository) ClearExpiredResetTokens(ctx context.Context, now time.Time) (int64, error) {
+	filter := bson.M{"reset_token": bson.M{"$ne": nil}, "reset_token_expiry": bson.M{"$lt": now}}
+	update := bson.M{"$set": bson.M{"reset_token": nil, "reset_token_expiry": time.Time{}, "baseusertype.updated_at": time.Now()}}
+	result, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// ListSuperUsers finds the superusers matching a filter in MongoDB
+func (r *mongoSuperUserRepository) ListSuperUsers(ctx context.Context, filter repositories.SuperUserListFilter, offset, limit int) ([]*types.SuperUserType, int64, error) {
+	query := bson.M{}
+	if filter.Search != "" {
+		pattern := bson.M{"$regex": regexp.QuoteMeta(filter.Search), "$options": "i"}
+		query["$or"] = []bson.M{
+			{"username": pattern},
+			{"baseusertype.email": pattern},
+			{"baseusertype.full_name": pattern},
+		}
+	}
+	if filter.Role != "" {
+		query["role"] = filter.Role
+	}
+	if filter.IsVerified != nil {
+		query["is_otp_verified"] = *filter.IsVerified
+	}
+	if filter.IsActive != nil {
+		query["baseusertype.is_active"] = *filter.IsActive
+	}
+	if !filter.IncludeDeleted {
+		query["deleted_at"] = bson.M{"$exists": false}
+	}
+
+	total, err := r.collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	findOptions := options.Find().SetSort(bson.M{"username": 1}).SetSkip(int64(offset)).SetLimit(int64(limit))
+	cursor, err := r.collection.Find(ctx, query, findOptions)
+	if err != nil {
+		return nil, 0, err
+	}
+	superUsers := []*types.SuperUserType{}
+	if err := cursor.All(ctx, &superUsers); err != nil {
+		return nil, 0, err
+	}
+	return superUsers, total, nil
+}
+
+// SetSuperUserActive activates or deactivates a superuser in MongoDB
+func (r *mongoSuperUserRepository) SetSuperUserActive(ctx context.Context, superUserID uuid.UUID, active bool, sessionsValidAfter time.Time) error {
+	return r.updateSuperUserFields(ctx, superUserID, bson.M{
+		"baseusertype.is_active": active,
+		"sessions_valid_after":   sessionsValidAfter,
+	})
+}
+
+// UpdateSuperUserRole replaces the role and permission groups of a superuser in MongoDB
+func (r *mongoSuperUserRepository) UpdateSuperUserRole(ctx context.Context, superUserID uuid.UUID, role string, permissionGroups []string) error {
+	return r.updateSuperUserFields(ctx, superUserID, bson.M{
+		"role":              role,
+		"permission_groups": permissionGroups,
+	})
+}
+
+// ForcePasswordReset stores a reset token and requires a password reset in MongoDB
+func (r *mongoSuperUserRepository) ForcePasswordReset(ctx context.Context, superUserID uuid.UUID, resetToken string, resetTokenExpiry time.Time, sessionsValidAfter time.Time) error {
+	return r.updateSuperUserFields(ctx, superUserID, bson.M{
+		"reset_token":             resetToken,
+		"reset_token_expiry":      resetTokenExpiry,
+		"password_reset_required": true,
+		"sessions_valid_after":    sessionsValidAfter,
+	})
+}
+
+// SoftDeleteSuperUser marks a superuser as deleted in MongoDB
+func (r *mongoSuperUserRepository) SoftDeleteSuperUser(ctx context.Context, superUserID uuid.UUID, deletedAt time.Time) error {
+	return r.updateSuperUserFields(ctx, superUserID, bson.M{
+		"deleted_at":             deletedAt,
+		"baseusertype.is_active": false,
+		"sessions_valid_after":   deletedAt,
+	})
+}
+
+// updateSuperUserFields sets fields of a superuser, reporting a superuser that does not exist
+func (r *mongoSuperUserRepository) updateSuperUserFields(ctx context.Context, superUserID uuid.UUID, fields bson.M) error {
+	fields["baseusertype.updated_at"] = time.Now()
+	result, err := r.collection.UpdateOne(ctx, bson.M{"baseusertype._id": superUserID}, bson.M{"$set": fields})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return errors.New("superuser not found")
+	}
+	return nil
+}
+
+// FindSuperUserByEmailChangeToken retrieves the superuser with a pending email change from MongoDB
+func (r *mongoSuperUserRepository) FindSuperUserByEmailChangeToken(ctx context.Context, tokenHash string) (*types.SuperUserType, error) {
+	var superUser types.SuperUserType
+	err := r.collection.FindOne(ctx, bson.M{"email_change_token": tokenHash}).Decode(&superUser)
+	if err == mongo.ErrNoDocuments {
+		return nil, errors.New("superuser not found")
+	}
+	return &superUser, err
+}
+
+// FindSuperUserByEmailUndoToken retrieves the superuser whose email change can be undone from MongoDB
+func (r *mongoSuperUserRepository) FindSuperUserByEmailUndoToken(ctx context.Context, tokenHash string) (*types.SuperUserType, error) {
+	var superUser types.SuperUserType
+	err := r.collection.FindOne(ctx, bson.M{"email_undo_token": tokenHash}).Decode(&superUser)
+	if err == mongo.ErrNoDocuments {
+		return nil, errors.New("superuser not found")
+	}
+	return &superUser, err
+}
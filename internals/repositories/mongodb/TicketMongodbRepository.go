@@ -0,0 +1,108 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoTicketRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoTicketRepository initializes a new instance of the ticket repository.
+func NewMongoTicketRepository(db *mongo.Database) repositories.TicketRepositoryInterface {
+	return &mongoTicketRepository{
+		collection: db.Collection("tickets"),
+	}
+}
+
+// CreateTicket stores a newly issued ticket in MongoDB.
+func (r *mongoTicketRepository) CreateTicket(ctx context.Context, ticket *types.TicketType) (*types.TicketType, error) {
+	ticket.CreatedAt = time.Now()
+	ticket.UpdatedAt = time.Now()
+
+	_, err := r.collection.InsertOne(ctx, ticket)
+	if err != nil {
+		return nil, err
+	}
+	return ticket, nil
+}
+
+// FindTicketByID retrieves a ticket by its ID in MongoDB.
+func (r *mongoTicketRepository) FindTicketByID(ctx context.Context, ticketID uuid.UUID) (*types.TicketType, error) {
+	var ticket types.TicketType
+	if err := r.collection.FindOne(ctx, bson.M{"_id": ticketID}).Decode(&ticket); err != nil {
+		return nil, err
+	}
+	return &ticket, nil
+}
+
+// FindValidTicketByGuestID retrieves the valid ticket of a guest in MongoDB.
+func (r *mongoTicketRepository) FindValidTicketByGuestID(ctx context.Context, guestID uuid.UUID) (*types.TicketType, error) {
+	var ticket types.TicketType
+	err := r.collection.FindOne(ctx, bson.M{"guest_id": guestID, "status": types.TicketStatusValid}).Decode(&ticket)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ticket, nil
+}
+
+// FindTicketsByEventID retrieves every ticket issued for an event in MongoDB.
+func (r *mongoTicketRepository) FindTicketsByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.TicketType, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"event_id": eventID}, options.Find().SetSort(bson.M{"issued_at": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var tickets []*types.TicketType
+	if err = cursor.All(ctx, &tickets); err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
+
+// CheckInTicket marks a valid ticket as used in MongoDB.
+func (r *mongoTicketRepository) CheckInTicket(ctx context.Context, ticketID, staffID uuid.UUID, checkedInAt time.Time) error {
+	update := bson.M{"$set": bson.M{
+		"status":        types.TicketStatusUsed,
+		"checked_in_at": checkedInAt,
+		"checked_in_by": staffID,
+		"updated_at":    time.Now(),
+	}}
+	return r.updateValidTicket(ctx, ticketID, update)
+}
+
+// RevokeTicket marks a valid ticket as revoked in MongoDB.
+func (r *mongoTicketRepository) RevokeTicket(ctx context.Context, ticketID uuid.UUID, reason string) error {
+	now := time.Now()
+	update := bson.M{"$set": bson.M{
+		"status":         types.TicketStatusRevoked,
+		"revoked_at":     now,
+		"revoked_reason": reason,
+		"updated_at":     now,
+	}}
+	return r.updateValidTicket(ctx, ticketID, update)
+}
+
+// updateValidTicket applies update only while the ticket is still valid
+func (r *mongoTicketRepository) updateValidTicket(ctx context.Context, ticketID uuid.UUID, update bson.M) error {
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": ticketID, "status": types.TicketStatusValid}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return repositories.ErrTicketNotValid
+	}
+	return nil
+}
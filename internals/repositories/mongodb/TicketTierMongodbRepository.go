@@ -0,0 +1,165 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoTicketTierRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoTicketTierRepository initializes a new instance of the ticket tier repository.
+func NewMongoTicketTierRepository(db *mongo.Database) repositories.TicketTierRepositoryInterface {
+	return &mongoTicketTierRepository{
+		collection: db.Collection("ticket_tiers"),
+	}
+}
+
+// CreateTicketTier stores a new ticket tier in MongoDB.
+func (r *mongoTicketTierRepository) CreateTicketTier(ctx context.Context, tier *types.TicketTierType) (*types.TicketTierType, error) {
+	tier.CreatedAt = time.Now()
+	tier.UpdatedAt = time.Now()
+
+	_, err := r.collection.InsertOne(ctx, tier)
+	if err != nil {
+		return nil, err
+	}
+	return tier, nil
+}
+
+// FindTicketTierByID retrieves a ticket tier by its ID in MongoDB.
+func (r *mongoTicketTierRepository) FindTicketTierByID(ctx context.Context, tierID uuid.UUID) (*types.TicketTierType, error) {
+	var tier types.TicketTierType
+	if err := r.collection.FindOne(ctx, bson.M{"_id": tierID}).Decode(&tier); err != nil {
+		return nil, err
+	}
+	return &tier, nil
+}
+
+// FindTicketTiersByEventID retrieves the ticket tiers of an event in MongoDB, cheapest first.
+func (r *mongoTicketTierRepository) FindTicketTiersByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.TicketTierType, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "price_cents", Value: 1}, {Key: "created_at", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"event_id": eventID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var tiers []*types.TicketTierType
+	if err = cursor.All(ctx, &tiers); err != nil {
+		return nil, err
+	}
+	return tiers, nil
+}
+
+// UpdateTicketTier stores the editable fields of a tier in MongoDB, unless the new quantity is below what is sold and held.
+func (r *mongoTicketTierRepository) UpdateTicketTier(ctx context.Context, tier *types.TicketTierType) error {
+	filter := bson.M{
+		"_id":   tier.ID,
+		"$expr": bson.M{"$lte": bson.A{bson.M{"$add": bson.A{"$sold", "$held"}}, tier.Quantity}},
+	}
+	update := bson.M{"$set": bson.M{
+		"name":           tier.Name,
+		"description":    tier.Description,
+		"price_cents":    tier.PriceCents,
+		"currency":       tier.Currency,
+		"quantity":       tier.Quantity,
+		"sales_start_at": tier.SalesStartAt,
+		"sales_end_at":   tier.SalesEndAt,
+		"updated_at":     time.Now(),
+	}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return r.missingOr(ctx, tier.ID, repositories.ErrTicketTierSoldOut)
+	}
+	return nil
+}
+
+// DeleteTicketTierByID removes a tier nobody ordered from in MongoDB.
+func (r *mongoTicketTierRepository) DeleteTicketTierByID(ctx context.Context, tierID uuid.UUID) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": tierID, "sold": 0, "held": 0})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return r.missingOr(ctx, tierID, repositories.ErrTicketTierInUse)
+	}
+	return nil
+}
+
+// ReserveTicketTierInventory holds tickets for a pending order in MongoDB with a single conditional update.
+func (r *mongoTicketTierRepository) ReserveTicketTierInventory(ctx context.Context, tierID uuid.UUID, quantity int) error {
+	filter := bson.M{
+		"_id":   tierID,
+		"$expr": bson.M{"$gte": bson.A{bson.M{"$subtract": bson.A{"$quantity", bson.M{"$add": bson.A{"$sold", "$held"}}}}, quantity}},
+	}
+	update := bson.M{
+		"$inc": bson.M{"held": quantity},
+		"$set": bson.M{"updated_at": time.Now()},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return r.missingOr(ctx, tierID, repositories.ErrTicketTierSoldOut)
+	}
+	return nil
+}
+
+// ReleaseTicketTierInventory puts held tickets back on sale in MongoDB.
+func (r *mongoTicketTierRepository) ReleaseTicketTierInventory(ctx context.Context, tierID uuid.UUID, quantity int) error {
+	return r.updateInventory(ctx, tierID, bson.M{"held": decreaseNotBelowZero("$held", quantity)})
+}
+
+// CommitTicketTierInventory turns held tickets into sold ones in MongoDB.
+func (r *mongoTicketTierRepository) CommitTicketTierInventory(ctx context.Context, tierID uuid.UUID, quantity int) error {
+	return r.updateInventory(ctx, tierID, bson.M{
+		"held": decreaseNotBelowZero("$held", quantity),
+		"sold": bson.M{"$add": bson.A{"$sold", quantity}},
+	})
+}
+
+// RestockTicketTierInventory puts sold tickets back on sale in MongoDB.
+func (r *mongoTicketTierRepository) RestockTicketTierInventory(ctx context.Context, tierID uuid.UUID, quantity int) error {
+	return r.updateInventory(ctx, tierID, bson.M{"sold": decreaseNotBelowZero("$sold", quantity)})
+}
+
+// updateInventory applies relative changes to the counters of a tier with a single pipeline update
+func (r *mongoTicketTierRepository) updateInventory(ctx context.Context, tierID uuid.UUID, set bson.M) error {
+	set["updated_at"] = time.Now()
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": tierID}, mongo.Pipeline{{{Key: "$set", Value: set}}})
+	return err
+}
+
+// missingOr tells a conditional update that matched nothing because the tier does not exist apart from one whose
+// condition failed, returning the lookup error or conditionErr
+func (r *mongoTicketTierRepository) missingOr(ctx context.Context, tierID uuid.UUID, conditionErr error) error {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"_id": tierID})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return errors.New("ticket tier not found")
+	}
+	return conditionErr
+}
+
+// decreaseNotBelowZero is an aggregation expression subtracting amount from field without going negative
+func decreaseNotBelowZero(field string, amount int) bson.M {
+	return bson.M{"$max": bson.A{bson.M{"$subtract": bson.A{field, amount}}, 0}}
+}
@@ -0,0 +1,117 @@
+package mongodb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type mongoVenueRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoVenueRepository initializes a new instance of the venue repository and ensures the 2dsphere index exists.
+func NewMongoVenueRepository(db *mongo.Database) repositories.VenueRepositoryInterface {
+	collection := db.Collection("venues")
+
+	indexModel := mongo.IndexModel{Keys: bson.D{{Key: "geo_location", Value: "2dsphere"}}}
+	if _, err := collection.Indexes().CreateOne(context.Background(), indexModel); err != nil {
+		log.Printf("Failed to create 2dsphere index on venues: %v", err)
+	}
+
+	return &mongoVenueRepository{
+		collection: collection,
+	}
+}
+
+// CreateVenue creates a new venue record in MongoDB.
+func (r *mongoVenueRepository) CreateVenue(ctx context.Context, venue *types.VenueType) (*types.VenueType, error) {
+	venue.ID = uuid.New()
+	venue.GeoLocation = types.NewGeoPoint(venue.Latitude, venue.Longitude)
+	venue.CreatedAt = time.Now()
+	venue.UpdatedAt = time.Now()
+
+	_, err := r.collection.InsertOne(ctx, venue)
+	if err != nil {
+		return nil, err
+	}
+	return venue, nil
+}
+
+// FindVenueByID finds a venue by its ID in MongoDB.
+func (r *mongoVenueRepository) FindVenueByID(ctx context.Context, venueID uuid.UUID) (*types.VenueType, error) {
+	var venue types.VenueType
+	err := r.collection.FindOne(ctx, bson.M{"_id": venueID}).Decode(&venue)
+	if err != nil {
+		return nil, err
+	}
+	return &venue, nil
+}
+
+// UpdateVenue updates an existing venue in MongoDB.
+func (r *mongoVenueRepository) UpdateVenue(ctx context.Context, venue *types.VenueType) error {
+	venue.GeoLocation = types.NewGeoPoint(venue.Latitude, venue.Longitude)
+	venue.UpdatedAt = time.Now()
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": venue.ID}, bson.M{"$set": venue})
+	return err
+}
+
+// DeleteVenueByID deletes a venue by its ID in MongoDB.
+func (r *mongoVenueRepository) DeleteVenueByID(ctx context.Context, venueID uuid.UUID) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": venueID})
+	return err
+}
+
+// FindAllVenues retrieves all venues from MongoDB.
+func (r *mongoVenueRepository) FindAllVenues(ctx context.Context) ([]*types.VenueType, error) {
+	return r.findVenues(ctx, bson.M{})
+}
+
+// FindVenuesWithinRadius uses $nearSphere on the 2dsphere index, which also sorts results by distance.
+func (r *mongoVenueRepository) FindVenuesWithinRadius(ctx context.Context, latitude, longitude, radiusMeters float64) ([]*types.VenueType, error) {
+	filter := bson.M{
+		"geo_location": bson.M{
+			"$nearSphere": bson.M{
+				"$geometry":    types.NewGeoPoint(latitude, longitude),
+				"$maxDistance": radiusMeters,
+			},
+		},
+	}
+	return r.findVenues(ctx, filter)
+}
+
+// FindVenuesWithinBoundingBox uses $geoWithin with a GeoJSON polygon built from the box corners.
+func (r *mongoVenueRepository) FindVenuesWithinBoundingBox(ctx context.Context, minLatitude, minLongitude, maxLatitude, maxLongitude float64) ([]*types.VenueType, error) {
+	polygon := bson.M{
+		"type": "Polygon",
+		"coordinates": [][][]float64{{
+			{minLongitude, minLatitude},
+			{maxLongitude, minLatitude},
+			{maxLongitude, maxLatitude},
+			{minLongitude, maxLatitude},
+			{minLongitude, minLatitude},
+		}},
+	}
+	filter := bson.M{"geo_location": bson.M{"$geoWithin": bson.M{"$geometry": polygon}}}
+	return r.findVenues(ctx, filter)
+}
+
+// findVenues is a helper function to run a query and decode all matching venues.
+func (r *mongoVenueRepository) findVenues(ctx context.Context, filter bson.M) ([]*types.VenueType, error) {
+	var venues []*types.VenueType
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &venues); err != nil {
+		return nil, err
+	}
+	return venues, nil
+}
@@ -0,0 +1,183 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongoWebhookRepository struct {
+	collection         *mongo.Collection
+	deliveryCollection *mongo.Collection
+}
+
+// NewMongoWebhookRepository initializes a new instance of the webhook repository.
+func NewMongoWebhookRepository(db *mongo.Database) repositories.WebhookRepositoryInterface {
+	return &mongoWebhookRepository{
+		collection:         db.Collection("webhooks"),
+		deliveryCollection: db.Collection("webhook_deliveries"),
+	}
+}
+
+// CreateWebhook stores a new webhook in MongoDB.
+func (r *mongoWebhookRepository) CreateWebhook(ctx context.Context, webhook *types.WebhookType) (*types.WebhookType, error) {
+	webhook.CreatedAt = time.Now()
+	webhook.UpdatedAt = time.Now()
+
+	_, err := r.collection.InsertOne(ctx, webhook)
+	if err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// FindWebhookByID retrieves a webhook by its ID in MongoDB.
+func (r *mongoWebhookRepository) FindWebhookByID(ctx context.Context, webhookID uuid.UUID) (*types.WebhookType, error) {
+	var webhook types.WebhookType
+	if err := r.collection.FindOne(ctx, bson.M{"_id": webhookID}).Decode(&webhook); err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// FindWebhooksByOrganizerID retrieves every webhook of an organizer in MongoDB.
+func (r *mongoWebhookRepository) FindWebhooksByOrganizerID(ctx context.Context, organizerID uuid.UUID) ([]*types.WebhookType, error) {
+	return r.findWebhooks(ctx, bson.M{"organizer_id": organizerID})
+}
+
+// FindActiveWebhooksByEventType retrieves the active webhooks of an organizer subscribed to an event type in MongoDB.
+func (r *mongoWebhookRepository) FindActiveWebhooksByEventType(ctx context.Context, organizerID uuid.UUID, eventType string) ([]*types.WebhookType, error) {
+	// Matching a scalar against an array field matches any of its elements
+	return r.findWebhooks(ctx, bson.M{"organizer_id": organizerID, "is_active": true, "event_types": eventType})
+}
+
+// UpdateWebhook stores the editable fields of a webhook in MongoDB.
+func (r *mongoWebhookRepository) UpdateWebhook(ctx context.Context, webhook *types.WebhookType) error {
+	update := bson.M{"$set": bson.M{
+		"url":         webhook.URL,
+		"event_types": webhook.EventTypes,
+		"description": webhook.Description,
+		"is_active":   webhook.IsActive,
+		"updated_at":  time.Now(),
+	}}
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": webhook.ID}, update)
+	return err
+}
+
+// DeleteWebhookByID removes a webhook and its deliveries in MongoDB.
+func (r *mongoWebhookRepository) DeleteWebhookByID(ctx context.Context, webhookID uuid.UUID) error {
+	if _, err := r.collection.DeleteOne(ctx, bson.M{"_id": webhookID}); err != nil {
+		return err
+	}
+	_, err := r.deliveryCollection.DeleteMany(ctx, bson.M{"webhook_id": webhookID})
+	return err
+}
+
+// CreateWebhookDelivery stores a new delivery in MongoDB.
+func (r *mongoWebhookRepository) CreateWebhookDelivery(ctx context.Context, delivery *types.WebhookDeliveryType) (*types.WebhookDeliveryType, error) {
+	delivery.CreatedAt = time.Now()
+	delivery.UpdatedAt = time.Now()
+
+	_, err := r.deliveryCollection.InsertOne(ctx, delivery)
+	if err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// FindWebhookDeliveryByID retrieves a delivery by its ID in MongoDB.
+func (r *mongoWebhookRepository) FindWebhookDeliveryByID(ctx context.Context, deliveryID uuid.UUID) (*types.WebhookDeliveryType, error) {
+	var delivery types.WebhookDeliveryType
+	if err := r.deliveryCollection.FindOne(ctx, bson.M{"_id": deliveryID}).Decode(&delivery); err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// FindWebhookDeliveriesByWebhookID retrieves the latest deliveries of a webhook in MongoDB, newest first.
+func (r *mongoWebhookRepository) FindWebhookDeliveriesByWebhookID(ctx context.Context, webhookID uuid.UUID, limit int) ([]*types.WebhookDeliveryType, error) {
+	opts := options.Find().SetSort(bson.M{"created_at": -1}).SetLimit(int64(limit))
+	return r.findDeliveries(ctx, bson.M{"webhook_id": webhookID}, opts)
+}
+
+// FindDueWebhookDeliveries retrieves pending deliveries that are due in MongoDB.
+func (r *mongoWebhookRepository) FindDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]*types.WebhookDeliveryType, error) {
+	opts := options.Find().SetSort(bson.M{"next_attempt_at": 1}).SetLimit(int64(limit))
+	return r.findDeliveries(ctx, dueWebhookDeliveryFilter(now), opts)
+}
+
+// AcquireWebhookDelivery pushes back the next attempt of a due delivery in MongoDB with a single conditional update.
+func (r *mongoWebhookRepository) AcquireWebhookDelivery(ctx context.Context, deliveryID uuid.UUID, now, lockUntil time.Time) (bool, error) {
+	filter := dueWebhookDeliveryFilter(now)
+	filter["_id"] = deliveryID
+	update := bson.M{"$set": bson.M{
+		"next_attempt_at": lockUntil,
+		"updated_at":      time.Now(),
+	}}
+
+	result, err := r.deliveryCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount == 1, nil
+}
+
+// UpdateWebhookDelivery stores the outcome of a delivery attempt in MongoDB.
+func (r *mongoWebhookRepository) UpdateWebhookDelivery(ctx context.Context, delivery *types.WebhookDeliveryType) error {
+	update := bson.M{"$set": bson.M{
+		"status":          delivery.Status,
+		"attempts":        delivery.Attempts,
+		"next_attempt_at": delivery.NextAttemptAt,
+		"last_attempt_at": delivery.LastAttemptAt,
+		"response_status": delivery.ResponseStatus,
+		"response_body":   delivery.ResponseBody,
+		"last_error":      delivery.LastError,
+		"updated_at":      time.Now(),
+	}}
+	_, err := r.deliveryCollection.UpdateOne(ctx, bson.M{"_id": delivery.ID}, update)
+	return err
+}
+
+// dueWebhookDeliveryFilter matches pending deliveries whose next attempt is due
+func dueWebhookDeliveryFilter(now time.Time) bson.M {
+	return bson.M{
+		"status":          types.WebhookDeliveryStatusPending,
+		"next_attempt_at": bson.M{"$lte": now},
+	}
+}
+
+// findWebhooks runs a query and decodes every matching webhook, oldest first.
+func (r *mongoWebhookRepository) findWebhooks(ctx context.Context, filter bson.M) ([]*types.WebhookType, error) {
+	cursor, err := r.collection.Find(ctx, filter, options.Find().SetSort(bson.M{"created_at": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var webhooks []*types.WebhookType
+	if err = cursor.All(ctx, &webhooks); err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// findDeliveries runs a query and decodes every matching delivery.
+func (r *mongoWebhookRepository) findDeliveries(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*types.WebhookDeliveryType, error) {
+	cursor, err := r.deliveryCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var deliveries []*types.WebhookDeliveryType
+	if err = cursor.All(ctx, &deliveries); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,91 @@
+package postgresdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"gorm.io/gorm"
+)
+
+// auditChainLockKey is the transaction-level advisory lock that serializes appends to the audit chain
+const auditChainLockKey = 7_245_001
+
+type postgresAuditRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresAuditRepository initializes a new instance of the audit repository.
+func NewPostgresAuditRepository(db *gorm.DB) repositories.AuditRepositoryInterface {
+	return &postgresAuditRepository{
+		db: db,
+	}
+}
+
+// AppendAuditRecord chains an audit record to the last one and inserts it in PostgreSQL. An advisory lock
+// keeps other appends, from any instance, out until the transaction ends.
+func (r *postgresAuditRepository) AppendAuditRecord(ctx context.Context, record *types.AuditRecordType) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", auditChainLockKey).Error; err != nil {
+			return err
+		}
+
+		var previous *types.AuditRecordType
+		var last types.AuditRecordType
+		err := tx.Order("sequence DESC").First(&last).Error
+		switch {
+		case err == nil:
+			previous = &last
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			return err
+		}
+
+		record.ChainTo(previous)
+		return tx.Create(record).Error
+	})
+}
+
+// ListAuditRecords finds the audit records matching a filter in PostgreSQL, latest first.
+func (r *postgresAuditRepository) ListAuditRecords(ctx context.Context, filter repositories.AuditRecordFilter, offset, limit int) ([]*types.AuditRecordType, int64, error) {
+	query := r.db.WithContext(ctx).Model(&types.AuditRecordType{})
+	if filter.ActorID != nil {
+		query = query.Where("actor_id = ?", *filter.ActorID)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.TargetType != "" {
+		query = query.Where("target_type = ?", filter.TargetType)
+	}
+	if filter.TargetID != nil {
+		query = query.Where("target_id = ?", *filter.TargetID)
+	}
+	if filter.Since != nil {
+		query = query.Where("created_at >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		query = query.Where("created_at < ?", *filter.Until)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var records []*types.AuditRecordType
+	if err := query.Order("sequence DESC").Offset(offset).Limit(limit).Find(&records).Error; err != nil {
+		return nil, 0, err
+	}
+	return records, total, nil
+}
+
+// FindAuditRecordsAfter finds the audit records following a sequence number in PostgreSQL, in chain order.
+func (r *postgresAuditRepository) FindAuditRecordsAfter(ctx context.Context, afterSequence int64, limit int) ([]*types.AuditRecordType, error) {
+	var records []*types.AuditRecordType
+	err := r.db.WithContext(ctx).
+		Where("sequence > ?", afterSequence).
+		Order("sequence ASC").
+		Limit(limit).
+		Find(&records).Error
+	return records, err
+}
@@ -0,0 +1,70 @@
+package postgresdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"gorm.io/gorm"
+)
+
+type postgresEventGrantRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresEventGrantRepository initializes a new instance of the event grant repository.
+func NewPostgresEventGrantRepository(db *gorm.DB) repositories.EventGrantRepositoryInterface {
+	return &postgresEventGrantRepository{
+		db: db,
+	}
+}
+
+// CreateEventGrant stores a new grant in PostgreSQL; the unique index on event and user backs the check.
+func (r *postgresEventGrantRepository) CreateEventGrant(ctx context.Context, grant *types.EventGrantType) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&types.EventGrantType{}).
+			Where("event_id = ? AND super_user_id = ?", grant.EventID, grant.SuperUserID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return repositories.ErrEventGrantExists
+		}
+		return tx.Create(grant).Error
+	})
+}
+
+// FindEventGrant retrieves the grant of a user on an event in PostgreSQL.
+func (r *postgresEventGrantRepository) FindEventGrant(ctx context.Context, eventID, superUserID uuid.UUID) (*types.EventGrantType, error) {
+	var grant types.EventGrantType
+	err := r.db.WithContext(ctx).First(&grant, "event_id = ? AND super_user_id = ?", eventID, superUserID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &grant, nil
+}
+
+// FindEventGrantsByEventID retrieves every grant on an event in PostgreSQL.
+func (r *postgresEventGrantRepository) FindEventGrantsByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.EventGrantType, error) {
+	var grants []*types.EventGrantType
+	if err := r.db.WithContext(ctx).Where("event_id = ?", eventID).Order("created_at").Find(&grants).Error; err != nil {
+		return nil, err
+	}
+	return grants, nil
+}
+
+// UpdateEventGrantRole stores the role of a grant in PostgreSQL.
+func (r *postgresEventGrantRepository) UpdateEventGrantRole(ctx context.Context, grant *types.EventGrantType) error {
+	return r.db.WithContext(ctx).Model(&types.EventGrantType{}).Where("id = ?", grant.ID).Update("role", grant.Role).Error
+}
+
+// DeleteEventGrant removes the grant of a user on an event in PostgreSQL.
+func (r *postgresEventGrantRepository) DeleteEventGrant(ctx context.Context, eventID, superUserID uuid.UUID) error {
+	return r.db.WithContext(ctx).Where("event_id = ? AND super_user_id = ?", eventID, superUserID).Delete(&types.EventGrantType{}).Error
+}
@@ -2,12 +2,15 @@ package postgresdb
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/lordofthemind/EventureGo/internals/repositories"
 	"github.com/lordofthemind/EventureGo/internals/types"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type postgresEventRepository struct {
@@ -23,6 +26,10 @@ func NewPostgresEventRepository(db *gorm.DB) repositories.EventRepositoryInterfa
 
 // CreateEvent creates a new event record in PostgreSQL.
 func (r *postgresEventRepository) CreateEvent(ctx context.Context, event *types.EventType) (*types.EventType, error) {
+	if err := repositories.CheckTenant(ctx, event.OrganizationID); err != nil {
+		return nil, err
+	}
+
 	event.ID = uuid.New()
 	event.CreatedAt = time.Now()
 	event.UpdatedAt = time.Now()
@@ -36,7 +43,19 @@ func (r *postgresEventRepository) CreateEvent(ctx context.Context, event *types.
 // FindEventByID finds an event by its ID in PostgreSQL.
 func (r *postgresEventRepository) FindEventByID(ctx context.Context, eventID uuid.UUID) (*types.EventType, error) {
 	var event types.EventType
-	if err := r.db.WithContext(ctx).First(&event, "id = ?", eventID).Error; err != nil {
+	if err := r.scoped(ctx).First(&event, "id = ?", eventID).Error; err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// FindEventBySlug finds the event with the given public page slug in PostgreSQL.
+func (r *postgresEventRepository) FindEventBySlug(ctx context.Context, slug string) (*types.EventType, error) {
+	var event types.EventType
+	if err := r.db.WithContext(ctx).First(&event, "slug = ?", slug).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
 		return nil, err
 	}
 	return &event, nil
@@ -45,7 +64,7 @@ func (r *postgresEventRepository) FindEventByID(ctx context.Context, eventID uui
 // FindEventsByOrganizerID retrieves events organized by a specific user.
 func (r *postgresEventRepository) FindEventsByOrganizerID(ctx context.Context, organizerID uuid.UUID) ([]*types.EventType, error) {
 	var events []*types.EventType
-	if err := r.db.WithContext(ctx).Where("organizer_id = ?", organizerID).Find(&events).Error; err != nil {
+	if err := r.scoped(ctx).Where("organizer_id = ?", organizerID).Find(&events).Error; err != nil {
 		return nil, err
 	}
 	return events, nil
@@ -53,6 +72,14 @@ func (r *postgresEventRepository) FindEventsByOrganizerID(ctx context.Context, o
 
 // UpdateEvent updates an existing event in PostgreSQL.
 func (r *postgresEventRepository) UpdateEvent(ctx context.Context, event *types.EventType) error {
+	if err := repositories.CheckTenant(ctx, event.OrganizationID); err != nil {
+		return err
+	}
+	// Save inserts rows it cannot find, so make sure the stored event is in the tenant first
+	if err := r.scoped(ctx).Select("id").First(&types.EventType{}, "id = ?", event.ID).Error; err != nil {
+		return err
+	}
+
 	event.UpdatedAt = time.Now()
 	if err := r.db.WithContext(ctx).Save(event).Error; err != nil {
 		return err
@@ -62,7 +89,7 @@ func (r *postgresEventRepository) UpdateEvent(ctx context.Context, event *types.
 
 // DeleteEventByID deletes an event by its ID in PostgreSQL.
 func (r *postgresEventRepository) DeleteEventByID(ctx context.Context, eventID uuid.UUID) error {
-	if err := r.db.WithContext(ctx).Delete(&types.EventType{}, "id = ?", eventID).Error; err != nil {
+	if err := r.scoped(ctx).Delete(&types.EventType{}, "id = ?", eventID).Error; err != nil {
 		return err
 	}
 	return nil
@@ -71,7 +98,7 @@ func (r *postgresEventRepository) DeleteEventByID(ctx context.Context, eventID u
 // FindAllEvents retrieves all events from PostgreSQL.
 func (r *postgresEventRepository) FindAllEvents(ctx context.Context) ([]*types.EventType, error) {
 	var events []*types.EventType
-	if err := r.db.WithContext(ctx).Find(&events).Error; err != nil {
+	if err := r.scoped(ctx).Find(&events).Error; err != nil {
 		return nil, err
 	}
 	return events, nil
@@ -80,7 +107,7 @@ func (r *postgresEventRepository) FindAllEvents(ctx context.Context) ([]*types.E
 // FindEventsByDateRange finds events occurring between a start and end date.
 func (r *postgresEventRepository) FindEventsByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*types.EventType, error) {
 	var events []*types.EventType
-	if err := r.db.WithContext(ctx).Where("start_time >= ? AND end_time <= ?", startDate, endDate).Find(&events).Error; err != nil {
+	if err := r.scoped(ctx).Where("start_time >= ? AND end_time <= ?", startDate, endDate).Find(&events).Error; err != nil {
 		return nil, err
 	}
 	return events, nil
@@ -89,7 +116,41 @@ func (r *postgresEventRepository) FindEventsByDateRange(ctx context.Context, sta
 // FindEventsByLocation finds events based on location.
 func (r *postgresEventRepository) FindEventsByLocation(ctx context.Context, location string) ([]*types.EventType, error) {
 	var events []*types.EventType
-	if err := r.db.WithContext(ctx).Where("location = ?", location).Find(&events).Error; err != nil {
+	if err := r.scoped(ctx).Where("location = ?", location).Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// FindEventsByVenueIDs retrieves events held at any of the specified venues.
+func (r *postgresEventRepository) FindEventsByVenueIDs(ctx context.Context, venueIDs []uuid.UUID) ([]*types.EventType, error) {
+	var events []*types.EventType
+	if err := r.scoped(ctx).Where("venue_id IN ?", venueIDs).Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// FindOverlappingEvents retrieves active events at the venue or run by the organizer that may overlap the window.
+func (r *postgresEventRepository) FindOverlappingEvents(ctx context.Context, venueID, organizerID *uuid.UUID, windowStart, windowEnd time.Time) ([]*types.EventType, error) {
+	var events []*types.EventType
+
+	query := r.scoped(ctx).
+		Where("is_active = ? AND status <> ? AND start_time < ?", true, types.EventStatusCancelled, windowEnd).
+		Where("end_time > ? OR (recurrence IS NOT NULL AND (recurrence->>'until' IS NULL OR (recurrence->>'until')::timestamptz > ?))", windowStart, windowStart)
+
+	switch {
+	case venueID != nil && organizerID != nil:
+		query = query.Where("venue_id = ? OR organizer_id = ?", *venueID, *organizerID)
+	case venueID != nil:
+		query = query.Where("venue_id = ?", *venueID)
+	case organizerID != nil:
+		query = query.Where("organizer_id = ?", *organizerID)
+	default:
+		return events, nil
+	}
+
+	if err := query.Find(&events).Error; err != nil {
 		return nil, err
 	}
 	return events, nil
@@ -98,7 +159,7 @@ func (r *postgresEventRepository) FindEventsByLocation(ctx context.Context, loca
 // FindEventsByMultipleTags retrieves events that match any of the specified tags.
 func (r *postgresEventRepository) FindEventsByMultipleTags(ctx context.Context, tags []string) ([]*types.EventType, error) {
 	var events []*types.EventType
-	if err := r.db.WithContext(ctx).Where("tags && ?", tags).Find(&events).Error; err != nil {
+	if err := r.scoped(ctx).Where("tags && ?", tags).Find(&events).Error; err != nil {
 		return nil, err
 	}
 	return events, nil
@@ -114,10 +175,13 @@ func (r *postgresEventRepository) DeactivateEvent(ctx context.Context, eventID u
 	return r.updateEventStatus(ctx, eventID, false)
 }
 
-// FindUpcomingEvents retrieves events scheduled for future dates.
+// FindUpcomingEvents retrieves events scheduled for future dates, including recurring series that have not ended.
 func (r *postgresEventRepository) FindUpcomingEvents(ctx context.Context) ([]*types.EventType, error) {
 	var events []*types.EventType
-	if err := r.db.WithContext(ctx).Where("start_time > ?", time.Now()).Find(&events).Error; err != nil {
+	now := time.Now().UTC()
+	if err := r.scoped(ctx).
+		Where("start_time > ? OR (recurrence IS NOT NULL AND (recurrence->>'until' IS NULL OR (recurrence->>'until')::timestamptz > ?))", now, now).
+		Find(&events).Error; err != nil {
 		return nil, err
 	}
 	return events, nil
@@ -126,7 +190,7 @@ func (r *postgresEventRepository) FindUpcomingEvents(ctx context.Context) ([]*ty
 // FindPastEvents retrieves events that have already occurred.
 func (r *postgresEventRepository) FindPastEvents(ctx context.Context) ([]*types.EventType, error) {
 	var events []*types.EventType
-	if err := r.db.WithContext(ctx).Where("end_time < ?", time.Now()).Find(&events).Error; err != nil {
+	if err := r.scoped(ctx).Where("end_time < ?", time.Now().UTC()).Find(&events).Error; err != nil {
 		return nil, err
 	}
 	return events, nil
@@ -135,7 +199,7 @@ func (r *postgresEventRepository) FindPastEvents(ctx context.Context) ([]*types.
 // FindActiveEvents retrieves all currently active events.
 func (r *postgresEventRepository) FindActiveEvents(ctx context.Context) ([]*types.EventType, error) {
 	var events []*types.EventType
-	if err := r.db.WithContext(ctx).Where("is_active = true").Find(&events).Error; err != nil {
+	if err := r.scoped(ctx).Where("is_active = true").Find(&events).Error; err != nil {
 		return nil, err
 	}
 	return events, nil
@@ -144,7 +208,7 @@ func (r *postgresEventRepository) FindActiveEvents(ctx context.Context) ([]*type
 // FindInactiveEvents retrieves all inactive events.
 func (r *postgresEventRepository) FindInactiveEvents(ctx context.Context) ([]*types.EventType, error) {
 	var events []*types.EventType
-	if err := r.db.WithContext(ctx).Where("is_active = false").Find(&events).Error; err != nil {
+	if err := r.scoped(ctx).Where("is_active = false").Find(&events).Error; err != nil {
 		return nil, err
 	}
 	return events, nil
@@ -153,25 +217,44 @@ func (r *postgresEventRepository) FindInactiveEvents(ctx context.Context) ([]*ty
 // SearchEventsByTitle finds events by searching their titles in PostgreSQL.
 func (r *postgresEventRepository) SearchEventsByTitle(ctx context.Context, title string) ([]*types.EventType, error) {
 	var events []*types.EventType
-	if err := r.db.WithContext(ctx).Where("title ILIKE ?", "%"+title+"%").Find(&events).Error; err != nil {
+	if err := r.scoped(ctx).Where("title ILIKE ?", containsPattern(title)).Find(&events).Error; err != nil {
 		return nil, err
 	}
 	return events, nil
 }
 
-// CancelEvent cancels an event by marking it as inactive in PostgreSQL.
-func (r *postgresEventRepository) CancelEvent(ctx context.Context, eventID uuid.UUID) error {
-	return r.updateEventStatus(ctx, eventID, false)
+// TransitionEventStatus moves an event to a new lifecycle state in PostgreSQL, locking the row while its current state is checked.
+func (r *postgresEventRepository) TransitionEventStatus(ctx context.Context, eventID uuid.UUID, change *types.EventStatusChangeType) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var event types.EventType
+		if err := tenantScoped(ctx, tx).Clauses(clause.Locking{Strength: "UPDATE"}).First(&event, "id = ?", eventID).Error; err != nil {
+			return err
+		}
+		if event.CurrentStatus() != change.From {
+			return repositories.ErrEventStatusChanged
+		}
+
+		event.Status = change.To
+		if change.To == types.EventStatusCancelled {
+			event.CancellationReason = change.Reason
+			event.Sequence++
+		}
+		event.StatusHistory = append(event.StatusHistory, *change)
+		event.UpdatedAt = time.Now()
+
+		return tx.Model(&event).Select("status", "cancellation_reason", "status_history", "sequence", "updated_at").Updates(&event).Error
+	})
 }
 
-// RescheduleEvent reschedules an event to a new date and time in PostgreSQL.
+// RescheduleEvent reschedules an event to a new date and time in PostgreSQL, bumping its iCalendar sequence.
 func (r *postgresEventRepository) RescheduleEvent(ctx context.Context, eventID uuid.UUID, newStartTime, newEndTime time.Time) error {
 	var event types.EventType
-	if err := r.db.WithContext(ctx).First(&event, "id = ?", eventID).Error; err != nil {
+	if err := r.scoped(ctx).First(&event, "id = ?", eventID).Error; err != nil {
 		return err
 	}
-	event.StartTime = newStartTime
-	event.EndTime = newEndTime
+	event.StartTime = newStartTime.UTC()
+	event.EndTime = newEndTime.UTC()
+	event.Sequence++
 	event.UpdatedAt = time.Now()
 	if err := r.db.WithContext(ctx).Save(&event).Error; err != nil {
 		return err
@@ -182,7 +265,7 @@ func (r *postgresEventRepository) RescheduleEvent(ctx context.Context, eventID u
 // CountTotalEvents returns the total number of events in the system.
 func (r *postgresEventRepository) CountTotalEvents(ctx context.Context) (int64, error) {
 	var count int64
-	if err := r.db.WithContext(ctx).Model(&types.EventType{}).Count(&count).Error; err != nil {
+	if err := r.scoped(ctx).Model(&types.EventType{}).Count(&count).Error; err != nil {
 		return 0, err
 	}
 	return count, nil
@@ -191,16 +274,38 @@ func (r *postgresEventRepository) CountTotalEvents(ctx context.Context) (int64,
 // CountEventsByOrganizerID counts the number of events organized by a specific user.
 func (r *postgresEventRepository) CountEventsByOrganizerID(ctx context.Context, organizerID uuid.UUID) (int64, error) {
 	var count int64
-	if err := r.db.WithContext(ctx).Model(&types.EventType{}).Where("organizer_id = ?", organizerID).Count(&count).Error; err != nil {
+	if err := r.scoped(ctx).Model(&types.EventType{}).Where("organizer_id = ?", organizerID).Count(&count).Error; err != nil {
 		return 0, err
 	}
 	return count, nil
 }
 
+// scoped starts a query limited to the tenant in ctx, if any
+func (r *postgresEventRepository) scoped(ctx context.Context) *gorm.DB {
+	return tenantScoped(ctx, r.db.WithContext(ctx))
+}
+
 // updateEventStatus is a helper function to activate or deactivate an event.
 func (r *postgresEventRepository) updateEventStatus(ctx context.Context, eventID uuid.UUID, isActive bool) error {
-	if err := r.db.WithContext(ctx).Model(&types.EventType{}).Where("id = ?", eventID).Update("is_active", isActive).Error; err != nil {
+	if err := r.scoped(ctx).Model(&types.EventType{}).Where("id = ?", eventID).Update("is_active", isActive).Error; err != nil {
 		return err
 	}
 	return nil
 }
+
+// containsPattern builds an ILIKE pattern matching values that contain search, with its wildcards taken literally
+func containsPattern(search string) string {
+	return "%" + strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(search) + "%"
+}
+
+// tenantScoped limits a query on events or guests to the tenant in ctx, if any
+func tenantScoped(ctx context.Context, db *gorm.DB) *gorm.DB {
+	scope, ok := repositories.TenantScopeFromContext(ctx)
+	if !ok {
+		return db
+	}
+	if scope.OrganizationID == nil {
+		return db.Where("organization_id IS NULL")
+	}
+	return db.Where("organization_id = ?", *scope.OrganizationID)
+}
@@ -2,11 +2,13 @@ package postgresdb
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 	"github.com/lordofthemind/EventureGo/internals/repositories"
 	"github.com/lordofthemind/EventureGo/internals/types"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type postgresGuestRepository struct {
@@ -22,6 +24,10 @@ func NewPostgresGuestRepository(db *gorm.DB) repositories.GuestRepositoryInterfa
 
 // AddGuest adds a new guest to an event in PostgreSQL.
 func (r *postgresGuestRepository) AddGuest(ctx context.Context, guest *types.GuestType) (*types.GuestType, error) {
+	if err := repositories.CheckTenant(ctx, guest.OrganizationID); err != nil {
+		return nil, err
+	}
+
 	guest.ID = uuid.New()
 	if err := r.db.WithContext(ctx).Create(guest).Error; err != nil {
 		return nil, err
@@ -33,6 +39,9 @@ func (r *postgresGuestRepository) AddGuest(ctx context.Context, guest *types.Gue
 func (r *postgresGuestRepository) AddBulkGuest(ctx context.Context, guests []*types.GuestType) ([]*types.GuestType, error) {
 	// Generate UUIDs for all guests
 	for _, guest := range guests {
+		if err := repositories.CheckTenant(ctx, guest.OrganizationID); err != nil {
+			return nil, err
+		}
 		guest.ID = uuid.New()
 	}
 
@@ -48,16 +57,60 @@ func (r *postgresGuestRepository) AddBulkGuest(ctx context.Context, guests []*ty
 // FindGuestsByEventID retrieves all guests for a given event in PostgreSQL.
 func (r *postgresGuestRepository) FindGuestsByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.GuestType, error) {
 	var guests []*types.GuestType
-	if err := r.db.WithContext(ctx).Where("event_id = ?", eventID).Find(&guests).Error; err != nil {
+	if err := r.scoped(ctx).Where("event_id = ?", eventID).Find(&guests).Error; err != nil {
 		return nil, err
 	}
 	return guests, nil
 }
 
+// StreamGuestsByEventID iterates the guests of an event in PostgreSQL row by row.
+func (r *postgresGuestRepository) StreamGuestsByEventID(ctx context.Context, eventID uuid.UUID, rsvpStatus string, checkedIn *bool, visit func(guest *types.GuestType) error) error {
+	query := r.scoped(ctx).Model(&types.GuestType{}).Where("event_id = ?", eventID)
+	if rsvpStatus != "" {
+		query = query.Where("rsvp_status = ?", rsvpStatus)
+	}
+	if checkedIn != nil {
+		if *checkedIn {
+			query = query.Where("checked_in_at IS NOT NULL")
+		} else {
+			query = query.Where("checked_in_at IS NULL")
+		}
+	}
+
+	rows, err := query.Order("full_name").Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var guest types.GuestType
+		if err := r.db.ScanRows(rows, &guest); err != nil {
+			return err
+		}
+		if err := visit(&guest); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
 // FindGuestByID retrieves a guest by their ID in PostgreSQL.
 func (r *postgresGuestRepository) FindGuestByID(ctx context.Context, guestID uuid.UUID) (*types.GuestType, error) {
 	var guest types.GuestType
-	if err := r.db.WithContext(ctx).Where("id = ?", guestID).First(&guest).Error; err != nil {
+	if err := r.scoped(ctx).Where("id = ?", guestID).First(&guest).Error; err != nil {
+		return nil, err
+	}
+	return &guest, nil
+}
+
+// FindGuestByEventIDAndEmail retrieves the guest of an event with the given email in PostgreSQL.
+func (r *postgresGuestRepository) FindGuestByEventIDAndEmail(ctx context.Context, eventID uuid.UUID, email string) (*types.GuestType, error) {
+	var guest types.GuestType
+	if err := r.scoped(ctx).Where("event_id = ? AND email = ?", eventID, email).First(&guest).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
 		return nil, err
 	}
 	return &guest, nil
@@ -65,17 +118,104 @@ func (r *postgresGuestRepository) FindGuestByID(ctx context.Context, guestID uui
 
 // UpdateGuest updates the information of an existing guest in PostgreSQL.
 func (r *postgresGuestRepository) UpdateGuest(ctx context.Context, guest *types.GuestType) error {
+	if err := repositories.CheckTenant(ctx, guest.OrganizationID); err != nil {
+		return err
+	}
+	// Save inserts rows it cannot find, so make sure the stored guest is in the tenant first
+	if err := r.scoped(ctx).Select("id").First(&types.GuestType{}, "id = ?", guest.ID).Error; err != nil {
+		return err
+	}
 	return r.db.WithContext(ctx).Save(guest).Error
 }
 
+// AcceptGuestWithinCapacity stores a guest who accepted in PostgreSQL. The event row is locked while the seats are
+// counted, so acceptances for the same event wait for each other.
+func (r *postgresGuestRepository) AcceptGuestWithinCapacity(ctx context.Context, guest *types.GuestType, capacity int) error {
+	if err := repositories.CheckTenant(ctx, guest.OrganizationID); err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tenantScoped(ctx, tx).Select("id").First(&types.GuestType{}, "id = ?", guest.ID).Error; err != nil {
+			return err
+		}
+		if capacity > 0 {
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&types.EventType{}, "id = ?", guest.EventID).Error; err != nil {
+				return err
+			}
+			var accepted int64
+			err := tx.Model(&types.GuestType{}).
+				Where("event_id = ? AND id <> ? AND rsvp_status = ?", guest.EventID, guest.ID, types.RSVPStatusAccepted).
+				Count(&accepted).Error
+			if err != nil {
+				return err
+			}
+			if accepted >= int64(capacity) {
+				return repositories.ErrEventFull
+			}
+		}
+		return tx.Save(guest).Error
+	})
+}
+
 // DeleteGuestByID removes a guest by their ID in PostgreSQL.
 func (r *postgresGuestRepository) DeleteGuestByID(ctx context.Context, guestID uuid.UUID) error {
-	return r.db.WithContext(ctx).Where("id = ?", guestID).Delete(&types.GuestType{}).Error
+	return r.scoped(ctx).Where("id = ?", guestID).Delete(&types.GuestType{}).Error
 }
 
 // CountGuestsByEventID counts the number of guests for a given event in PostgreSQL.
 func (r *postgresGuestRepository) CountGuestsByEventID(ctx context.Context, eventID uuid.UUID) (int64, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&types.GuestType{}).Where("event_id = ?", eventID).Count(&count).Error
+	err := r.scoped(ctx).Model(&types.GuestType{}).Where("event_id = ?", eventID).Count(&count).Error
 	return count, err
 }
+
+// CountGuestsByRSVPStatus counts the guests of an event per RSVP status in PostgreSQL.
+func (r *postgresGuestRepository) CountGuestsByRSVPStatus(ctx context.Context, eventID uuid.UUID) (map[string]int64, error) {
+	var groups []struct {
+		RSVPStatus string
+		Count      int64
+	}
+	if err := r.scoped(ctx).Model(&types.GuestType{}).
+		Select("rsvp_status, count(*) AS count").
+		Where("event_id = ?", eventID).
+		Group("rsvp_status").
+		Scan(&groups).Error; err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(groups))
+	for _, group := range groups {
+		counts[group.RSVPStatus] = group.Count
+	}
+	return counts, nil
+}
+
+// CountCheckedInGuestsByEventID counts the checked-in guests of an event in PostgreSQL.
+func (r *postgresGuestRepository) CountCheckedInGuestsByEventID(ctx context.Context, eventID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.scoped(ctx).Model(&types.GuestType{}).
+		Where("event_id = ? AND checked_in_at IS NOT NULL", eventID).
+		Count(&count).Error
+	return count, err
+}
+
+// FindNextWaitlistedGuest retrieves the longest-waiting guest of an event in PostgreSQL.
+func (r *postgresGuestRepository) FindNextWaitlistedGuest(ctx context.Context, eventID uuid.UUID) (*types.GuestType, error) {
+	var guest types.GuestType
+	err := r.scoped(ctx).
+		Where("event_id = ? AND rsvp_status = ?", eventID, types.RSVPStatusWaitlisted).
+		Order("updated_at").
+		First(&guest).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &guest, nil
+}
+
+// scoped starts a query limited to the tenant in ctx, if any
+func (r *postgresGuestRepository) scoped(ctx context.Context) *gorm.DB {
+	return tenantScoped(ctx, r.db.WithContext(ctx))
+}
@@ -0,0 +1,142 @@
+package postgresdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// dueJobCondition matches active jobs that are due and whose lease, if any, has run out
+const dueJobCondition = "is_active = true AND next_run_at <= ? AND (locked_until IS NULL OR locked_until < ?)"
+
+type postgresJobRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresJobRepository initializes a new instance of the job repository.
+func NewPostgresJobRepository(db *gorm.DB) repositories.JobRepositoryInterface {
+	return &postgresJobRepository{
+		db: db,
+	}
+}
+
+// UpsertJob creates or updates a job by name in PostgreSQL.
+func (r *postgresJobRepository) UpsertJob(ctx context.Context, job *types.JobType, overwriteNextRun bool) (*types.JobType, error) {
+	columns := []string{"kind", "schedule", "payload", "max_attempts", "is_active", "updated_at"}
+	if overwriteNextRun {
+		columns = append(columns, "next_run_at", "attempts")
+	}
+
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns(columns),
+	}).Create(job).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var stored types.JobType
+	if err := r.db.WithContext(ctx).Where("name = ?", job.Name).First(&stored).Error; err != nil {
+		return nil, err
+	}
+	return &stored, nil
+}
+
+// FindJobByName retrieves a job by name in PostgreSQL.
+func (r *postgresJobRepository) FindJobByName(ctx context.Context, name string) (*types.JobType, error) {
+	var job types.JobType
+	err := r.db.WithContext(ctx).Where("name = ?", name).First(&job).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// FindAllJobs retrieves every job in PostgreSQL ordered by name.
+func (r *postgresJobRepository) FindAllJobs(ctx context.Context) ([]*types.JobType, error) {
+	var jobs []*types.JobType
+	if err := r.db.WithContext(ctx).Order("name").Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// FindDueJobs retrieves active, unlocked jobs that are due in PostgreSQL.
+func (r *postgresJobRepository) FindDueJobs(ctx context.Context, now time.Time, limit int) ([]*types.JobType, error) {
+	var jobs []*types.JobType
+	if err := r.db.WithContext(ctx).Where(dueJobCondition, now, now).Order("next_run_at").Limit(limit).Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// AcquireJob takes the lease on a due job in PostgreSQL with a single conditional update.
+func (r *postgresJobRepository) AcquireJob(ctx context.Context, jobID uuid.UUID, instanceID string, now, lockUntil time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&types.JobType{}).
+		Where("id = ?", jobID).
+		Where(dueJobCondition, now, now).
+		Updates(map[string]interface{}{
+			"locked_by":    instanceID,
+			"locked_until": lockUntil,
+			"updated_at":   time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// ReleaseJob stores the outcome of a run and clears the lease in PostgreSQL.
+func (r *postgresJobRepository) ReleaseJob(ctx context.Context, job *types.JobType) error {
+	result := r.db.WithContext(ctx).Model(&types.JobType{}).
+		Where("id = ? AND locked_by = ?", job.ID, job.LockedBy).
+		Updates(map[string]interface{}{
+			"next_run_at":  job.NextRunAt,
+			"attempts":     job.Attempts,
+			"is_active":    job.IsActive,
+			"last_run_at":  job.LastRunAt,
+			"last_error":   job.LastError,
+			"locked_by":    "",
+			"locked_until": nil,
+			"updated_at":   time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrJobLockLost
+	}
+	return nil
+}
+
+// CreateJobRun records the start of a job run in PostgreSQL.
+func (r *postgresJobRepository) CreateJobRun(ctx context.Context, run *types.JobRunType) error {
+	return r.db.WithContext(ctx).Create(run).Error
+}
+
+// FinishJobRun stores the outcome of a job run in PostgreSQL.
+func (r *postgresJobRepository) FinishJobRun(ctx context.Context, run *types.JobRunType) error {
+	return r.db.WithContext(ctx).Model(run).Select("status", "error", "finished_at").Updates(run).Error
+}
+
+// FindJobRuns retrieves the latest job runs in PostgreSQL, newest first.
+func (r *postgresJobRepository) FindJobRuns(ctx context.Context, jobName string, limit int) ([]*types.JobRunType, error) {
+	query := r.db.WithContext(ctx).Order("started_at DESC").Limit(limit)
+	if jobName != "" {
+		query = query.Where("job_name = ?", jobName)
+	}
+
+	var runs []*types.JobRunType
+	if err := query.Find(&runs).Error; err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
@@ -0,0 +1,86 @@
+package postgresdb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"gorm.io/gorm"
+)
+
+type postgresLoginChallengeRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresLoginChallengeRepository initializes a new instance of the login challenge repository.
+func NewPostgresLoginChallengeRepository(db *gorm.DB) repositories.LoginChallengeRepositoryInterface {
+	return &postgresLoginChallengeRepository{
+		db: db,
+	}
+}
+
+// CreateLoginChallenge stores a new challenge in PostgreSQL.
+func (r *postgresLoginChallengeRepository) CreateLoginChallenge(ctx context.Context, challenge *types.LoginChallengeType) error {
+	return r.db.WithContext(ctx).Create(challenge).Error
+}
+
+// FindLoginChallengeByTokenHash retrieves the challenge of a login link in PostgreSQL.
+func (r *postgresLoginChallengeRepository) FindLoginChallengeByTokenHash(ctx context.Context, tokenHash string) (*types.LoginChallengeType, error) {
+	return r.findLoginChallenge(ctx, "token_hash = ?", tokenHash)
+}
+
+// FindLoginChallengeByNonceHash retrieves the challenge a browser started in PostgreSQL.
+func (r *postgresLoginChallengeRepository) FindLoginChallengeByNonceHash(ctx context.Context, nonceHash string) (*types.LoginChallengeType, error) {
+	return r.findLoginChallenge(ctx, "nonce_hash = ?", nonceHash)
+}
+
+// CountLoginChallengesSince counts the recent challenges of a superuser in PostgreSQL.
+func (r *postgresLoginChallengeRepository) CountLoginChallengesSince(ctx context.Context, superUserID uuid.UUID, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&types.LoginChallengeType{}).
+		Where("super_user_id = ? AND created_at >= ?", superUserID, since).
+		Count(&count).Error
+	return count, err
+}
+
+// RecordFailedLoginChallengeAttempt increments the wrong codes of a challenge in PostgreSQL.
+func (r *postgresLoginChallengeRepository) RecordFailedLoginChallengeAttempt(ctx context.Context, challengeID uuid.UUID) error {
+	return r.db.WithContext(ctx).Model(&types.LoginChallengeType{}).
+		Where("id = ?", challengeID).
+		Update("attempts", gorm.Expr("attempts + 1")).Error
+}
+
+// UseLoginChallenge marks a challenge as used in PostgreSQL; only an unused challenge is updated.
+func (r *postgresLoginChallengeRepository) UseLoginChallenge(ctx context.Context, challengeID uuid.UUID, usedAt time.Time) error {
+	result := r.db.WithContext(ctx).Model(&types.LoginChallengeType{}).
+		Where("id = ? AND used_at IS NULL", challengeID).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrLoginChallengeUsed
+	}
+	return nil
+}
+
+// DeleteExpiredLoginChallenges removes expired challenges in PostgreSQL.
+func (r *postgresLoginChallengeRepository) DeleteExpiredLoginChallenges(ctx context.Context, now time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Where("expires_at < ?", now).Delete(&types.LoginChallengeType{})
+	return result.RowsAffected, result.Error
+}
+
+// findLoginChallenge retrieves the first challenge matching a condition, or nil
+func (r *postgresLoginChallengeRepository) findLoginChallenge(ctx context.Context, query string, args ...interface{}) (*types.LoginChallengeType, error) {
+	var challenge types.LoginChallengeType
+	err := r.db.WithContext(ctx).Where(query, args...).First(&challenge).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &challenge, nil
+}
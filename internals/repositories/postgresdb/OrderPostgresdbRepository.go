@@ -0,0 +1,78 @@
+package postgresdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"gorm.io/gorm"
+)
+
+type postgresOrderRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresOrderRepository initializes a new instance of the order repository.
+func NewPostgresOrderRepository(db *gorm.DB) repositories.OrderRepositoryInterface {
+	return &postgresOrderRepository{
+		db: db,
+	}
+}
+
+// CreateOrder stores a new order in PostgreSQL.
+func (r *postgresOrderRepository) CreateOrder(ctx context.Context, order *types.OrderType) (*types.OrderType, error) {
+	if err := r.db.WithContext(ctx).Create(order).Error; err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// FindOrderByID retrieves an order by its ID in PostgreSQL.
+func (r *postgresOrderRepository) FindOrderByID(ctx context.Context, orderID uuid.UUID) (*types.OrderType, error) {
+	var order types.OrderType
+	if err := r.db.WithContext(ctx).First(&order, "id = ?", orderID).Error; err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// FindOrdersByEventID retrieves every order of an event in PostgreSQL, newest first.
+func (r *postgresOrderRepository) FindOrdersByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.OrderType, error) {
+	var orders []*types.OrderType
+	if err := r.db.WithContext(ctx).Where("event_id = ?", eventID).Order("created_at DESC").Find(&orders).Error; err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// FindExpiredPendingOrders retrieves pending orders whose hold ran out in PostgreSQL.
+func (r *postgresOrderRepository) FindExpiredPendingOrders(ctx context.Context, now time.Time, limit int) ([]*types.OrderType, error) {
+	var orders []*types.OrderType
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND expires_at <= ?", types.OrderStatusPending, now).
+		Order("expires_at").
+		Limit(limit).
+		Find(&orders).Error
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// UpdateOrder stores the state of an order in PostgreSQL while it is still in fromStatus.
+func (r *postgresOrderRepository) UpdateOrder(ctx context.Context, order *types.OrderType, fromStatus string) error {
+	order.UpdatedAt = time.Now()
+	result := r.db.WithContext(ctx).Model(&types.OrderType{}).
+		Where("id = ? AND status = ?", order.ID, fromStatus).
+		Select("status", "attendees", "confirmed_at", "closed_at", "close_reason", "updated_at").
+		Updates(order)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrOrderStatusChanged
+	}
+	return nil
+}
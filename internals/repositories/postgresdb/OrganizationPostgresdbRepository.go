@@ -0,0 +1,161 @@
+package postgresdb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"gorm.io/gorm"
+)
+
+type postgresOrganizationRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresOrganizationRepository initializes a new instance of the organization repository.
+func NewPostgresOrganizationRepository(db *gorm.DB) repositories.OrganizationRepositoryInterface {
+	return &postgresOrganizationRepository{
+		db: db,
+	}
+}
+
+// CreateOrganization stores a new organization in PostgreSQL.
+func (r *postgresOrganizationRepository) CreateOrganization(ctx context.Context, organization *types.OrganizationType) (*types.OrganizationType, error) {
+	if err := r.db.WithContext(ctx).Create(organization).Error; err != nil {
+		return nil, err
+	}
+	return organization, nil
+}
+
+// FindOrganizationByID retrieves an organization by its ID in PostgreSQL.
+func (r *postgresOrganizationRepository) FindOrganizationByID(ctx context.Context, organizationID uuid.UUID) (*types.OrganizationType, error) {
+	var organization types.OrganizationType
+	if err := r.db.WithContext(ctx).First(&organization, "id = ?", organizationID).Error; err != nil {
+		return nil, err
+	}
+	return &organization, nil
+}
+
+// AddOrganizationMember stores a new membership in PostgreSQL; the unique index on organization and user backs the check.
+func (r *postgresOrganizationRepository) AddOrganizationMember(ctx context.Context, member *types.OrganizationMemberType) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&types.OrganizationMemberType{}).
+			Where("organization_id = ? AND super_user_id = ?", member.OrganizationID, member.SuperUserID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return repositories.ErrOrganizationMemberExists
+		}
+		return tx.Create(member).Error
+	})
+}
+
+// FindOrganizationMember retrieves the membership of a user in an organization in PostgreSQL.
+func (r *postgresOrganizationRepository) FindOrganizationMember(ctx context.Context, organizationID, superUserID uuid.UUID) (*types.OrganizationMemberType, error) {
+	var member types.OrganizationMemberType
+	err := r.db.WithContext(ctx).First(&member, "organization_id = ? AND super_user_id = ?", organizationID, superUserID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// FindOrganizationMembers retrieves every member of an organization in PostgreSQL.
+func (r *postgresOrganizationRepository) FindOrganizationMembers(ctx context.Context, organizationID uuid.UUID) ([]*types.OrganizationMemberType, error) {
+	var members []*types.OrganizationMemberType
+	if err := r.db.WithContext(ctx).Where("organization_id = ?", organizationID).Order("created_at").Find(&members).Error; err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// FindMembershipsBySuperUserID retrieves every membership of a user in PostgreSQL.
+func (r *postgresOrganizationRepository) FindMembershipsBySuperUserID(ctx context.Context, superUserID uuid.UUID) ([]*types.OrganizationMemberType, error) {
+	var members []*types.OrganizationMemberType
+	if err := r.db.WithContext(ctx).Where("super_user_id = ?", superUserID).Order("created_at").Find(&members).Error; err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// UpdateOrganizationMemberRole stores the role of a membership in PostgreSQL.
+func (r *postgresOrganizationRepository) UpdateOrganizationMemberRole(ctx context.Context, member *types.OrganizationMemberType) error {
+	return r.db.WithContext(ctx).Model(member).Select("role", "updated_at").Updates(member).Error
+}
+
+// RemoveOrganizationMember removes a user from an organization in PostgreSQL.
+func (r *postgresOrganizationRepository) RemoveOrganizationMember(ctx context.Context, organizationID, superUserID uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Where("organization_id = ? AND super_user_id = ?", organizationID, superUserID).
+		Delete(&types.OrganizationMemberType{}).Error
+}
+
+// CreateOrganizationInvitation stores a new invitation in PostgreSQL.
+func (r *postgresOrganizationRepository) CreateOrganizationInvitation(ctx context.Context, invitation *types.OrganizationInvitationType) (*types.OrganizationInvitationType, error) {
+	if err := r.db.WithContext(ctx).Create(invitation).Error; err != nil {
+		return nil, err
+	}
+	return invitation, nil
+}
+
+// FindOrganizationInvitationByID retrieves an invitation by its ID in PostgreSQL.
+func (r *postgresOrganizationRepository) FindOrganizationInvitationByID(ctx context.Context, invitationID uuid.UUID) (*types.OrganizationInvitationType, error) {
+	var invitation types.OrganizationInvitationType
+	if err := r.db.WithContext(ctx).First(&invitation, "id = ?", invitationID).Error; err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+// FindOrganizationInvitationByTokenHash retrieves the invitation with the given token hash in PostgreSQL.
+func (r *postgresOrganizationRepository) FindOrganizationInvitationByTokenHash(ctx context.Context, tokenHash string) (*types.OrganizationInvitationType, error) {
+	var invitation types.OrganizationInvitationType
+	err := r.db.WithContext(ctx).First(&invitation, "token_hash = ?", tokenHash).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+// FindPendingOrganizationInvitations retrieves the invitations of an organization that can still be accepted in PostgreSQL.
+func (r *postgresOrganizationRepository) FindPendingOrganizationInvitations(ctx context.Context, organizationID uuid.UUID, now time.Time) ([]*types.OrganizationInvitationType, error) {
+	var invitations []*types.OrganizationInvitationType
+	err := r.db.WithContext(ctx).
+		Where("organization_id = ? AND accepted_at IS NULL AND expires_at > ?", organizationID, now).
+		Order("created_at DESC").
+		Find(&invitations).Error
+	if err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+// MarkOrganizationInvitationAccepted records the acceptance of an invitation in PostgreSQL, guarded by it still being unaccepted.
+func (r *postgresOrganizationRepository) MarkOrganizationInvitationAccepted(ctx context.Context, invitationID, superUserID uuid.UUID, acceptedAt time.Time) error {
+	result := r.db.WithContext(ctx).Model(&types.OrganizationInvitationType{}).
+		Where("id = ? AND accepted_at IS NULL", invitationID).
+		Updates(map[string]interface{}{"accepted_at": acceptedAt, "accepted_by_id": superUserID})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrOrganizationInvitationSpent
+	}
+	return nil
+}
+
+// DeleteOrganizationInvitation removes an invitation in PostgreSQL.
+func (r *postgresOrganizationRepository) DeleteOrganizationInvitation(ctx context.Context, invitationID uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&types.OrganizationInvitationType{}, "id = ?", invitationID).Error
+}
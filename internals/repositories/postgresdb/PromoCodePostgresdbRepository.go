@@ -0,0 +1,139 @@
+package postgresdb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type postgresPromoCodeRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresPromoCodeRepository initializes a new instance of the promo code repository.
+func NewPostgresPromoCodeRepository(db *gorm.DB) repositories.PromoCodeRepositoryInterface {
+	return &postgresPromoCodeRepository{
+		db: db,
+	}
+}
+
+// CreatePromoCode stores a new promo code in PostgreSQL.
+func (r *postgresPromoCodeRepository) CreatePromoCode(ctx context.Context, promoCode *types.PromoCodeType) (*types.PromoCodeType, error) {
+	if err := r.db.WithContext(ctx).Create(promoCode).Error; err != nil {
+		return nil, err
+	}
+	return promoCode, nil
+}
+
+// FindPromoCodeByID retrieves a promo code by its ID in PostgreSQL.
+func (r *postgresPromoCodeRepository) FindPromoCodeByID(ctx context.Context, promoCodeID uuid.UUID) (*types.PromoCodeType, error) {
+	var promoCode types.PromoCodeType
+	if err := r.db.WithContext(ctx).First(&promoCode, "id = ?", promoCodeID).Error; err != nil {
+		return nil, err
+	}
+	return &promoCode, nil
+}
+
+// FindPromoCodeByCode retrieves the promo code of an event with the given code in PostgreSQL.
+func (r *postgresPromoCodeRepository) FindPromoCodeByCode(ctx context.Context, eventID uuid.UUID, code string) (*types.PromoCodeType, error) {
+	var promoCode types.PromoCodeType
+	err := r.db.WithContext(ctx).Where("event_id = ? AND code = ?", eventID, code).First(&promoCode).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &promoCode, nil
+}
+
+// FindPromoCodesByEventID retrieves every promo code of an event in PostgreSQL, ordered by code.
+func (r *postgresPromoCodeRepository) FindPromoCodesByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.PromoCodeType, error) {
+	var promoCodes []*types.PromoCodeType
+	if err := r.db.WithContext(ctx).Where("event_id = ?", eventID).Order("code").Find(&promoCodes).Error; err != nil {
+		return nil, err
+	}
+	return promoCodes, nil
+}
+
+// UpdatePromoCode stores the editable fields of a promo code in PostgreSQL.
+func (r *postgresPromoCodeRepository) UpdatePromoCode(ctx context.Context, promoCode *types.PromoCodeType) error {
+	promoCode.UpdatedAt = time.Now()
+	return r.db.WithContext(ctx).Model(&types.PromoCodeType{ID: promoCode.ID}).
+		Select("discount_type", "percent_off", "amount_off_cents", "currency", "max_redemptions", "max_redemptions_per_user",
+			"valid_from", "valid_until", "ticket_tier_ids", "is_active", "updated_at").
+		Updates(promoCode).Error
+}
+
+// DeletePromoCodeByID removes a promo code in PostgreSQL.
+func (r *postgresPromoCodeRepository) DeletePromoCodeByID(ctx context.Context, promoCodeID uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&types.PromoCodeType{}, "id = ?", promoCodeID).Error
+}
+
+// RedeemPromoCode records a redemption in PostgreSQL, locking the promo code row while its limits are checked.
+func (r *postgresPromoCodeRepository) RedeemPromoCode(ctx context.Context, redemption *types.PromoRedemptionType) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var promoCode types.PromoCodeType
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&promoCode, "id = ?", redemption.PromoCodeID).Error; err != nil {
+			return err
+		}
+		if promoCode.MaxRedemptions > 0 && promoCode.Redemptions >= promoCode.MaxRedemptions {
+			return repositories.ErrPromoCodeExhausted
+		}
+		if promoCode.MaxRedemptionsPerUser > 0 {
+			var used int64
+			err := tx.Model(&types.PromoRedemptionType{}).
+				Where("promo_code_id = ? AND user_id = ?", promoCode.ID, redemption.UserID).
+				Count(&used).Error
+			if err != nil {
+				return err
+			}
+			if used >= int64(promoCode.MaxRedemptionsPerUser) {
+				return repositories.ErrPromoCodeUserLimit
+			}
+		}
+
+		if err := tx.Create(redemption).Error; err != nil {
+			return err
+		}
+		return tx.Model(&promoCode).Updates(map[string]interface{}{
+			"redemptions": gorm.Expr("redemptions + 1"),
+			"updated_at":  time.Now(),
+		}).Error
+	})
+}
+
+// ReleasePromoRedemption removes the redemption of an order in PostgreSQL and gives it back to its promo code.
+func (r *postgresPromoCodeRepository) ReleasePromoRedemption(ctx context.Context, orderID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var redemption types.PromoRedemptionType
+		err := tx.Clauses(clause.Returning{}).Where("order_id = ?", orderID).Delete(&redemption).Error
+		if err != nil {
+			return err
+		}
+		if redemption.PromoCodeID == uuid.Nil {
+			return nil // The order used no promo code, or it was released already
+		}
+		return tx.Model(&types.PromoCodeType{}).
+			Where("id = ? AND redemptions > 0", redemption.PromoCodeID).
+			Updates(map[string]interface{}{
+				"redemptions": gorm.Expr("redemptions - 1"),
+				"updated_at":  time.Now(),
+			}).Error
+	})
+}
+
+// FindPromoRedemptionsByEventID retrieves every redemption of the promo codes of an event in PostgreSQL.
+func (r *postgresPromoCodeRepository) FindPromoRedemptionsByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.PromoRedemptionType, error) {
+	var redemptions []*types.PromoRedemptionType
+	if err := r.db.WithContext(ctx).Where("event_id = ?", eventID).Order("created_at").Find(&redemptions).Error; err != nil {
+		return nil, err
+	}
+	return redemptions, nil
+}
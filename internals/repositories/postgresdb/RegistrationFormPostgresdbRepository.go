@@ -0,0 +1,55 @@
+package postgresdb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type postgresRegistrationFormRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresRegistrationFormRepository initializes a new instance of the registration form repository.
+func NewPostgresRegistrationFormRepository(db *gorm.DB) repositories.RegistrationFormRepositoryInterface {
+	return &postgresRegistrationFormRepository{
+		db: db,
+	}
+}
+
+// FindRegistrationFormByEventID retrieves the form of an event in PostgreSQL.
+func (r *postgresRegistrationFormRepository) FindRegistrationFormByEventID(ctx context.Context, eventID uuid.UUID) (*types.RegistrationFormType, error) {
+	var form types.RegistrationFormType
+	if err := r.db.WithContext(ctx).First(&form, "event_id = ?", eventID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &form, nil
+}
+
+// SaveRegistrationForm inserts the form of an event in PostgreSQL, or replaces the fields of its existing form.
+func (r *postgresRegistrationFormRepository) SaveRegistrationForm(ctx context.Context, form *types.RegistrationFormType) (*types.RegistrationFormType, error) {
+	form.UpdatedAt = time.Now()
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "event_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"fields", "updated_at"}),
+	}).Create(form).Error
+	if err != nil {
+		return nil, err
+	}
+	// The insert may have turned into an update, so reload for the stored ID and creation time
+	return r.FindRegistrationFormByEventID(ctx, form.EventID)
+}
+
+// DeleteRegistrationFormByEventID removes the form of an event in PostgreSQL.
+func (r *postgresRegistrationFormRepository) DeleteRegistrationFormByEventID(ctx context.Context, eventID uuid.UUID) error {
+	return r.db.WithContext(ctx).Where("event_id = ?", eventID).Delete(&types.RegistrationFormType{}).Error
+}
@@ -0,0 +1,84 @@
+package postgresdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type postgresReminderRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresReminderRepository initializes a new instance of the reminder repository.
+func NewPostgresReminderRepository(db *gorm.DB) repositories.ReminderRepositoryInterface {
+	return &postgresReminderRepository{
+		db: db,
+	}
+}
+
+// CreateReminder stores a newly planned reminder in PostgreSQL.
+func (r *postgresReminderRepository) CreateReminder(ctx context.Context, reminder *types.ReminderType) (*types.ReminderType, error) {
+	if err := r.db.WithContext(ctx).Create(reminder).Error; err != nil {
+		return nil, err
+	}
+	return reminder, nil
+}
+
+// FindRemindersByEventID retrieves every reminder planned for an event in PostgreSQL.
+func (r *postgresReminderRepository) FindRemindersByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.ReminderType, error) {
+	var reminders []*types.ReminderType
+	if err := r.db.WithContext(ctx).Where("event_id = ?", eventID).Order("send_at").Find(&reminders).Error; err != nil {
+		return nil, err
+	}
+	return reminders, nil
+}
+
+// FindDueReminders retrieves pending reminders that are due in PostgreSQL, oldest first.
+func (r *postgresReminderRepository) FindDueReminders(ctx context.Context, now time.Time, limit int) ([]*types.ReminderType, error) {
+	var reminders []*types.ReminderType
+	if err := r.db.WithContext(ctx).
+		Where("status = ? AND send_at <= ?", types.ReminderStatusPending, now).
+		Order("send_at").
+		Limit(limit).
+		Find(&reminders).Error; err != nil {
+		return nil, err
+	}
+	return reminders, nil
+}
+
+// MarkGuestNotified records that a guest has been emailed for a reminder in PostgreSQL.
+func (r *postgresReminderRepository) MarkGuestNotified(ctx context.Context, reminderID, guestID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var reminder types.ReminderType
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&reminder, "id = ?", reminderID).Error; err != nil {
+			return err
+		}
+		if reminder.HasNotified(guestID) {
+			return nil
+		}
+		reminder.GuestsNotified = append(reminder.GuestsNotified, guestID)
+		return tx.Model(&reminder).Select("guests_notified", "updated_at").Updates(&reminder).Error
+	})
+}
+
+// UpdateReminderStatus moves a reminder to Sent or Skipped in PostgreSQL.
+func (r *postgresReminderRepository) UpdateReminderStatus(ctx context.Context, reminderID uuid.UUID, status string) error {
+	updates := map[string]interface{}{"status": status, "updated_at": time.Now()}
+	if status == types.ReminderStatusSent {
+		updates["sent_at"] = time.Now()
+	}
+	return r.db.WithContext(ctx).Model(&types.ReminderType{}).Where("id = ?", reminderID).Updates(updates).Error
+}
+
+// DeletePendingRemindersByEventID removes the unsent reminders of an event in PostgreSQL.
+func (r *postgresReminderRepository) DeletePendingRemindersByEventID(ctx context.Context, eventID uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Where("event_id = ? AND status = ?", eventID, types.ReminderStatusPending).
+		Delete(&types.ReminderType{}).Error
+}
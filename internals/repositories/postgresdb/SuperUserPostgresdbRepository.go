@@ -34,6 +34,16 @@ func (r *postgresSuperUserRepository) CreateSuperUser(ctx context.Context, super
 	return superUser, nil
 }
 
+// FindSuperUserByID searches for a superuser by ID.
+func (r *postgresSuperUserRepository) FindSuperUserByID(ctx context.Context, superUserID uuid.UUID) (*types.SuperUserType, error) {
+	var superUser types.SuperUserType
+	err := r.db.WithContext(ctx).Where("id = ?", superUserID).First(&superUser).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, errors.New("superuser not found")
+	}
+	return &superUser, err
+}
+
 // FindSuperUserByEmail searches for a superuser by email.
 func (r *postgresSuperUserRepository) FindSuperUserByEmail(ctx context.Context, email string) (*types.SuperUserType, error) {
 	var superUser types.SuperUserType
@@ -102,3 +112,118 @@ func (r *postgresSuperUserRepository) VerifySuperUserOTP(ctx context.Context, su
 
 	return r.db.WithContext(ctx).Save(superUser).Error
 }
+
+// ClearExpiredOTPs removes expired OTPs in PostgreSQL
+func (r *postgresSuperUserRepository) ClearExpiredOTPs(ctx context.Context, now time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&types.SuperUserType{}).
+		Where("otp IS NOT NULL AND otp_expiry < ?", now).
+		Updates(map[string]interface{}{"otp": nil, "otp_expiry": time.Time{}, "updated_at": time.Now()})
+	return result.RowsAffected, result.Error
+}
+
+// ClearExpiredResetTokens removes expired password reset tokens in PostgreSQL
+func (r *postgresSuperUserRepository) ClearExpiredResetTokens(ctx context.Context, now time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&types.SuperUserType{}).
+		Where("reset_token IS NOT NULL AND reset_token_expiry < ?", now).
+		Updates(map[string]interface{}{"reset_token": nil, "reset_token_expiry": time.Time{}, "updated_at": time.Now()})
+	return result.RowsAffected, result.Error
+}
+
+// ListSuperUsers finds the superusers matching a filter in PostgreSQL
+func (r *postgresSuperUserRepository) ListSuperUsers(ctx context.Context, filter repositories.SuperUserListFilter, offset, limit int) ([]*types.SuperUserType, int64, error) {
+	query := r.db.WithContext(ctx).Model(&types.SuperUserType{})
+	if filter.Search != "" {
+		pattern := containsPattern(filter.Search)
+		query = query.Where("username ILIKE ? OR email ILIKE ? OR full_name ILIKE ?", pattern, pattern, pattern)
+	}
+	if filter.Role != "" {
+		query = query.Where("role = ?", filter.Role)
+	}
+	if filter.IsVerified != nil {
+		query = query.Where("is_otp_verified = ?", *filter.IsVerified)
+	}
+	if filter.IsActive != nil {
+		query = query.Where("is_active = ?", *filter.IsActive)
+	}
+	if !filter.IncludeDeleted {
+		query = query.Where("deleted_at IS NULL")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var superUsers []*types.SuperUserType
+	if err := query.Order("username").Offset(offset).Limit(limit).Find(&superUsers).Error; err != nil {
+		return nil, 0, err
+	}
+	return superUsers, total, nil
+}
+
+// SetSuperUserActive activates or deactivates a superuser in PostgreSQL
+func (r *postgresSuperUserRepository) SetSuperUserActive(ctx context.Context, superUserID uuid.UUID, active bool, sessionsValidAfter time.Time) error {
+	return r.updateSuperUserColumns(ctx, superUserID, map[string]interface{}{
+		"is_active":            active,
+		"sessions_valid_after": sessionsValidAfter,
+	})
+}
+
+// UpdateSuperUserRole replaces the role and permission groups of a superuser in PostgreSQL
+func (r *postgresSuperUserRepository) UpdateSuperUserRole(ctx context.Context, superUserID uuid.UUID, role string, permissionGroups []string) error {
+	return r.updateSuperUserColumns(ctx, superUserID, map[string]interface{}{
+		"role":              role,
+		"permission_groups": permissionGroups,
+	})
+}
+
+// ForcePasswordReset stores a reset token and requires a password reset in PostgreSQL
+func (r *postgresSuperUserRepository) ForcePasswordReset(ctx context.Context, superUserID uuid.UUID, resetToken string, resetTokenExpiry time.Time, sessionsValidAfter time.Time) error {
+	return r.updateSuperUserColumns(ctx, superUserID, map[string]interface{}{
+		"reset_token":             resetToken,
+		"reset_token_expiry":      resetTokenExpiry,
+		"password_reset_required": true,
+		"sessions_valid_after":    sessionsValidAfter,
+	})
+}
+
+// SoftDeleteSuperUser marks a superuser as deleted in PostgreSQL
+func (r *postgresSuperUserRepository) SoftDeleteSuperUser(ctx context.Context, superUserID uuid.UUID, deletedAt time.Time) error {
+	return r.updateSuperUserColumns(ctx, superUserID, map[string]interface{}{
+		"deleted_at":           deletedAt,
+		"is_active":            false,
+		"sessions_valid_after": deletedAt,
+	})
+}
+
+// updateSuperUserColumns sets columns of a superuser, reporting a superuser that does not exist
+func (r *postgresSuperUserRepository) updateSuperUserColumns(ctx context.Context, superUserID uuid.UUID, columns map[string]interface{}) error {
+	columns["updated_at"] = time.Now()
+	result := r.db.WithContext(ctx).Model(&types.SuperUserType{}).Where("id = ?", superUserID).Updates(columns)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("superuser not found")
+	}
+	return nil
+}
+
+// FindSuperUserByEmailChangeToken retrieves the superuser with a pending email change from PostgreSQL
+func (r *postgresSuperUserRepository) FindSuperUserByEmailChangeToken(ctx context.Context, tokenHash string) (*types.SuperUserType, error) {
+	var superUser types.SuperUserType
+	err := r.db.WithContext(ctx).Where("email_change_token = ?", tokenHash).First(&superUser).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, errors.New("superuser not found")
+	}
+	return &superUser, err
+}
+
+// FindSuperUserByEmailUndoToken retrieves the superuser whose email change can be undone from PostgreSQL
+func (r *postgresSuperUserRepository) FindSuperUserByEmailUndoToken(ctx context.Context, tokenHash string) (*types.SuperUserType, error) {
+	var superUser types.SuperUserType
+	err := r.db.WithContext(ctx).Where("email_undo_token = ?", tokenHash).First(&superUser).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, errors.New("superuser not found")
+	}
+	return &superUser, err
+}
@@ -0,0 +1,97 @@
+package postgresdb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"gorm.io/gorm"
+)
+
+type postgresTicketRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresTicketRepository initializes a new instance of the ticket repository.
+func NewPostgresTicketRepository(db *gorm.DB) repositories.TicketRepositoryInterface {
+	return &postgresTicketRepository{
+		db: db,
+	}
+}
+
+// CreateTicket stores a newly issued ticket in PostgreSQL.
+func (r *postgresTicketRepository) CreateTicket(ctx context.Context, ticket *types.TicketType) (*types.TicketType, error) {
+	if err := r.db.WithContext(ctx).Create(ticket).Error; err != nil {
+		return nil, err
+	}
+	return ticket, nil
+}
+
+// FindTicketByID retrieves a ticket by its ID in PostgreSQL.
+func (r *postgresTicketRepository) FindTicketByID(ctx context.Context, ticketID uuid.UUID) (*types.TicketType, error) {
+	var ticket types.TicketType
+	if err := r.db.WithContext(ctx).First(&ticket, "id = ?", ticketID).Error; err != nil {
+		return nil, err
+	}
+	return &ticket, nil
+}
+
+// FindValidTicketByGuestID retrieves the valid ticket of a guest in PostgreSQL.
+func (r *postgresTicketRepository) FindValidTicketByGuestID(ctx context.Context, guestID uuid.UUID) (*types.TicketType, error) {
+	var ticket types.TicketType
+	err := r.db.WithContext(ctx).Where("guest_id = ? AND status = ?", guestID, types.TicketStatusValid).First(&ticket).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ticket, nil
+}
+
+// FindTicketsByEventID retrieves every ticket issued for an event in PostgreSQL.
+func (r *postgresTicketRepository) FindTicketsByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.TicketType, error) {
+	var tickets []*types.TicketType
+	if err := r.db.WithContext(ctx).Where("event_id = ?", eventID).Order("issued_at").Find(&tickets).Error; err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
+
+// CheckInTicket marks a valid ticket as used in PostgreSQL.
+func (r *postgresTicketRepository) CheckInTicket(ctx context.Context, ticketID, staffID uuid.UUID, checkedInAt time.Time) error {
+	return r.updateValidTicket(ctx, ticketID, map[string]interface{}{
+		"status":        types.TicketStatusUsed,
+		"checked_in_at": checkedInAt,
+		"checked_in_by": staffID,
+		"updated_at":    time.Now(),
+	})
+}
+
+// RevokeTicket marks a valid ticket as revoked in PostgreSQL.
+func (r *postgresTicketRepository) RevokeTicket(ctx context.Context, ticketID uuid.UUID, reason string) error {
+	now := time.Now()
+	return r.updateValidTicket(ctx, ticketID, map[string]interface{}{
+		"status":         types.TicketStatusRevoked,
+		"revoked_at":     now,
+		"revoked_reason": reason,
+		"updated_at":     now,
+	})
+}
+
+// updateValidTicket applies updates only while the ticket is still valid
+func (r *postgresTicketRepository) updateValidTicket(ctx context.Context, ticketID uuid.UUID, updates map[string]interface{}) error {
+	result := r.db.WithContext(ctx).Model(&types.TicketType{}).
+		Where("id = ? AND status = ?", ticketID, types.TicketStatusValid).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrTicketNotValid
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package postgresdb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"gorm.io/gorm"
+)
+
+type postgresTicketTierRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresTicketTierRepository initializes a new instance of the ticket tier repository.
+func NewPostgresTicketTierRepository(db *gorm.DB) repositories.TicketTierRepositoryInterface {
+	return &postgresTicketTierRepository{
+		db: db,
+	}
+}
+
+// CreateTicketTier stores a new ticket tier in PostgreSQL.
+func (r *postgresTicketTierRepository) CreateTicketTier(ctx context.Context, tier *types.TicketTierType) (*types.TicketTierType, error) {
+	if err := r.db.WithContext(ctx).Create(tier).Error; err != nil {
+		return nil, err
+	}
+	return tier, nil
+}
+
+// FindTicketTierByID retrieves a ticket tier by its ID in PostgreSQL.
+func (r *postgresTicketTierRepository) FindTicketTierByID(ctx context.Context, tierID uuid.UUID) (*types.TicketTierType, error) {
+	var tier types.TicketTierType
+	if err := r.db.WithContext(ctx).First(&tier, "id = ?", tierID).Error; err != nil {
+		return nil, err
+	}
+	return &tier, nil
+}
+
+// FindTicketTiersByEventID retrieves the ticket tiers of an event in PostgreSQL, cheapest first.
+func (r *postgresTicketTierRepository) FindTicketTiersByEventID(ctx context.Context, eventID uuid.UUID) ([]*types.TicketTierType, error) {
+	var tiers []*types.TicketTierType
+	if err := r.db.WithContext(ctx).Where("event_id = ?", eventID).Order("price_cents, created_at").Find(&tiers).Error; err != nil {
+		return nil, err
+	}
+	return tiers, nil
+}
+
+// UpdateTicketTier stores the editable fields of a tier in PostgreSQL, unless the new quantity is below what is sold and held.
+func (r *postgresTicketTierRepository) UpdateTicketTier(ctx context.Context, tier *types.TicketTierType) error {
+	result := r.db.WithContext(ctx).Model(&types.TicketTierType{}).
+		Where("id = ? AND sold + held <= ?", tier.ID, tier.Quantity).
+		Updates(map[string]interface{}{
+			"name":           tier.Name,
+			"description":    tier.Description,
+			"price_cents":    tier.PriceCents,
+			"currency":       tier.Currency,
+			"quantity":       tier.Quantity,
+			"sales_start_at": tier.SalesStartAt,
+			"sales_end_at":   tier.SalesEndAt,
+			"updated_at":     time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return r.missingOr(ctx, tier.ID, repositories.ErrTicketTierSoldOut)
+	}
+	return nil
+}
+
+// DeleteTicketTierByID removes a tier nobody ordered from in PostgreSQL.
+func (r *postgresTicketTierRepository) DeleteTicketTierByID(ctx context.Context, tierID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("id = ? AND sold = 0 AND held = 0", tierID).Delete(&types.TicketTierType{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return r.missingOr(ctx, tierID, repositories.ErrTicketTierInUse)
+	}
+	return nil
+}
+
+// ReserveTicketTierInventory holds tickets for a pending order in PostgreSQL with a single conditional update.
+func (r *postgresTicketTierRepository) ReserveTicketTierInventory(ctx context.Context, tierID uuid.UUID, quantity int) error {
+	result := r.db.WithContext(ctx).Model(&types.TicketTierType{}).
+		Where("id = ? AND quantity - sold - held >= ?", tierID, quantity).
+		Updates(map[string]interface{}{
+			"held":       gorm.Expr("held + ?", quantity),
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return r.missingOr(ctx, tierID, repositories.ErrTicketTierSoldOut)
+	}
+	return nil
+}
+
+// ReleaseTicketTierInventory puts held tickets back on sale in PostgreSQL.
+func (r *postgresTicketTierRepository) ReleaseTicketTierInventory(ctx context.Context, tierID uuid.UUID, quantity int) error {
+	return r.updateInventory(ctx, tierID, map[string]interface{}{
+		"held": gorm.Expr("GREATEST(held - ?, 0)", quantity),
+	})
+}
+
+// CommitTicketTierInventory turns held tickets into sold ones in PostgreSQL.
+func (r *postgresTicketTierRepository) CommitTicketTierInventory(ctx context.Context, tierID uuid.UUID, quantity int) error {
+	return r.updateInventory(ctx, tierID, map[string]interface{}{
+		"held": gorm.Expr("GREATEST(held - ?, 0)", quantity),
+		"sold": gorm.Expr("sold + ?", quantity),
+	})
+}
+
+// RestockTicketTierInventory puts sold tickets back on sale in PostgreSQL.
+func (r *postgresTicketTierRepository) RestockTicketTierInventory(ctx context.Context, tierID uuid.UUID, quantity int) error {
+	return r.updateInventory(ctx, tierID, map[string]interface{}{
+		"sold": gorm.Expr("GREATEST(sold - ?, 0)", quantity),
+	})
+}
+
+// updateInventory applies relative changes to the counters of a tier in one statement
+func (r *postgresTicketTierRepository) updateInventory(ctx context.Context, tierID uuid.UUID, updates map[string]interface{}) error {
+	updates["updated_at"] = time.Now()
+	return r.db.WithContext(ctx).Model(&types.TicketTierType{}).Where("id = ?", tierID).Updates(updates).Error
+}
+
+// missingOr tells a conditional update that matched nothing because the tier does not exist apart from one whose
+// condition failed, returning the lookup error or conditionErr
+func (r *postgresTicketTierRepository) missingOr(ctx context.Context, tierID uuid.UUID, conditionErr error) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&types.TicketTierType{}).Where("id = ?", tierID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return errors.New("ticket tier not found")
+	}
+	return conditionErr
+}
@@ -0,0 +1,132 @@
+package postgresdb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"gorm.io/gorm"
+)
+
+// Supported PostgreSQL extensions for geographic queries
+const (
+	GeoExtensionPostGIS       = "postgis"
+	GeoExtensionEarthDistance = "earthdistance"
+)
+
+type postgresVenueRepository struct {
+	db           *gorm.DB
+	geoExtension string
+}
+
+// NewPostgresVenueRepository initializes a new instance of the venue repository.
+// geoExtension selects how radius queries are built and must be one of the GeoExtension constants.
+func NewPostgresVenueRepository(db *gorm.DB, geoExtension string) repositories.VenueRepositoryInterface {
+	return &postgresVenueRepository{
+		db:           db,
+		geoExtension: geoExtension,
+	}
+}
+
+// EnableGeoExtension enables the preferred geo extension, falling back to earthdistance when PostGIS is unavailable.
+// It returns the extension that was actually enabled.
+func EnableGeoExtension(db *gorm.DB, preferred string) (string, error) {
+	if preferred == GeoExtensionPostGIS {
+		err := db.Exec("CREATE EXTENSION IF NOT EXISTS postgis").Error
+		if err == nil {
+			return GeoExtensionPostGIS, nil
+		}
+		log.Printf("PostGIS is not available, falling back to earthdistance: %v", err)
+	}
+
+	// earthdistance depends on the cube extension
+	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS cube").Error; err != nil {
+		return "", err
+	}
+	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS earthdistance").Error; err != nil {
+		return "", err
+	}
+	return GeoExtensionEarthDistance, nil
+}
+
+// CreateVenue creates a new venue record in PostgreSQL.
+func (r *postgresVenueRepository) CreateVenue(ctx context.Context, venue *types.VenueType) (*types.VenueType, error) {
+	venue.ID = uuid.New()
+	venue.CreatedAt = time.Now()
+	venue.UpdatedAt = time.Now()
+
+	if err := r.db.WithContext(ctx).Create(venue).Error; err != nil {
+		return nil, err
+	}
+	return venue, nil
+}
+
+// FindVenueByID finds a venue by its ID in PostgreSQL.
+func (r *postgresVenueRepository) FindVenueByID(ctx context.Context, venueID uuid.UUID) (*types.VenueType, error) {
+	var venue types.VenueType
+	if err := r.db.WithContext(ctx).First(&venue, "id = ?", venueID).Error; err != nil {
+		return nil, err
+	}
+	return &venue, nil
+}
+
+// UpdateVenue updates an existing venue in PostgreSQL.
+func (r *postgresVenueRepository) UpdateVenue(ctx context.Context, venue *types.VenueType) error {
+	venue.UpdatedAt = time.Now()
+	return r.db.WithContext(ctx).Save(venue).Error
+}
+
+// DeleteVenueByID deletes a venue by its ID in PostgreSQL.
+func (r *postgresVenueRepository) DeleteVenueByID(ctx context.Context, venueID uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&types.VenueType{}, "id = ?", venueID).Error
+}
+
+// FindAllVenues retrieves all venues from PostgreSQL.
+func (r *postgresVenueRepository) FindAllVenues(ctx context.Context) ([]*types.VenueType, error) {
+	var venues []*types.VenueType
+	if err := r.db.WithContext(ctx).Find(&venues).Error; err != nil {
+		return nil, err
+	}
+	return venues, nil
+}
+
+// FindVenuesWithinRadius retrieves venues within radiusMeters of a point using PostGIS or earthdistance, nearest first.
+func (r *postgresVenueRepository) FindVenuesWithinRadius(ctx context.Context, latitude, longitude, radiusMeters float64) ([]*types.VenueType, error) {
+	var venues []*types.VenueType
+	query := r.db.WithContext(ctx)
+
+	switch r.geoExtension {
+	case GeoExtensionPostGIS:
+		point := "ST_SetSRID(ST_MakePoint(?, ?), 4326)::geography"
+		venuePoint := "ST_SetSRID(ST_MakePoint(longitude, latitude), 4326)::geography"
+		query = query.
+			Where("ST_DWithin("+venuePoint+", "+point+", ?)", longitude, latitude, radiusMeters).
+			Order(gorm.Expr("ST_Distance("+venuePoint+", "+point+")", longitude, latitude))
+	default:
+		// earth_box narrows the candidates using an index-friendly cube, earth_distance trims the corners
+		query = query.
+			Where("earth_box(ll_to_earth(?, ?), ?) @> ll_to_earth(latitude, longitude)", latitude, longitude, radiusMeters).
+			Where("earth_distance(ll_to_earth(?, ?), ll_to_earth(latitude, longitude)) <= ?", latitude, longitude, radiusMeters).
+			Order(gorm.Expr("earth_distance(ll_to_earth(?, ?), ll_to_earth(latitude, longitude))", latitude, longitude))
+	}
+
+	if err := query.Find(&venues).Error; err != nil {
+		return nil, err
+	}
+	return venues, nil
+}
+
+// FindVenuesWithinBoundingBox retrieves venues whose coordinates fall inside the given box.
+func (r *postgresVenueRepository) FindVenuesWithinBoundingBox(ctx context.Context, minLatitude, minLongitude, maxLatitude, maxLongitude float64) ([]*types.VenueType, error) {
+	var venues []*types.VenueType
+	if err := r.db.WithContext(ctx).
+		Where("latitude BETWEEN ? AND ?", minLatitude, maxLatitude).
+		Where("longitude BETWEEN ? AND ?", minLongitude, maxLongitude).
+		Find(&venues).Error; err != nil {
+		return nil, err
+	}
+	return venues, nil
+}
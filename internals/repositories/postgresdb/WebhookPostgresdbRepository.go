@@ -0,0 +1,148 @@
+package postgresdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"gorm.io/gorm"
+)
+
+// dueWebhookDeliveryCondition matches pending deliveries whose next attempt is due
+const dueWebhookDeliveryCondition = "status = '" + types.WebhookDeliveryStatusPending + "' AND next_attempt_at <= ?"
+
+type postgresWebhookRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresWebhookRepository initializes a new instance of the webhook repository.
+func NewPostgresWebhookRepository(db *gorm.DB) repositories.WebhookRepositoryInterface {
+	return &postgresWebhookRepository{
+		db: db,
+	}
+}
+
+// CreateWebhook stores a new webhook in PostgreSQL.
+func (r *postgresWebhookRepository) CreateWebhook(ctx context.Context, webhook *types.WebhookType) (*types.WebhookType, error) {
+	if err := r.db.WithContext(ctx).Create(webhook).Error; err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// FindWebhookByID retrieves a webhook by its ID in PostgreSQL.
+func (r *postgresWebhookRepository) FindWebhookByID(ctx context.Context, webhookID uuid.UUID) (*types.WebhookType, error) {
+	var webhook types.WebhookType
+	if err := r.db.WithContext(ctx).First(&webhook, "id = ?", webhookID).Error; err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// FindWebhooksByOrganizerID retrieves every webhook of an organizer in PostgreSQL.
+func (r *postgresWebhookRepository) FindWebhooksByOrganizerID(ctx context.Context, organizerID uuid.UUID) ([]*types.WebhookType, error) {
+	var webhooks []*types.WebhookType
+	if err := r.db.WithContext(ctx).Where("organizer_id = ?", organizerID).Order("created_at").Find(&webhooks).Error; err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// FindActiveWebhooksByEventType retrieves the active webhooks of an organizer subscribed to an event type in PostgreSQL.
+func (r *postgresWebhookRepository) FindActiveWebhooksByEventType(ctx context.Context, organizerID uuid.UUID, eventType string) ([]*types.WebhookType, error) {
+	var webhooks []*types.WebhookType
+	err := r.db.WithContext(ctx).
+		Where("organizer_id = ? AND is_active = true", organizerID).
+		Where("EXISTS (SELECT 1 FROM jsonb_array_elements_text(event_types) AS subscribed(event_type) WHERE subscribed.event_type = ?)", eventType).
+		Order("created_at").
+		Find(&webhooks).Error
+	if err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// UpdateWebhook stores the editable fields of a webhook in PostgreSQL.
+func (r *postgresWebhookRepository) UpdateWebhook(ctx context.Context, webhook *types.WebhookType) error {
+	return r.db.WithContext(ctx).Model(webhook).
+		Select("url", "event_types", "description", "is_active", "updated_at").
+		Updates(webhook).Error
+}
+
+// DeleteWebhookByID removes a webhook and its deliveries in PostgreSQL.
+func (r *postgresWebhookRepository) DeleteWebhookByID(ctx context.Context, webhookID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("webhook_id = ?", webhookID).Delete(&types.WebhookDeliveryType{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&types.WebhookType{}, "id = ?", webhookID).Error
+	})
+}
+
+// CreateWebhookDelivery stores a new delivery in PostgreSQL.
+func (r *postgresWebhookRepository) CreateWebhookDelivery(ctx context.Context, delivery *types.WebhookDeliveryType) (*types.WebhookDeliveryType, error) {
+	if err := r.db.WithContext(ctx).Create(delivery).Error; err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// FindWebhookDeliveryByID retrieves a delivery by its ID in PostgreSQL.
+func (r *postgresWebhookRepository) FindWebhookDeliveryByID(ctx context.Context, deliveryID uuid.UUID) (*types.WebhookDeliveryType, error) {
+	var delivery types.WebhookDeliveryType
+	if err := r.db.WithContext(ctx).First(&delivery, "id = ?", deliveryID).Error; err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// FindWebhookDeliveriesByWebhookID retrieves the latest deliveries of a webhook in PostgreSQL.
+func (r *postgresWebhookRepository) FindWebhookDeliveriesByWebhookID(ctx context.Context, webhookID uuid.UUID, limit int) ([]*types.WebhookDeliveryType, error) {
+	var deliveries []*types.WebhookDeliveryType
+	if err := r.db.WithContext(ctx).Where("webhook_id = ?", webhookID).Order("created_at DESC").Limit(limit).Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// FindDueWebhookDeliveries retrieves pending deliveries that are due in PostgreSQL.
+func (r *postgresWebhookRepository) FindDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]*types.WebhookDeliveryType, error) {
+	var deliveries []*types.WebhookDeliveryType
+	if err := r.db.WithContext(ctx).Where(dueWebhookDeliveryCondition, now).Order("next_attempt_at").Limit(limit).Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// AcquireWebhookDelivery pushes back the next attempt of a due delivery in PostgreSQL with a single conditional update.
+func (r *postgresWebhookRepository) AcquireWebhookDelivery(ctx context.Context, deliveryID uuid.UUID, now, lockUntil time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&types.WebhookDeliveryType{}).
+		Where("id = ?", deliveryID).
+		Where(dueWebhookDeliveryCondition, now).
+		Updates(map[string]interface{}{
+			"next_attempt_at": lockUntil,
+			"updated_at":      time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// UpdateWebhookDelivery stores the outcome of a delivery attempt in PostgreSQL.
+func (r *postgresWebhookRepository) UpdateWebhookDelivery(ctx context.Context, delivery *types.WebhookDeliveryType) error {
+	return r.db.WithContext(ctx).Model(&types.WebhookDeliveryType{}).
+		Where("id = ?", delivery.ID).
+		Updates(map[string]interface{}{
+			"status":          delivery.Status,
+			"attempts":        delivery.Attempts,
+			"next_attempt_at": delivery.NextAttemptAt,
+			"last_attempt_at": delivery.LastAttemptAt,
+			"response_status": delivery.ResponseStatus,
+			"response_body":   delivery.ResponseBody,
+			"last_error":      delivery.LastError,
+			"updated_at":      time.Now(),
+		}).Error
+}
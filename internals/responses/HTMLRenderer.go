@@ -0,0 +1,162 @@
+package responses
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"log"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HTMLRendererContextKey is the gin context key the HTML renderer of a request is stored under
+const HTMLRendererContextKey = "HTMLRenderer"
+
+// ErrorPage is the page failed requests are shown with in a browser
+const ErrorPage = "error"
+
+// contentBlock is the template every page defines with its body; HTMX requests get only this fragment
+const contentBlock = "content"
+
+// HTMLRenderer renders a StandardResponse as HTML with html/template. The template directory holds:
+//
+//	layouts/*.html   wrap every page; one of them defines "layout", which calls {{template "content" .}}
+//	partials/*.html  fragments shared between pages
+//	pages/**.html    one file per page defining "content" (and "title"), named by its path, e.g. "events/detail"
+//
+// Pages are executed with the StandardResponse, so they can show its data, message, error and request ID.
+type HTMLRenderer struct {
+	pages map[string]*template.Template
+}
+
+// NewHTMLRenderer parses the layouts, partials and pages under templatePath
+func NewHTMLRenderer(templatePath string) (*HTMLRenderer, error) {
+	var shared []string
+	for _, dir := range []string{"layouts", "partials"} {
+		files, err := filepath.Glob(filepath.Join(templatePath, dir, "*.html"))
+		if err != nil {
+			return nil, err
+		}
+		shared = append(shared, files...)
+	}
+	if len(shared) == 0 {
+		return nil, fmt.Errorf("no layouts found under %s", templatePath)
+	}
+	base, err := template.New("").Funcs(htmlTemplateFuncs).ParseFiles(shared...)
+	if err != nil {
+		return nil, err
+	}
+
+	renderer := &HTMLRenderer{pages: make(map[string]*template.Template)}
+	pagesDir := filepath.Join(templatePath, "pages")
+	err = filepath.WalkDir(pagesDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || filepath.Ext(path) != ".html" {
+			return err
+		}
+		page, err := base.Clone()
+		if err != nil {
+			return err
+		}
+		if page, err = page.ParseFiles(path); err != nil {
+			return err
+		}
+		if page.Lookup(contentBlock) == nil {
+			return fmt.Errorf("page %s does not define %q", path, contentBlock)
+		}
+		name, _ := filepath.Rel(pagesDir, strings.TrimSuffix(path, ".html"))
+		renderer.pages[filepath.ToSlash(name)] = page
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return renderer, nil
+}
+
+// HasPage reports whether a page with the given name was loaded
+func (r *HTMLRenderer) HasPage(name string) bool {
+	_, ok := r.pages[name]
+	return ok
+}
+
+// RespondGin writes a response in the format the client asked for: JSON for API clients, the page in its layout
+// for browsers, and only the fragment HTMX swaps in for HTMX requests. The fragment is the page's block named by
+// the HX-Target header when the page defines one, its content otherwise. JSON is written when no renderer is
+// installed or the page does not exist, so handlers can switch to RespondGin before their pages are written.
+func RespondGin(c *gin.Context, page string, response StandardResponse) {
+	c.Header("Vary", "Accept, HX-Request")
+	value, _ := c.Get(HTMLRendererContextKey)
+	renderer, ok := value.(*HTMLRenderer)
+	if !ok || !renderer.HasPage(page) || !wantsHTML(c) {
+		c.JSON(response.Status, response)
+		return
+	}
+
+	tmpl := renderer.pages[page]
+	name := "layout"
+	if isHTMXFragmentRequest(c) {
+		name = contentBlock
+		if target := c.GetHeader("HX-Target"); target != "" && target != "layout" && tmpl.Lookup(target) != nil {
+			name = target
+		}
+		// HTMX does not swap in error responses, so the message travels in an event the page can listen for
+		if trigger, err := json.Marshal(map[string]StandardResponse{"eventurego:response": withoutData(response)}); err == nil {
+			c.Header("HX-Trigger", string(trigger))
+		}
+	}
+
+	// Render fully first, so a template error never sends half a page
+	var body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&body, name, response); err != nil {
+		log.Printf("Failed to render page %s: %v", page, err)
+		c.String(http.StatusInternalServerError, "Internal server error")
+		return
+	}
+	c.Data(response.Status, "text/html; charset=utf-8", body.Bytes())
+}
+
+// RespondGinError writes a failed response, shown on the error page in a browser
+func RespondGinError(c *gin.Context, status int, message string, err interface{}) {
+	RespondGin(c, ErrorPage, NewGinResponse(c, status, message, nil, err))
+}
+
+// wantsHTML reports whether the client prefers HTML over JSON; clients that accept anything get JSON
+func wantsHTML(c *gin.Context) bool {
+	if c.GetHeader("HX-Request") == "true" {
+		return true
+	}
+	return c.NegotiateFormat(gin.MIMEJSON, gin.MIMEHTML) == gin.MIMEHTML
+}
+
+// isHTMXFragmentRequest reports whether HTMX asked for part of a page. Boosted links and history restores
+// replace the whole page, so they get the layout too.
+func isHTMXFragmentRequest(c *gin.Context) bool {
+	return c.GetHeader("HX-Request") == "true" &&
+		c.GetHeader("HX-Boosted") != "true" &&
+		c.GetHeader("HX-History-Restore-Request") != "true"
+}
+
+// withoutData copies a response without its data, which can be large, for a response header
+func withoutData(response StandardResponse) StandardResponse {
+	response.Data = nil
+	return response
+}
+
+// htmlTemplateFuncs are the helpers available to every page
+var htmlTemplateFuncs = template.FuncMap{
+	// formatTime renders a time for people in its own location, e.g. "Mon, Jan 2, 2006 15:04 MST"
+	"formatTime": func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format("Mon, Jan 2, 2006 15:04 MST")
+	},
+	// statusText names an HTTP status, e.g. "Not Found"
+	"statusText": http.StatusText,
+}
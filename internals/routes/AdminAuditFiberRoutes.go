@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+// SetupAdminAuditFiberRoutes sets up the audit log API in Fiber, for administrators only
+func SetupAdminAuditFiberRoutes(
+	app *fiber.App,
+	handler *handlers.AdminAuditFiberHandler,
+	tokenManager gophertoken.TokenManager,
+	superUserService services.SuperUserServiceInterface,
+) {
+	// Authenticated routes group; the role is checked against the database
+	adminAuditRoutes := app.Group(
+		"/admin/api/audit",
+		middlewares.AuthTokenFiberMiddleware(tokenManager),
+		middlewares.RequireRoleFiberMiddleware(superUserService, "SuperUser", "Admin"),
+	)
+
+	adminAuditRoutes.Get("", handler.ListAuditRecordsHandler)
+	adminAuditRoutes.Get("/verify", handler.VerifyAuditChainHandler)
+}
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+func SetupAdminAuditGinRoutes(
+	router *gin.Engine,
+	adminAuditGinHandler *handlers.AdminAuditGinHandler,
+	tokenManager gophertoken.TokenManager,
+	superUserService services.SuperUserServiceInterface,
+) {
+	// Audit log API, for administrators only
+	adminAuditRoutes := router.Group("/admin/api/audit")
+	adminAuditRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager))                             // Middleware to protect routes
+	adminAuditRoutes.Use(middlewares.RequireRoleGinMiddleware(superUserService, "SuperUser", "Admin")) // Role checked against the database
+	{
+		adminAuditRoutes.GET("", adminAuditGinHandler.ListAuditRecordsHandler)
+		adminAuditRoutes.GET("/verify", adminAuditGinHandler.VerifyAuditChainHandler)
+	}
+}
@@ -0,0 +1,44 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+func SetupAdminConsoleGinRoutes(
+	router *gin.Engine,
+	adminConsoleGinHandler *handlers.AdminConsoleGinHandler,
+	tokenManager gophertoken.TokenManager,
+	superUserService services.SuperUserServiceInterface,
+) {
+	// Console login, open to everyone
+	router.GET("/admin/login", adminConsoleGinHandler.LogInPageHandler)
+	router.POST("/admin/login", adminConsoleGinHandler.LogInHandler)
+
+	// Server-rendered admin console
+	adminConsoleRoutes := router.Group("/admin")
+	adminConsoleRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager))                             // Middleware to protect routes
+	adminConsoleRoutes.Use(middlewares.RequireRoleGinMiddleware(superUserService, "SuperUser", "Admin")) // Role checked against the database
+	{
+		adminConsoleRoutes.GET("", func(c *gin.Context) { c.Redirect(http.StatusFound, "/admin/superusers") })
+		adminConsoleRoutes.POST("/logout", adminConsoleGinHandler.LogOutHandler)
+
+		adminConsoleRoutes.GET("/superusers", adminConsoleGinHandler.ListSuperUsersHandler)
+		adminConsoleRoutes.GET("/superusers/:id", adminConsoleGinHandler.GetSuperUserHandler)
+		adminConsoleRoutes.POST("/superusers/:id/active", adminConsoleGinHandler.SetSuperUserActiveHandler)
+		adminConsoleRoutes.POST("/superusers/:id/role", adminConsoleGinHandler.ChangeSuperUserRoleHandler)
+		adminConsoleRoutes.POST("/superusers/:id/verification", adminConsoleGinHandler.ResendVerificationEmailHandler)
+		adminConsoleRoutes.POST("/superusers/:id/password-reset", adminConsoleGinHandler.ForcePasswordResetHandler)
+		adminConsoleRoutes.POST("/superusers/:id/delete", adminConsoleGinHandler.DeleteSuperUserHandler)
+
+		adminConsoleRoutes.GET("/events", adminConsoleGinHandler.ListEventsHandler)
+		adminConsoleRoutes.GET("/events/:id", adminConsoleGinHandler.GetEventGuestsHandler)
+
+		adminConsoleRoutes.GET("/audit", adminConsoleGinHandler.ListAuditRecordsHandler)
+	}
+}
@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+// SetupAdminSuperUserFiberRoutes sets up the superuser management API in Fiber, for administrators only
+func SetupAdminSuperUserFiberRoutes(
+	app *fiber.App,
+	handler *handlers.AdminSuperUserFiberHandler,
+	tokenManager gophertoken.TokenManager,
+	superUserService services.SuperUserServiceInterface,
+) {
+	// Authenticated routes group; the role is checked against the database
+	adminSuperUserRoutes := app.Group(
+		"/admin/api/superusers",
+		middlewares.AuthTokenFiberMiddleware(tokenManager),
+		middlewares.RequireRoleFiberMiddleware(superUserService, "SuperUser", "Admin"),
+	)
+
+	adminSuperUserRoutes.Get("", handler.ListSuperUsersHandler)
+	adminSuperUserRoutes.Get("/:id", handler.GetSuperUserHandler)
+	adminSuperUserRoutes.Put("/:id/active", handler.SetSuperUserActiveHandler)
+	adminSuperUserRoutes.Put("/:id/role", handler.ChangeSuperUserRoleHandler)
+	adminSuperUserRoutes.Post("/:id/password-reset", handler.ForcePasswordResetHandler)
+	adminSuperUserRoutes.Post("/:id/verification", handler.ResendVerificationEmailHandler)
+	adminSuperUserRoutes.Delete("/:id", handler.DeleteSuperUserHandler)
+}
@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+func SetupAdminSuperUserGinRoutes(
+	router *gin.Engine,
+	adminSuperUserGinHandler *handlers.AdminSuperUserGinHandler,
+	tokenManager gophertoken.TokenManager,
+	superUserService services.SuperUserServiceInterface,
+) {
+	// Superuser management API, for administrators only
+	adminSuperUserRoutes := router.Group("/admin/api/superusers")
+	adminSuperUserRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager))                             // Middleware to protect routes
+	adminSuperUserRoutes.Use(middlewares.RequireRoleGinMiddleware(superUserService, "SuperUser", "Admin")) // Role checked against the database
+	{
+		adminSuperUserRoutes.GET("", adminSuperUserGinHandler.ListSuperUsersHandler)
+		adminSuperUserRoutes.GET("/:id", adminSuperUserGinHandler.GetSuperUserHandler)
+		adminSuperUserRoutes.PUT("/:id/active", adminSuperUserGinHandler.SetSuperUserActiveHandler)
+		adminSuperUserRoutes.PUT("/:id/role", adminSuperUserGinHandler.ChangeSuperUserRoleHandler)
+		adminSuperUserRoutes.POST("/:id/password-reset", adminSuperUserGinHandler.ForcePasswordResetHandler)
+		adminSuperUserRoutes.POST("/:id/verification", adminSuperUserGinHandler.ResendVerificationEmailHandler)
+		adminSuperUserRoutes.DELETE("/:id", adminSuperUserGinHandler.DeleteSuperUserHandler)
+	}
+}
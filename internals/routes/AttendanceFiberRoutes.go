@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+// SetupAttendanceFiberRoutes sets up the live attendance routes in Fiber
+func SetupAttendanceFiberRoutes(
+	app *fiber.App,
+	handler *handlers.AttendanceFiberHandler,
+	tokenManager gophertoken.TokenManager,
+) {
+	// Authenticated routes group, protected by the AuthTokenFiberMiddleware
+	attendanceRoutes := app.Group("/event/:id/attendance", middlewares.AuthTokenFiberMiddleware(tokenManager))
+
+	attendanceRoutes.Get("", handler.GetAttendanceHandler)
+	attendanceRoutes.Get("/stream", handler.StreamAttendanceHandler)
+}
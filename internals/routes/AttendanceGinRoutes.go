@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+func SetupAttendanceGinRoutes(
+	router *gin.Engine,
+	attendanceGinHandler *handlers.AttendanceGinHandler,
+	tokenManager gophertoken.TokenManager,
+	organizationService services.OrganizationServiceInterface,
+) {
+	// Live attendance routes, nested under their event
+	protectedAttendanceRoutes := router.Group("/event/:id/attendance")
+	protectedAttendanceRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager))          // Middleware to protect routes
+	protectedAttendanceRoutes.Use(middlewares.TenantScopeGinMiddleware(organizationService)) // Scope queries to the active organization
+	{
+		protectedAttendanceRoutes.GET("", attendanceGinHandler.GetAttendanceHandler)
+		protectedAttendanceRoutes.GET("/stream", attendanceGinHandler.StreamAttendanceHandler)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/lordofthemind/EventureGo/internals/handlers"
 	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/EventureGo/internals/services"
 	"github.com/lordofthemind/mygopher/gophertoken"
 )
 
@@ -11,11 +12,22 @@ func SetupEventGinRoutes(
 	router *gin.Engine,
 	eventGinHandler *handlers.EventGinHandler,
 	tokenManager gophertoken.TokenManager,
+	organizationService services.OrganizationServiceInterface,
 ) {
 	// Event routes for protected actions
 	protectedEventRoutes := router.Group("/event")
-	protectedEventRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager)) // Middleware to protect routes
+	protectedEventRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager))          // Middleware to protect routes
+	protectedEventRoutes.Use(middlewares.TenantScopeGinMiddleware(organizationService)) // Scope queries to the active organization
 	{
 		protectedEventRoutes.POST("/register", eventGinHandler.CreateEventHandler)
+		protectedEventRoutes.GET("/nearby", eventGinHandler.FindEventsNearHandler)
+		protectedEventRoutes.GET("/within", eventGinHandler.FindEventsWithinBoundsHandler)
+		protectedEventRoutes.GET("/upcoming", eventGinHandler.FindUpcomingEventsHandler)
+		protectedEventRoutes.GET("/:id", eventGinHandler.GetEventHandler)
+		protectedEventRoutes.GET("/:id/occurrences", eventGinHandler.GetEventOccurrencesHandler)
+		protectedEventRoutes.GET("/:id/ics", eventGinHandler.GetEventICSHandler)
+		protectedEventRoutes.PUT("/:id/reschedule", eventGinHandler.RescheduleEventHandler)
+		protectedEventRoutes.PUT("/:id/status", eventGinHandler.ChangeEventStatusHandler)
+		protectedEventRoutes.PUT("/:id/slug", eventGinHandler.ChangeEventSlugHandler)
 	}
 }
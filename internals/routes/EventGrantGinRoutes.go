@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+func SetupEventGrantGinRoutes(
+	router *gin.Engine,
+	eventGrantGinHandler *handlers.EventGrantGinHandler,
+	tokenManager gophertoken.TokenManager,
+	organizationService services.OrganizationServiceInterface,
+) {
+	// Routes for sharing an event with co-organizers, editors and check-in staff, nested under their event
+	protectedEventGrantRoutes := router.Group("/event/:id")
+	protectedEventGrantRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager))          // Middleware to protect routes
+	protectedEventGrantRoutes.Use(middlewares.TenantScopeGinMiddleware(organizationService)) // Scope queries to the active organization
+	{
+		protectedEventGrantRoutes.GET("/grants", eventGrantGinHandler.ListEventGrantsHandler)
+		protectedEventGrantRoutes.POST("/grants", eventGrantGinHandler.GrantEventAccessHandler)
+		protectedEventGrantRoutes.PUT("/grants/:userId", eventGrantGinHandler.ChangeEventGrantRoleHandler)
+		protectedEventGrantRoutes.DELETE("/grants/:userId", eventGrantGinHandler.RevokeEventAccessHandler)
+		protectedEventGrantRoutes.PUT("/organizer", eventGrantGinHandler.TransferEventHandler)
+	}
+}
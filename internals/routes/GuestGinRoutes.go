@@ -0,0 +1,26 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+func SetupGuestGinRoutes(
+	router *gin.Engine,
+	guestGinHandler *handlers.GuestGinHandler,
+	tokenManager gophertoken.TokenManager,
+	organizationService services.OrganizationServiceInterface,
+) {
+	// Guest list routes for protected actions, nested under their event
+	protectedGuestRoutes := router.Group("/event/:id/guests")
+	protectedGuestRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager))          // Middleware to protect routes
+	protectedGuestRoutes.Use(middlewares.TenantScopeGinMiddleware(organizationService)) // Scope queries to the active organization
+	{
+		protectedGuestRoutes.POST("/import", guestGinHandler.ImportGuestsHandler)
+		protectedGuestRoutes.GET("/export", guestGinHandler.ExportGuestsHandler)
+		protectedGuestRoutes.PUT("/:guestId/rsvp", guestGinHandler.UpdateRSVPHandler)
+	}
+}
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+func SetupJobGinRoutes(
+	router *gin.Engine,
+	jobGinHandler *handlers.JobGinHandler,
+	tokenManager gophertoken.TokenManager,
+	superUserService services.SuperUserServiceInterface,
+) {
+	// Admin routes for inspecting background jobs
+	adminJobRoutes := router.Group("/admin/jobs")
+	adminJobRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager))                             // Middleware to protect routes
+	adminJobRoutes.Use(middlewares.RequireRoleGinMiddleware(superUserService, "SuperUser", "Admin")) // Role checked against the database
+	{
+		adminJobRoutes.GET("", jobGinHandler.ListJobsHandler)
+		adminJobRoutes.GET("/runs", jobGinHandler.ListJobRunsHandler)
+	}
+}
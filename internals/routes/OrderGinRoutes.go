@@ -0,0 +1,37 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+func SetupOrderGinRoutes(
+	router *gin.Engine,
+	orderGinHandler *handlers.OrderGinHandler,
+	tokenManager gophertoken.TokenManager,
+	organizationService services.OrganizationServiceInterface,
+) {
+	// Buyers order tickets of the events of every tenant; only the organizers' views are scoped
+	tenantScope := middlewares.TenantScopeGinMiddleware(organizationService)
+
+	// Placing orders and listing them is scoped to an event
+	protectedEventOrderRoutes := router.Group("/event/:id/orders")
+	protectedEventOrderRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager)) // Middleware to protect routes
+	{
+		protectedEventOrderRoutes.POST("", orderGinHandler.CreateOrderHandler)
+		protectedEventOrderRoutes.GET("", tenantScope, orderGinHandler.ListEventOrdersHandler)
+	}
+
+	// Order routes for the buyer, and refunds for the organizer
+	protectedOrderRoutes := router.Group("/orders")
+	protectedOrderRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager)) // Middleware to protect routes
+	{
+		protectedOrderRoutes.GET("/:id", orderGinHandler.GetOrderHandler)
+		protectedOrderRoutes.POST("/:id/confirm", orderGinHandler.ConfirmOrderHandler)
+		protectedOrderRoutes.POST("/:id/cancel", orderGinHandler.CancelOrderHandler)
+		protectedOrderRoutes.POST("/:id/refund", tenantScope, orderGinHandler.RefundOrderHandler)
+	}
+}
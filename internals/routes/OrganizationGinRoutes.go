@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+func SetupOrganizationGinRoutes(
+	router *gin.Engine,
+	organizationGinHandler *handlers.OrganizationGinHandler,
+	tokenManager gophertoken.TokenManager,
+) {
+	// Organization routes, members manage their teams and choose which one they work in
+	protectedOrganizationRoutes := router.Group("/organizations")
+	protectedOrganizationRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager)) // Middleware to protect routes
+	{
+		protectedOrganizationRoutes.POST("", organizationGinHandler.CreateOrganizationHandler)
+		protectedOrganizationRoutes.GET("", organizationGinHandler.ListOrganizationsHandler)
+		protectedOrganizationRoutes.PUT("/active", organizationGinHandler.SwitchActiveOrganizationHandler)
+		protectedOrganizationRoutes.GET("/invitations/accept", organizationGinHandler.AcceptInvitationHandler)
+		protectedOrganizationRoutes.GET("/:id", organizationGinHandler.GetOrganizationHandler)
+		protectedOrganizationRoutes.PUT("/:id/members/:userId/role", organizationGinHandler.ChangeMemberRoleHandler)
+		protectedOrganizationRoutes.DELETE("/:id/members/:userId", organizationGinHandler.RemoveMemberHandler)
+		protectedOrganizationRoutes.POST("/:id/invitations", organizationGinHandler.InviteMemberHandler)
+		protectedOrganizationRoutes.GET("/:id/invitations", organizationGinHandler.ListInvitationsHandler)
+		protectedOrganizationRoutes.DELETE("/:id/invitations/:invitationId", organizationGinHandler.RevokeInvitationHandler)
+	}
+}
@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// SetupPasswordlessLoginFiberRoutes sets up the routes for logging in with an emailed link or code in Fiber
+func SetupPasswordlessLoginFiberRoutes(
+	app *fiber.App,
+	handler *handlers.PasswordlessLoginFiberHandler,
+) {
+	// Requesting login emails and guessing codes are both limited per client IP
+	passwordlessRateLimit := middlewares.RateLimitFiberMiddleware(configs.PasswordlessRateLimit, configs.PasswordlessRateWindow)
+
+	// Public routes; the nonce cookie is scoped to this path
+	passwordlessRoutes := app.Group(utils.PasswordlessLoginPath)
+	passwordlessRoutes.Post("", passwordlessRateLimit, handler.RequestLoginHandler)
+	passwordlessRoutes.Get("/verify", handler.LogInWithLinkHandler)
+	passwordlessRoutes.Post("/code", passwordlessRateLimit, handler.LogInWithCodeHandler)
+}
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+func SetupPasswordlessLoginGinRoutes(
+	router *gin.Engine,
+	passwordlessLoginHandler *handlers.PasswordlessLoginGinHandler,
+) {
+	// Requesting login emails and guessing codes are both limited per client IP
+	passwordlessRateLimit := middlewares.RateLimitGinMiddleware(configs.PasswordlessRateLimit, configs.PasswordlessRateWindow)
+
+	// Public routes; the nonce cookie is scoped to this path
+	passwordlessRoutes := router.Group(utils.PasswordlessLoginPath)
+	{
+		passwordlessRoutes.POST("", passwordlessRateLimit, passwordlessLoginHandler.RequestLoginHandler)
+		passwordlessRoutes.GET("/verify", passwordlessLoginHandler.LogInWithLinkHandler)
+		passwordlessRoutes.POST("/code", passwordlessRateLimit, passwordlessLoginHandler.LogInWithCodeHandler)
+	}
+}
@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+func SetupPromoCodeGinRoutes(
+	router *gin.Engine,
+	promoCodeGinHandler *handlers.PromoCodeGinHandler,
+	tokenManager gophertoken.TokenManager,
+	organizationService services.OrganizationServiceInterface,
+) {
+	// Promo code routes, scoped to an event; only its organizer can see and change them
+	protectedPromoCodeRoutes := router.Group("/event/:id/promocodes")
+	protectedPromoCodeRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager))          // Middleware to protect routes
+	protectedPromoCodeRoutes.Use(middlewares.TenantScopeGinMiddleware(organizationService)) // Scope queries to the active organization
+	{
+		protectedPromoCodeRoutes.POST("", promoCodeGinHandler.CreatePromoCodeHandler)
+		protectedPromoCodeRoutes.GET("", promoCodeGinHandler.ListPromoCodesHandler)
+		protectedPromoCodeRoutes.GET("/report", promoCodeGinHandler.PromoCodeUsageReportHandler)
+		protectedPromoCodeRoutes.PUT("/:codeId", promoCodeGinHandler.UpdatePromoCodeHandler)
+		protectedPromoCodeRoutes.DELETE("/:codeId", promoCodeGinHandler.DeletePromoCodeHandler)
+	}
+}
@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+)
+
+func SetupPublicEventGinRoutes(
+	router *gin.Engine,
+	publicEventGinHandler *handlers.PublicEventGinHandler,
+) {
+	// Registering through the API or the page counts against the same limit
+	registrationRateLimit := middlewares.RateLimitGinMiddleware(configs.RegistrationRateLimit, configs.RegistrationRateWindow)
+
+	// Public event API, no account needed
+	publicEventRoutes := router.Group("/public/events/:slug")
+	{
+		publicEventRoutes.GET("", publicEventGinHandler.GetPublicEventHandler)
+		publicEventRoutes.POST("/register", registrationRateLimit, publicEventGinHandler.RegisterForEventHandler)
+		publicEventRoutes.POST("/confirm", publicEventGinHandler.ConfirmRegistrationHandler)
+	}
+
+	// Server-rendered public event pages
+	publicEventPages := router.Group("/e/:slug")
+	{
+		publicEventPages.GET("", publicEventGinHandler.EventPageHandler)
+		publicEventPages.POST("/register", registrationRateLimit, publicEventGinHandler.RegisterPageHandler)
+		publicEventPages.GET("/confirm", publicEventGinHandler.ConfirmRegistrationPageHandler)
+	}
+}
@@ -0,0 +1,26 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+func SetupRegistrationFormGinRoutes(
+	router *gin.Engine,
+	registrationFormGinHandler *handlers.RegistrationFormGinHandler,
+	tokenManager gophertoken.TokenManager,
+	organizationService services.OrganizationServiceInterface,
+) {
+	// Registration form routes, one form per event
+	protectedRegistrationFormRoutes := router.Group("/event/:id/form")
+	protectedRegistrationFormRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager))          // Middleware to protect routes
+	protectedRegistrationFormRoutes.Use(middlewares.TenantScopeGinMiddleware(organizationService)) // Scope queries to the active organization
+	{
+		protectedRegistrationFormRoutes.GET("", registrationFormGinHandler.GetRegistrationFormHandler)
+		protectedRegistrationFormRoutes.PUT("", registrationFormGinHandler.SaveRegistrationFormHandler)
+		protectedRegistrationFormRoutes.DELETE("", registrationFormGinHandler.DeleteRegistrationFormHandler)
+	}
+}
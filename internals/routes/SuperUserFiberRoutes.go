@@ -17,12 +17,18 @@ func SetupSuperUserFiberRoutes(
 	app.Post("/superusers/register", handler.RegisterSuperUserHandler)
 	app.Get("/superusers/verify", handler.VerifySuperUserHandler)
 	app.Post("/superusers/login", handler.LogInSuperUserHandler)
+	// Links emailed during an email change; the token identifies the superuser
+	app.Get("/superusers/email/confirm", handler.ConfirmEmailChangeHandler)
+	app.Get("/superusers/email/undo", handler.UndoEmailChangeHandler)
 
 	// Authenticated routes group, protected by the AuthTokenFiberMiddleware
 	authRoutes := app.Group("/superusers", middlewares.AuthTokenFiberMiddleware(tokenManager))
 
 	// Authenticated actions (e.g., logout)
 	authRoutes.Get("/logout", handler.LogOutSuperUserHandler)
+	authRoutes.Put("/password", handler.ChangePasswordHandler)
+	authRoutes.Put("/profile", handler.UpdateProfileHandler)
+	authRoutes.Post("/email", handler.ChangeEmailHandler)
 	// Add more authenticated routes here as needed
 
 	// Password reset routes
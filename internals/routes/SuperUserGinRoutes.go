@@ -16,12 +16,18 @@ func SetupSuperUserGinRoutes(
 	router.POST("/superusers/register", superUserHandler.RegisterSuperUserHandler)
 	router.GET("/superusers/verify", superUserHandler.VerifySuperUserHandler)
 	router.POST("/superusers/login", superUserHandler.LogInSuperUserHandler)
+	// Links emailed during an email change; the token identifies the superuser
+	router.GET("/superusers/email/confirm", superUserHandler.ConfirmEmailChangeHandler)
+	router.GET("/superusers/email/undo", superUserHandler.UndoEmailChangeHandler)
 
 	// Auth routes for protected actions
 	authRoutes := router.Group("/superusers")
 	authRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager)) // Middleware to protect routes
 	{
 		authRoutes.GET("/logout", superUserHandler.LogOutSuperUserHandler)
+		authRoutes.PUT("/password", superUserHandler.ChangePasswordHandler)
+		authRoutes.PUT("/profile", superUserHandler.UpdateProfileHandler)
+		authRoutes.POST("/email", superUserHandler.ChangeEmailHandler)
 		// Add more authenticated routes here as needed
 		authRoutes.GET("/log-middleware", superUserHandler.LogContextHandler)
 	}
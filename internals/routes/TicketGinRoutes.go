@@ -0,0 +1,40 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+func SetupTicketGinRoutes(
+	router *gin.Engine,
+	ticketGinHandler *handlers.TicketGinHandler,
+	tokenManager gophertoken.TokenManager,
+	organizationService services.OrganizationServiceInterface,
+) {
+	// Public ticket routes, linked from the ticket email
+	publicTicketRoutes := router.Group("/tickets")
+	{
+		publicTicketRoutes.GET("/:code/qr.png", ticketGinHandler.TicketQRCodeHandler)
+	}
+
+	// Check-in routes for door staff, nested under their event
+	protectedCheckInRoutes := router.Group("/event/:id/checkin")
+	protectedCheckInRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager))          // Middleware to protect routes
+	protectedCheckInRoutes.Use(middlewares.TenantScopeGinMiddleware(organizationService)) // Scope queries to the active organization
+	{
+		protectedCheckInRoutes.POST("", ticketGinHandler.CheckInHandler)
+		protectedCheckInRoutes.POST("/sync", ticketGinHandler.SyncCheckInsHandler)
+		protectedCheckInRoutes.GET("/manifest", ticketGinHandler.CheckInManifestHandler)
+	}
+
+	// Ticket routes for the organizer, nested under the guest
+	protectedTicketRoutes := router.Group("/event/:id/guests/:guestId/ticket")
+	protectedTicketRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager))          // Middleware to protect routes
+	protectedTicketRoutes.Use(middlewares.TenantScopeGinMiddleware(organizationService)) // Scope queries to the active organization
+	{
+		protectedTicketRoutes.DELETE("", ticketGinHandler.RevokeTicketHandler)
+	}
+}
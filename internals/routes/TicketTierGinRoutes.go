@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/EventureGo/internals/services"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+func SetupTicketTierGinRoutes(
+	router *gin.Engine,
+	ticketTierGinHandler *handlers.TicketTierGinHandler,
+	tokenManager gophertoken.TokenManager,
+	organizationService services.OrganizationServiceInterface,
+) {
+	// Buyers list the tiers of the events of every tenant; only changing them is scoped
+	tenantScope := middlewares.TenantScopeGinMiddleware(organizationService)
+
+	// Ticket tier routes, scoped to an event; only its organizers can change them
+	protectedTicketTierRoutes := router.Group("/event/:id/tiers")
+	protectedTicketTierRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager)) // Middleware to protect routes
+	{
+		protectedTicketTierRoutes.POST("", tenantScope, ticketTierGinHandler.CreateTicketTierHandler)
+		protectedTicketTierRoutes.GET("", ticketTierGinHandler.ListTicketTiersHandler)
+		protectedTicketTierRoutes.PUT("/:tierId", tenantScope, ticketTierGinHandler.UpdateTicketTierHandler)
+		protectedTicketTierRoutes.DELETE("/:tierId", tenantScope, ticketTierGinHandler.DeleteTicketTierHandler)
+	}
+}
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+func SetupVenueGinRoutes(
+	router *gin.Engine,
+	venueGinHandler *handlers.VenueGinHandler,
+	tokenManager gophertoken.TokenManager,
+) {
+	// Venue routes for protected actions
+	protectedVenueRoutes := router.Group("/venue")
+	protectedVenueRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager)) // Middleware to protect routes
+	{
+		protectedVenueRoutes.POST("/register", venueGinHandler.CreateVenueHandler)
+		protectedVenueRoutes.GET("/nearby", venueGinHandler.FindVenuesNearHandler)
+		protectedVenueRoutes.GET("/within", venueGinHandler.FindVenuesWithinBoundsHandler)
+		protectedVenueRoutes.GET("/:id", venueGinHandler.GetVenueHandler)
+		protectedVenueRoutes.GET("/:id/free-slots", venueGinHandler.FindVenueFreeSlotsHandler)
+	}
+}
@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/lordofthemind/EventureGo/internals/handlers"
+	"github.com/lordofthemind/EventureGo/internals/middlewares"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+func SetupWebhookGinRoutes(
+	router *gin.Engine,
+	webhookGinHandler *handlers.WebhookGinHandler,
+	tokenManager gophertoken.TokenManager,
+) {
+	// Webhook routes, each organizer manages their own endpoints
+	protectedWebhookRoutes := router.Group("/webhooks")
+	protectedWebhookRoutes.Use(middlewares.AuthTokenGinMiddleware(tokenManager)) // Middleware to protect routes
+	{
+		protectedWebhookRoutes.POST("", webhookGinHandler.CreateWebhookHandler)
+		protectedWebhookRoutes.GET("", webhookGinHandler.ListWebhooksHandler)
+		protectedWebhookRoutes.GET("/:id", webhookGinHandler.GetWebhookHandler)
+		protectedWebhookRoutes.PUT("/:id", webhookGinHandler.UpdateWebhookHandler)
+		protectedWebhookRoutes.DELETE("/:id", webhookGinHandler.DeleteWebhookHandler)
+		protectedWebhookRoutes.GET("/:id/deliveries", webhookGinHandler.ListWebhookDeliveriesHandler)
+		protectedWebhookRoutes.POST("/:id/deliveries/:deliveryId/redeliver", webhookGinHandler.RedeliverWebhookHandler)
+	}
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+type AttendanceService struct {
+	guestRepository        repositories.GuestRepositoryInterface
+	eventRepository        repositories.EventRepositoryInterface
+	organizationRepository repositories.OrganizationRepositoryInterface
+	venueRepository        repositories.VenueRepositoryInterface
+	superUserRepository    repositories.SuperUserRepositoryInterface
+	eventGrantRepository   repositories.EventGrantRepositoryInterface
+	eventBus               EventBusServiceInterface
+}
+
+func NewAttendanceService(
+	guestRepository repositories.GuestRepositoryInterface,
+	eventRepository repositories.EventRepositoryInterface,
+	organizationRepository repositories.OrganizationRepositoryInterface,
+	venueRepository repositories.VenueRepositoryInterface,
+	superUserRepository repositories.SuperUserRepositoryInterface,
+	eventGrantRepository repositories.EventGrantRepositoryInterface,
+	eventBus EventBusServiceInterface,
+) AttendanceServiceInterface {
+	return &AttendanceService{
+		guestRepository:        guestRepository,
+		eventRepository:        eventRepository,
+		organizationRepository: organizationRepository,
+		venueRepository:        venueRepository,
+		superUserRepository:    superUserRepository,
+		eventGrantRepository:   eventGrantRepository,
+		eventBus:               eventBus,
+	}
+}
+
+func (a *AttendanceService) AttendanceStatsService(ctx context.Context, userID, eventID uuid.UUID) (*utils.AttendanceStats, error) {
+	ctx, event, err := a.attendanceEvent(ctx, userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsvpCounts, err := a.guestRepository.CountGuestsByRSVPStatus(ctx, event.ID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to count RSVPs")
+	}
+	checkedIn, err := a.guestRepository.CountCheckedInGuestsByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to count check-ins")
+	}
+	return utils.NewAttendanceStats(event.ID, rsvpCounts, checkedIn, eventCapacity(ctx, a.venueRepository, event)), nil
+}
+
+func (a *AttendanceService) WatchAttendanceService(ctx context.Context, userID, eventID uuid.UUID) (<-chan struct{}, error) {
+	if _, _, err := a.attendanceEvent(ctx, userID, eventID); err != nil {
+		return nil, err
+	}
+
+	changes := make(chan struct{}, 1)
+	unsubscribe := a.eventBus.Subscribe(func(event *types.DomainEventType) {
+		if event.EventID != eventID {
+			return
+		}
+		select {
+		case changes <- struct{}{}:
+		default: // A signal is already pending
+		}
+	})
+	go func() {
+		<-ctx.Done()
+		unsubscribe()
+	}()
+	return changes, nil
+}
+
+func (a *AttendanceService) attendanceEvent(ctx context.Context, userID, eventID uuid.UUID) (context.Context, *types.EventType, error) {
+	return findEventForStaff(ctx, a.eventRepository, a.superUserRepository, a.organizationRepository, a.eventGrantRepository, userID, eventID,
+		types.OrganizationRoleViewer, "only the organizer and event staff can follow attendance")
+}
+
+// eventCapacity returns the number of seats at the venue of an event, 0 when the event has no venue or the venue
+// capacity is unknown
+func eventCapacity(ctx context.Context, venueRepository repositories.VenueRepositoryInterface, event *types.EventType) int {
+	if event.VenueID == nil {
+		return 0
+	}
+	venue, err := venueRepository.FindVenueByID(ctx, *event.VenueID)
+	if err != nil || venue == nil {
+		return 0
+	}
+	return venue.Capacity
+}
@@ -0,0 +1,19 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// AttendanceServiceInterface defines the methods behind the live attendance dashboard of an event
+type AttendanceServiceInterface interface {
+	// AttendanceStatsService counts RSVPs, check-ins and the waitlist of an event for its organizer or staff
+	AttendanceStatsService(ctx context.Context, userID, eventID uuid.UUID) (*utils.AttendanceStats, error)
+
+	// WatchAttendanceService returns a channel that receives a signal whenever the guests of an event change
+	// on this instance. Signals are coalesced, so a slow reader sees one signal for many changes.
+	// The subscription ends when ctx is done.
+	WatchAttendanceService(ctx context.Context, userID, eventID uuid.UUID) (<-chan struct{}, error)
+}
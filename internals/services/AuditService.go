@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// auditVerifyBatchSize is how many records the chain check reads at a time
+const auditVerifyBatchSize = 500
+
+type AuditService struct {
+	repository repositories.AuditRepositoryInterface
+}
+
+func NewAuditService(repository repositories.AuditRepositoryInterface) AuditServiceInterface {
+	return &AuditService{
+		repository: repository,
+	}
+}
+
+func (a *AuditService) RecordAuditService(ctx context.Context, record *types.AuditRecordType) error {
+	info := utils.RequestInfoFromContext(ctx)
+	if record.RequestID == "" {
+		record.RequestID = info.RequestID
+	}
+	if record.ClientIP == "" {
+		record.ClientIP = info.ClientIP
+	}
+	if err := a.repository.AppendAuditRecord(ctx, record); err != nil {
+		return newerrors.Wrap(err, "failed to record audit entry")
+	}
+	return nil
+}
+
+func (a *AuditService) ListAuditRecordsService(ctx context.Context, query *utils.AuditRecordQuery) (*utils.AuditRecordListResponse, error) {
+	if query.Page < 1 {
+		query.Page = 1
+	}
+	if query.PageSize < 1 {
+		query.PageSize = utils.AuditPageSize
+	}
+	if query.PageSize > utils.MaxAuditPageSize {
+		query.PageSize = utils.MaxAuditPageSize
+	}
+
+	offset := (query.Page - 1) * query.PageSize
+	records, total, err := a.repository.ListAuditRecords(ctx, utils.TransformToAuditRecordFilter(query), offset, query.PageSize)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to list audit records")
+	}
+	return &utils.AuditRecordListResponse{
+		Records:  records,
+		Query:    query,
+		Page:     query.Page,
+		PageSize: query.PageSize,
+		Total:    total,
+	}, nil
+}
+
+func (a *AuditService) VerifyAuditChainService(ctx context.Context) (*utils.AuditChainReport, error) {
+	report := &utils.AuditChainReport{Valid: true}
+	var previous *types.AuditRecordType
+	for {
+		afterSequence := int64(0)
+		if previous != nil {
+			afterSequence = previous.Sequence
+		}
+		records, err := a.repository.FindAuditRecordsAfter(ctx, afterSequence, auditVerifyBatchSize)
+		if err != nil {
+			return nil, newerrors.Wrap(err, "failed to read audit records")
+		}
+
+		for _, record := range records {
+			if problem := auditChainProblem(previous, record); problem != "" {
+				report.Valid = false
+				report.BrokenAtSequence = afterSequence + 1
+				report.Problem = problem
+				return report, nil
+			}
+			report.Records++
+			report.LastHash = record.Hash
+			previous = record
+			afterSequence = record.Sequence
+		}
+
+		if len(records) < auditVerifyBatchSize {
+			return report, nil
+		}
+	}
+}
+
+// auditChainProblem describes why record cannot follow previous in the chain, or returns "" when it can
+func auditChainProblem(previous, record *types.AuditRecordType) string {
+	expectedSequence, expectedPrevHash := int64(1), ""
+	if previous != nil {
+		expectedSequence, expectedPrevHash = previous.Sequence+1, previous.Hash
+	}
+	switch {
+	case record.Sequence != expectedSequence:
+		return fmt.Sprintf("record %d is missing", expectedSequence)
+	case record.PrevHash != expectedPrevHash:
+		return "record does not link to the hash of the record before it"
+	case record.Hash != record.ComputeHash():
+		return "record was changed after it was appended"
+	}
+	return ""
+}
+
+// recordAudit appends an audit record of an action a service has already taken, so a failure is logged rather
+// than returned. Services that may run without an audit log pass a nil auditService.
+func recordAudit(ctx context.Context, auditService AuditServiceInterface, actorID uuid.UUID, action, targetType string, targetID uuid.UUID, changes map[string]*types.AuditChangeType) {
+	if auditService == nil {
+		return
+	}
+	record := types.NewAuditRecord(actorID, action, targetType, targetID, changes)
+	if err := auditService.RecordAuditService(ctx, record); err != nil {
+		log.Printf("Failed to audit %s of %s %s by %s: %v", action, targetType, targetID, actorID, err)
+	}
+}
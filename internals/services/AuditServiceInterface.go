@@ -0,0 +1,22 @@
+package services
+
+import (
+	"context"
+
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// AuditServiceInterface defines the methods for keeping and checking the tamper-evident audit log
+type AuditServiceInterface interface {
+	// RecordAuditService appends a record to the audit log, adding the request ID and client IP of the
+	// request ctx belongs to when the record has none
+	RecordAuditService(ctx context.Context, record *types.AuditRecordType) error
+
+	// ListAuditRecordsService lists one page of the audit records matching a query, latest first
+	ListAuditRecordsService(ctx context.Context, query *utils.AuditRecordQuery) (*utils.AuditRecordListResponse, error)
+
+	// VerifyAuditChainService walks the whole audit log in chain order and reports the first record that is
+	// missing, out of place or changed since it was appended
+	VerifyAuditChainService(ctx context.Context) (*utils.AuditChainReport, error)
+}
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// hasEventAccess reports whether a user may work on an event: a personal event admits its organizer, an
+// organization's event every member with at least minimumRole, and either admits users the event was shared
+// with at least minimumGrant. An empty minimumGrant leaves shared access out.
+func hasEventAccess(
+	ctx context.Context,
+	organizationRepository repositories.OrganizationRepositoryInterface,
+	eventGrantRepository repositories.EventGrantRepositoryInterface,
+	userID uuid.UUID,
+	event *types.EventType,
+	minimumRole string,
+	minimumGrant string,
+) bool {
+	if event.OrganizationID == nil {
+		if event.OrganizerID == userID {
+			return true
+		}
+	} else {
+		member, err := organizationRepository.FindOrganizationMember(ctx, *event.OrganizationID, userID)
+		if err == nil && member != nil && types.OrganizationRoleAtLeast(member.Role, minimumRole) {
+			return true
+		}
+	}
+	return minimumGrant != "" && hasEventGrant(ctx, eventGrantRepository, userID, event.ID, minimumGrant)
+}
+
+// hasEventGrant reports whether the event was shared with a user with at least minimumGrant
+func hasEventGrant(
+	ctx context.Context,
+	eventGrantRepository repositories.EventGrantRepositoryInterface,
+	userID, eventID uuid.UUID,
+	minimumGrant string,
+) bool {
+	grant, err := eventGrantRepository.FindEventGrant(ctx, eventID, userID)
+	return err == nil && grant != nil && types.EventGrantRoleAtLeast(grant.Role, minimumGrant)
+}
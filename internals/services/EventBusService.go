@@ -0,0 +1,63 @@
+package services
+
+import (
+	"log"
+	"sort"
+	"sync"
+
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+type EventBusService struct {
+	mu       sync.RWMutex
+	handlers map[int]DomainEventHandlerFunc
+	nextID   int
+}
+
+func NewEventBusService() EventBusServiceInterface {
+	return &EventBusService{
+		handlers: make(map[int]DomainEventHandlerFunc),
+	}
+}
+
+func (b *EventBusService) Publish(event *types.DomainEventType) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	ids := make([]int, 0, len(b.handlers))
+	for id := range b.handlers {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		b.deliver(b.handlers[id], event)
+	}
+}
+
+func (b *EventBusService) Subscribe(handler DomainEventHandlerFunc) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextID
+	b.nextID++
+	b.handlers[id] = handler
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			delete(b.handlers, id)
+		})
+	}
+}
+
+// deliver calls one handler, so a panicking subscriber cannot break the publisher or the other subscribers
+func (b *EventBusService) deliver(handler DomainEventHandlerFunc, event *types.DomainEventType) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("Domain event handler panicked on %s for event %s: %v", event.Type, event.EventID, r)
+		}
+	}()
+	handler(event)
+}
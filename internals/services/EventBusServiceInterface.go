@@ -0,0 +1,18 @@
+package services
+
+import (
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// DomainEventHandlerFunc receives published domain events. It runs on the publisher's goroutine, so it must not block.
+type DomainEventHandlerFunc func(event *types.DomainEventType)
+
+// EventBusServiceInterface defines an in-process publish/subscribe bus for domain events.
+// Subscribers only see events published by the same server instance.
+type EventBusServiceInterface interface {
+	// Publish hands the event to every subscriber, in subscription order
+	Publish(event *types.DomainEventType)
+
+	// Subscribe registers handler for every future event and returns a function that removes it again
+	Subscribe(handler DomainEventHandlerFunc) (unsubscribe func())
+}
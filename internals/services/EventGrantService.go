@@ -0,0 +1,291 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/htmltemplates"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/mygopher/gophersmtp"
+)
+
+// eventGrantRoleDescriptions explain each grant role in the email sent to the grantee
+var eventGrantRoleDescriptions = map[string]string{
+	types.EventGrantRoleCoOrganizer: "Co-organizer: you can change the event, manage its guest list and share it with others",
+	types.EventGrantRoleEditor:      "Editor: you can change the event and manage its guest list",
+	types.EventGrantRoleCheckIn:     "Check-in: you can check guests in and follow attendance",
+}
+
+type EventGrantService struct {
+	repository             repositories.EventGrantRepositoryInterface
+	eventRepository        repositories.EventRepositoryInterface
+	organizationRepository repositories.OrganizationRepositoryInterface
+	superUserRepository    repositories.SuperUserRepositoryInterface
+	emailService           gophersmtp.GopherSmtpInterface
+	auditService           AuditServiceInterface
+}
+
+func NewEventGrantService(
+	repository repositories.EventGrantRepositoryInterface,
+	eventRepository repositories.EventRepositoryInterface,
+	organizationRepository repositories.OrganizationRepositoryInterface,
+	superUserRepository repositories.SuperUserRepositoryInterface,
+	emailService gophersmtp.GopherSmtpInterface,
+	auditService AuditServiceInterface,
+) EventGrantServiceInterface {
+	return &EventGrantService{
+		repository:             repository,
+		eventRepository:        eventRepository,
+		organizationRepository: organizationRepository,
+		superUserRepository:    superUserRepository,
+		emailService:           emailService,
+		auditService:           auditService,
+	}
+}
+
+func (s *EventGrantService) GrantEventAccessService(ctx context.Context, userID, eventID uuid.UUID, grantDTO *utils.EventGrantDTO) (*utils.EventGrantResponse, error) {
+	event, err := s.findManagedEvent(ctx, userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if grantDTO.Role == types.EventGrantRoleCoOrganizer && !s.isPrimaryOrganizer(ctx, userID, event) {
+		return nil, newerrors.NewForbiddenError("only the organizer can add co-organizers")
+	}
+	grantee, err := s.findActiveUser(ctx, grantDTO.Email)
+	if err != nil {
+		return nil, err
+	}
+	if grantee.ID == event.OrganizerID {
+		return nil, newerrors.NewValidationError("the organizer already has full access to this event")
+	}
+	// An organization's events are only seen from inside the organization, so only its members can use a grant on one
+	if event.OrganizationID != nil {
+		member, err := s.organizationRepository.FindOrganizationMember(ctx, *event.OrganizationID, grantee.ID)
+		if err != nil || member == nil {
+			return nil, newerrors.NewValidationError("events of an organization can only be shared with its members")
+		}
+	}
+
+	grant := types.NewEventGrant(event.ID, grantee.ID, grantDTO.Role, userID)
+	if err := s.repository.CreateEventGrant(ctx, grant); err != nil {
+		if errors.Is(err, repositories.ErrEventGrantExists) {
+			return nil, newerrors.NewConflictError(fmt.Sprintf("%s already has access to this event; change their role instead", grantDTO.Email))
+		}
+		return nil, newerrors.Wrap(err, "failed to share event")
+	}
+	recordAudit(ctx, s.auditService, userID, types.AuditActionEventAccessGranted, types.AuditTargetEvent, event.ID, map[string]*types.AuditChangeType{
+		"grantee_id": types.NewAuditChange(nil, grantee.ID),
+		"role":       types.NewAuditChange(nil, grant.Role),
+	})
+
+	if err := s.sendAccessEmail(ctx, userID, event, grantee, "event_access_granted_email.html",
+		fmt.Sprintf("%s was shared with you", event.Title), eventGrantRoleDescriptions[grant.Role]); err != nil {
+		return nil, err
+	}
+	return utils.TransformToEventGrantResponse(grant, grantee), nil
+}
+
+func (s *EventGrantService) FindEventGrantsService(ctx context.Context, userID, eventID uuid.UUID) ([]*utils.EventGrantResponse, error) {
+	event, err := s.findManagedEvent(ctx, userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := s.repository.FindEventGrantsByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load event access")
+	}
+
+	responses := make([]*utils.EventGrantResponse, 0, len(grants))
+	for _, grant := range grants {
+		responses = append(responses, s.grantResponse(ctx, grant))
+	}
+	return responses, nil
+}
+
+func (s *EventGrantService) ChangeEventGrantRoleService(ctx context.Context, userID, eventID, granteeID uuid.UUID, role string) (*utils.EventGrantResponse, error) {
+	event, err := s.findManagedEvent(ctx, userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	grant, err := s.findGrant(ctx, event.ID, granteeID)
+	if err != nil {
+		return nil, err
+	}
+	if (grant.Role == types.EventGrantRoleCoOrganizer || role == types.EventGrantRoleCoOrganizer) && !s.isPrimaryOrganizer(ctx, userID, event) {
+		return nil, newerrors.NewForbiddenError("only the organizer can add or remove co-organizers")
+	}
+	if grant.Role == role {
+		return s.grantResponse(ctx, grant), nil
+	}
+
+	previousRole := grant.Role
+	grant.Role = role
+	if err := s.repository.UpdateEventGrantRole(ctx, grant); err != nil {
+		return nil, newerrors.Wrap(err, "failed to change event access")
+	}
+	recordAudit(ctx, s.auditService, userID, types.AuditActionEventAccessChanged, types.AuditTargetEvent, event.ID, map[string]*types.AuditChangeType{
+		"grantee_id": types.NewAuditChange(granteeID, granteeID),
+		"role":       types.NewAuditChange(previousRole, role),
+	})
+	return s.grantResponse(ctx, grant), nil
+}
+
+func (s *EventGrantService) RevokeEventAccessService(ctx context.Context, userID, eventID, granteeID uuid.UUID) error {
+	var event *types.EventType
+	var err error
+	if userID == granteeID {
+		// Anyone may give up access that was shared with them
+		event, err = s.eventRepository.FindEventByID(ctx, eventID)
+		if err != nil || event == nil {
+			return newerrors.NewValidationError("event not found")
+		}
+	} else if event, err = s.findManagedEvent(ctx, userID, eventID); err != nil {
+		return err
+	}
+	grant, err := s.findGrant(ctx, event.ID, granteeID)
+	if err != nil {
+		return err
+	}
+	if userID != granteeID && grant.Role == types.EventGrantRoleCoOrganizer && !s.isPrimaryOrganizer(ctx, userID, event) {
+		return newerrors.NewForbiddenError("only the organizer can add or remove co-organizers")
+	}
+
+	if err := s.repository.DeleteEventGrant(ctx, event.ID, granteeID); err != nil {
+		return newerrors.Wrap(err, "failed to revoke event access")
+	}
+	recordAudit(ctx, s.auditService, userID, types.AuditActionEventAccessRevoked, types.AuditTargetEvent, event.ID, map[string]*types.AuditChangeType{
+		"grantee_id": types.NewAuditChange(granteeID, nil),
+		"role":       types.NewAuditChange(grant.Role, nil),
+	})
+	return nil
+}
+
+func (s *EventGrantService) TransferEventService(ctx context.Context, userID, eventID uuid.UUID, newOrganizerEmail string) (*types.EventType, error) {
+	event, err := s.eventRepository.FindEventByID(ctx, eventID)
+	if err != nil || event == nil {
+		return nil, newerrors.NewValidationError("event not found")
+	}
+	if !s.isPrimaryOrganizer(ctx, userID, event) {
+		return nil, newerrors.NewForbiddenError("only the organizer can hand this event over")
+	}
+	newOrganizer, err := s.findActiveUser(ctx, strings.ToLower(strings.TrimSpace(newOrganizerEmail)))
+	if err != nil {
+		return nil, err
+	}
+	if newOrganizer.ID == event.OrganizerID {
+		return nil, newerrors.NewValidationError("this user already organizes the event")
+	}
+	// An organization's event stays with the organization, so it can only go to one of its members
+	if event.OrganizationID != nil {
+		member, err := s.organizationRepository.FindOrganizationMember(ctx, *event.OrganizationID, newOrganizer.ID)
+		if err != nil || member == nil {
+			return nil, newerrors.NewValidationError("the new organizer must be a member of the organization owning this event")
+		}
+	}
+
+	previousOrganizerID := event.OrganizerID
+	event.OrganizerID = newOrganizer.ID
+	if err := s.eventRepository.UpdateEvent(ctx, event); err != nil {
+		return nil, newerrors.Wrap(err, "failed to transfer event")
+	}
+
+	// The new organizer no longer needs a grant, the previous one keeps working on the event as co-organizer
+	if err := s.repository.DeleteEventGrant(ctx, event.ID, newOrganizer.ID); err != nil {
+		return nil, newerrors.Wrap(err, "failed to update event access")
+	}
+	coOrganizer := types.NewEventGrant(event.ID, previousOrganizerID, types.EventGrantRoleCoOrganizer, userID)
+	if err := s.repository.CreateEventGrant(ctx, coOrganizer); err != nil && !errors.Is(err, repositories.ErrEventGrantExists) {
+		return nil, newerrors.Wrap(err, "failed to update event access")
+	}
+	recordAudit(ctx, s.auditService, userID, types.AuditActionEventTransferred, types.AuditTargetEvent, event.ID, map[string]*types.AuditChangeType{
+		"organizer_id": types.NewAuditChange(previousOrganizerID, newOrganizer.ID),
+	})
+
+	if err := s.sendAccessEmail(ctx, userID, event, newOrganizer, "event_transferred_email.html",
+		fmt.Sprintf("You are now the organizer of %s", event.Title), ""); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// findManagedEvent loads an event whose access a user wants to manage, which takes its organizer, a co-organizer
+// or an admin of the organization owning it
+func (s *EventGrantService) findManagedEvent(ctx context.Context, userID, eventID uuid.UUID) (*types.EventType, error) {
+	event, err := s.eventRepository.FindEventByID(ctx, eventID)
+	if err != nil || event == nil {
+		return nil, newerrors.NewValidationError("event not found")
+	}
+	if !hasEventAccess(ctx, s.organizationRepository, s.repository, userID, event, types.OrganizationRoleAdmin, types.EventGrantRoleCoOrganizer) {
+		return nil, newerrors.NewForbiddenError("only the organizer or a co-organizer can manage who has access to this event")
+	}
+	return event, nil
+}
+
+// isPrimaryOrganizer reports whether a user speaks for the event as a whole: its organizer, or an admin of the
+// organization owning it
+func (s *EventGrantService) isPrimaryOrganizer(ctx context.Context, userID uuid.UUID, event *types.EventType) bool {
+	if event.OrganizerID == userID {
+		return true
+	}
+	return event.OrganizationID != nil && hasEventAccess(ctx, s.organizationRepository, s.repository, userID, event, types.OrganizationRoleAdmin, "")
+}
+
+// findActiveUser loads the active account an event is shared with or handed over to
+func (s *EventGrantService) findActiveUser(ctx context.Context, email string) (*types.SuperUserType, error) {
+	superUser, err := s.superUserRepository.FindSuperUserByEmail(ctx, email)
+	if err != nil || superUser == nil || !superUser.IsActive {
+		return nil, newerrors.NewValidationError(fmt.Sprintf("no active account uses %s", email))
+	}
+	return superUser, nil
+}
+
+// findGrant loads a grant that is being changed
+func (s *EventGrantService) findGrant(ctx context.Context, eventID, granteeID uuid.UUID) (*types.EventGrantType, error) {
+	grant, err := s.repository.FindEventGrant(ctx, eventID, granteeID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load event access")
+	}
+	if grant == nil {
+		return nil, newerrors.NewValidationError("this user has no access to the event")
+	}
+	return grant, nil
+}
+
+// grantResponse describes a grant together with the grantee's account details
+func (s *EventGrantService) grantResponse(ctx context.Context, grant *types.EventGrantType) *utils.EventGrantResponse {
+	superUser, err := s.superUserRepository.FindSuperUserByID(ctx, grant.SuperUserID)
+	if err != nil {
+		superUser = nil
+	}
+	return utils.TransformToEventGrantResponse(grant, superUser)
+}
+
+// sendAccessEmail tells a user what they can now do on an event
+func (s *EventGrantService) sendAccessEmail(ctx context.Context, userID uuid.UUID, event *types.EventType, recipient *types.SuperUserType, templateName, subject, roleDescription string) error {
+	grantorName := "A colleague"
+	if grantor, err := s.superUserRepository.FindSuperUserByID(ctx, userID); err == nil && grantor != nil {
+		grantorName = grantor.FullName
+	}
+	emailBody, err := htmltemplates.LoadAndRenderTemplate(templateName, map[string]interface{}{
+		"Name":            recipient.FullName,
+		"GrantorName":     grantorName,
+		"EventTitle":      event.Title,
+		"EventTime":       utils.FormatEventTime(event.StartTime, event.Timezone),
+		"RoleDescription": roleDescription,
+		"EventLink":       fmt.Sprintf("%s/event/%s", configs.BaseURL, event.ID),
+	})
+	if err != nil {
+		return newerrors.Wrap(err, "failed to render event access email template")
+	}
+	if err := s.emailService.SendEmail([]string{recipient.Email}, subject, emailBody, true); err != nil {
+		return newerrors.Wrap(err, "failed to send event access email")
+	}
+	return nil
+}
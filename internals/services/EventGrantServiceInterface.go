@@ -0,0 +1,29 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// EventGrantServiceInterface defines the methods for sharing a single event with other users and handing it over
+type EventGrantServiceInterface interface {
+	// GrantEventAccessService shares an event with the user owning an email address and emails them about it.
+	// The organizer and co-organizers grant editor and check-in access; only the organizer grants co-organizer access.
+	GrantEventAccessService(ctx context.Context, userID, eventID uuid.UUID, grantDTO *utils.EventGrantDTO) (*utils.EventGrantResponse, error)
+
+	// FindEventGrantsService retrieves the users an event was shared with
+	FindEventGrantsService(ctx context.Context, userID, eventID uuid.UUID) ([]*utils.EventGrantResponse, error)
+
+	// ChangeEventGrantRoleService changes the access of a user the event was shared with
+	ChangeEventGrantRoleService(ctx context.Context, userID, eventID, granteeID uuid.UUID, role string) (*utils.EventGrantResponse, error)
+
+	// RevokeEventAccessService takes back the access of a user, or lets a user give it up when granteeID is their own ID
+	RevokeEventAccessService(ctx context.Context, userID, eventID, granteeID uuid.UUID) error
+
+	// TransferEventService makes the user owning an email address the primary organizer of an event.
+	// The previous organizer stays on as co-organizer until their access is revoked.
+	TransferEventService(ctx context.Context, userID, eventID uuid.UUID, newOrganizerEmail string) (*types.EventType, error)
+}
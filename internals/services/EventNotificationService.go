@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lordofthemind/EventureGo/htmltemplates"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/mygopher/gophersmtp"
+)
+
+type EventNotificationService struct {
+	guestRepository repositories.GuestRepositoryInterface
+	emailService    gophersmtp.GopherSmtpInterface
+}
+
+func NewEventNotificationService(
+	guestRepository repositories.GuestRepositoryInterface,
+	emailService gophersmtp.GopherSmtpInterface,
+) EventNotificationServiceInterface {
+	return &EventNotificationService{
+		guestRepository: guestRepository,
+		emailService:    emailService,
+	}
+}
+
+func (n *EventNotificationService) NotifyEventStatusChangeService(ctx context.Context, event *types.EventType, change *types.EventStatusChangeType) error {
+	// Only a cancellation changes what guests have in their calendars
+	ics := ""
+	if change.To == types.EventStatusCancelled {
+		ics = utils.GenerateEventICS(event, utils.ICSMethodCancel)
+	}
+
+	subject := fmt.Sprintf("%s: %s", change.To, event.Title)
+	return n.notifyGuests(ctx, event, subject, "event_status_change_email.html", ics, map[string]interface{}{
+		"EventTitle": event.Title,
+		"Status":     change.To,
+		"Reason":     change.Reason,
+		"StartTime":  utils.FormatEventTime(event.StartTime, event.Timezone),
+		"Location":   event.Location,
+	})
+}
+
+func (n *EventNotificationService) NotifyEventRescheduledService(ctx context.Context, event *types.EventType, previousStartTime, previousEndTime time.Time) error {
+	subject := fmt.Sprintf("Rescheduled: %s", event.Title)
+	return n.notifyGuests(ctx, event, subject, "event_rescheduled_email.html", utils.GenerateEventICS(event, utils.ICSMethodRequest), map[string]interface{}{
+		"EventTitle":        event.Title,
+		"PreviousStartTime": utils.FormatEventTime(previousStartTime, event.Timezone),
+		"PreviousEndTime":   utils.FormatEventTime(previousEndTime, event.Timezone),
+		"StartTime":         utils.FormatEventTime(event.StartTime, event.Timezone),
+		"EndTime":           utils.FormatEventTime(event.EndTime, event.Timezone),
+		"Location":          event.Location,
+	})
+}
+
+// notifyGuests renders the template for every guest of the event and emails it, attaching the .ics when one is given.
+// Each guest gets a personal email so addresses are never shared between guests.
+func (n *EventNotificationService) notifyGuests(ctx context.Context, event *types.EventType, subject, templateName, ics string, data map[string]interface{}) error {
+	guests, err := n.guestRepository.FindGuestsByEventID(ctx, event.ID)
+	if err != nil {
+		return newerrors.Wrap(err, "failed to load guests")
+	}
+	if len(guests) == 0 {
+		return nil
+	}
+
+	var attachments []string
+	if ics != "" {
+		icsPath, cleanup, err := utils.WriteTempAttachment("invite.ics", []byte(ics))
+		if err != nil {
+			return newerrors.Wrap(err, "failed to write calendar attachment")
+		}
+		defer cleanup()
+		attachments = []string{icsPath}
+	}
+
+	failed := 0
+	for _, guest := range guests {
+		data["FullName"] = guest.FullName
+		emailBody, err := htmltemplates.LoadAndRenderTemplate(templateName, data)
+		if err != nil {
+			return newerrors.Wrap(err, "failed to render event email template")
+		}
+
+		if len(attachments) > 0 {
+			err = n.emailService.SendEmailWithAttachments([]string{guest.Email}, subject, emailBody, attachments, true)
+		} else {
+			err = n.emailService.SendEmail([]string{guest.Email}, subject, emailBody, true)
+		}
+		if err != nil {
+			log.Printf("Failed to queue email for event %s to %s: %v", event.ID, guest.Email, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to notify %d of %d guests", failed, len(guests))
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// EventNotificationServiceInterface defines the methods for telling guests about changes to their events
+type EventNotificationServiceInterface interface {
+	// NotifyEventStatusChangeService emails every guest of the event about a lifecycle change such as a cancellation.
+	// Cancellation emails carry a METHOD:CANCEL .ics so calendar clients remove the event.
+	NotifyEventStatusChangeService(ctx context.Context, event *types.EventType, change *types.EventStatusChangeType) error
+
+	// NotifyEventRescheduledService emails every guest the old and new time of a moved event with an updated .ics
+	NotifyEventRescheduledService(ctx context.Context, event *types.EventType, previousStartTime, previousEndTime time.Time) error
+}
@@ -2,40 +2,595 @@ package services
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
 	"github.com/lordofthemind/EventureGo/internals/repositories"
 	"github.com/lordofthemind/EventureGo/internals/types"
 	"github.com/lordofthemind/EventureGo/internals/utils"
 )
 
+// conflictCheckLimit caps how many occurrences of a recurring event are checked for double bookings
+const conflictCheckLimit = 500
+
+// conflictCheckHorizonYears bounds open-ended recurring series when checking for double bookings
+const conflictCheckHorizonYears = 2
+
 type EventService struct {
-	repository repositories.EventRepositoryInterface
+	repository             repositories.EventRepositoryInterface
+	venueRepository        repositories.VenueRepositoryInterface
+	organizationRepository repositories.OrganizationRepositoryInterface
+	eventGrantRepository   repositories.EventGrantRepositoryInterface
+	notificationService    EventNotificationServiceInterface
+	reminderService        ReminderServiceInterface
+	eventBus               EventBusServiceInterface
+	auditService           AuditServiceInterface
 }
 
-func NewEventService(repository repositories.EventRepositoryInterface) EventServiceInterface {
+func NewEventService(
+	repository repositories.EventRepositoryInterface,
+	venueRepository repositories.VenueRepositoryInterface,
+	organizationRepository repositories.OrganizationRepositoryInterface,
+	eventGrantRepository repositories.EventGrantRepositoryInterface,
+	notificationService EventNotificationServiceInterface,
+	reminderService ReminderServiceInterface,
+	eventBus EventBusServiceInterface,
+	auditService AuditServiceInterface,
+) EventServiceInterface {
 	return &EventService{
-		repository: repository,
+		repository:             repository,
+		venueRepository:        venueRepository,
+		organizationRepository: organizationRepository,
+		eventGrantRepository:   eventGrantRepository,
+		notificationService:    notificationService,
+		reminderService:        reminderService,
+		eventBus:               eventBus,
+		auditService:           auditService,
 	}
 }
 
-func (e *EventService) CreateEventService(ctx context.Context, organizerID uuid.UUID, eventDTO *utils.EventDTO) (*types.EventType, error) {
+func (e *EventService) CreateEventService(ctx context.Context, organizerID uuid.UUID, eventDTO *utils.EventDTO) (*types.EventType, []*utils.EventConflictResponse, error) {
+	location := eventDTO.Location
+	timezone := eventDTO.Timezone
+
+	// Make sure the referenced venue exists and fall back to its name and timezone
+	if eventDTO.VenueID != nil {
+		venue, err := e.venueRepository.FindVenueByID(ctx, *eventDTO.VenueID)
+		if err != nil {
+			return nil, nil, newerrors.NewValidationError("venue not found")
+		}
+		if location == "" {
+			location = venue.Name
+		}
+		if timezone == "" {
+			timezone = venue.Timezone
+		}
+	}
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
 	// Create new event using the DTO and organizerID
 	event := types.NewEvent(
 		eventDTO.Title,
 		eventDTO.Description,
-		eventDTO.Location,
+		location,
+		timezone,
+		eventDTO.VenueID,
 		eventDTO.StartTime,
 		eventDTO.EndTime,
 		organizerID,
 		eventDTO.Tags,
+		eventDTO.Recurrence,
 	)
+	event.Slug = utils.NewEventSlug(event.Title)
+
+	// Events created while an organization is active belong to it, which only its editors may do
+	if scope, ok := repositories.TenantScopeFromContext(ctx); ok && scope.OrganizationID != nil {
+		event.OrganizationID = scope.OrganizationID
+		if !hasEventAccess(ctx, e.organizationRepository, e.eventGrantRepository, organizerID, event, types.OrganizationRoleEditor, "") {
+			return nil, nil, newerrors.NewForbiddenError("only editors of the organization can create its events")
+		}
+	}
+
+	// Events start as drafts unless the organizer publishes them right away
+	if eventDTO.Publish {
+		event.Status = types.EventStatusPublished
+		event.StatusHistory = append(event.StatusHistory, *types.NewEventStatusChange(types.EventStatusDraft, types.EventStatusPublished, "", organizerID))
+	}
+
+	// Check the venue and the organizer's calendar before saving
+	conflicts, err := e.findScheduleConflicts(ctx, event)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(conflicts) > 0 && resolveConflictMode(eventDTO.ConflictMode) == utils.ConflictModeReject {
+		return nil, conflicts, newerrors.NewConflictError("event clashes with existing bookings")
+	}
 
 	// Call repository to save the event in the database
 	createdEvent, err := e.repository.CreateEvent(ctx, event)
+	if err != nil {
+		return nil, nil, err
+	}
+	e.planEventReminders(ctx, createdEvent)
+	e.publishEventChange(types.DomainEventEventCreated, createdEvent, nil)
+	e.auditEvent(ctx, organizerID, types.AuditActionEventCreated, nil, createdEvent)
+
+	return createdEvent, conflicts, nil
+}
+
+func (e *EventService) ChangeEventSlugService(ctx context.Context, organizerID, eventID uuid.UUID, slug string) (*types.EventType, error) {
+	event, err := e.FindEventByIDService(ctx, eventID)
 	if err != nil {
 		return nil, err
 	}
+	if !hasEventAccess(ctx, e.organizationRepository, e.eventGrantRepository, organizerID, event, types.OrganizationRoleEditor, types.EventGrantRoleEditor) {
+		return nil, newerrors.NewForbiddenError("only the organizer or an editor can change the slug of this event")
+	}
+	if event.Slug == slug {
+		return event, nil
+	}
+
+	existing, err := e.repository.FindEventBySlug(ctx, slug)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to check slug")
+	}
+	if existing != nil {
+		return nil, newerrors.NewConflictError(fmt.Sprintf("slug %q is already taken", slug))
+	}
+
+	before := utils.AuditSnapshot(event)
+	event.Slug = slug
+	if err := e.repository.UpdateEvent(ctx, event); err != nil {
+		return nil, newerrors.Wrap(err, "failed to update event")
+	}
+	e.auditEvent(ctx, organizerID, types.AuditActionEventSlugChanged, before, event)
+	return event, nil
+}
+
+func (e *EventService) RescheduleEventService(ctx context.Context, organizerID, eventID uuid.UUID, rescheduleDTO *utils.RescheduleEventDTO) (*types.EventType, []*utils.EventConflictResponse, error) {
+	event, err := e.FindEventByIDService(ctx, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !hasEventAccess(ctx, e.organizationRepository, e.eventGrantRepository, organizerID, event, types.OrganizationRoleEditor, types.EventGrantRoleEditor) {
+		return nil, nil, newerrors.NewForbiddenError("only the organizer or an editor can reschedule this event")
+	}
+	status := event.CurrentStatus()
+	if status == types.EventStatusCancelled || status == types.EventStatusCompleted {
+		return nil, nil, newerrors.NewValidationError(fmt.Sprintf("a %s event cannot be rescheduled", strings.ToLower(status)))
+	}
+	if err := validateRescheduledRecurrence(event.Recurrence, rescheduleDTO.StartTime); err != nil {
+		return nil, nil, err
+	}
+
+	// Check the new slot on a copy so the stored event is untouched when the change is rejected
+	rescheduled := *event
+	rescheduled.StartTime = rescheduleDTO.StartTime.UTC()
+	rescheduled.EndTime = rescheduleDTO.EndTime.UTC()
+	rescheduled.Sequence = event.Sequence + 1
+
+	conflicts, err := e.findScheduleConflicts(ctx, &rescheduled)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(conflicts) > 0 && resolveConflictMode(rescheduleDTO.ConflictMode) == utils.ConflictModeReject {
+		return nil, conflicts, newerrors.NewConflictError("new time clashes with existing bookings")
+	}
+
+	before := utils.AuditSnapshot(event)
+	if err := e.repository.RescheduleEvent(ctx, eventID, rescheduled.StartTime, rescheduled.EndTime); err != nil {
+		return nil, nil, newerrors.Wrap(err, "failed to reschedule event")
+	}
+
+	// Setting a new date for a postponed event puts it back on the schedule
+	if status == types.EventStatusPostponed {
+		change := types.NewEventStatusChange(status, types.EventStatusPublished, "rescheduled", organizerID)
+		if err := e.repository.TransitionEventStatus(ctx, eventID, change); err != nil {
+			return nil, nil, newerrors.Wrap(err, "failed to republish postponed event")
+		}
+		applyEventStatusChange(&rescheduled, change)
+	}
+	e.planEventReminders(ctx, &rescheduled)
+	e.auditEvent(ctx, organizerID, types.AuditActionEventRescheduled, before, &rescheduled)
+	e.publishEventChange(types.DomainEventEventUpdated, &rescheduled, map[string]interface{}{
+		"change":              "rescheduled",
+		"previous_start_time": event.StartTime,
+		"previous_end_time":   event.EndTime,
+	})
+
+	// Guests of a draft were never invited, so there is nobody to tell yet
+	if !rescheduleDTO.SuppressNotifications && status != types.EventStatusDraft {
+		if err := e.notificationService.NotifyEventRescheduledService(ctx, &rescheduled, event.StartTime, event.EndTime); err != nil {
+			log.Printf("Failed to notify guests of event %s: %v", event.ID, err)
+		}
+	}
+
+	return &rescheduled, conflicts, nil
+}
+
+func (e *EventService) ChangeEventStatusService(ctx context.Context, organizerID, eventID uuid.UUID, statusDTO *utils.EventStatusChangeDTO) (*types.EventType, error) {
+	event, err := e.FindEventByIDService(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !hasEventAccess(ctx, e.organizationRepository, e.eventGrantRepository, organizerID, event, types.OrganizationRoleEditor, types.EventGrantRoleEditor) {
+		return nil, newerrors.NewForbiddenError("only the organizer or an editor can change the status of this event")
+	}
+
+	from := event.CurrentStatus()
+	if !types.CanTransitionEventStatus(from, statusDTO.Status) {
+		return nil, newerrors.NewValidationError(fmt.Sprintf("event cannot move from %s to %s", from, statusDTO.Status))
+	}
+	if statusDTO.Status == types.EventStatusCompleted && time.Now().UTC().Before(event.EndTime) {
+		return nil, newerrors.NewValidationError("only events that have ended can be completed")
+	}
+
+	before := utils.AuditSnapshot(event)
+	change := types.NewEventStatusChange(from, statusDTO.Status, statusDTO.Reason, organizerID)
+	if err := e.repository.TransitionEventStatus(ctx, eventID, change); err != nil {
+		if errors.Is(err, repositories.ErrEventStatusChanged) {
+			return nil, newerrors.NewConflictError(err.Error())
+		}
+		return nil, newerrors.Wrap(err, "failed to change event status")
+	}
+	applyEventStatusChange(event, change)
+	e.auditEvent(ctx, organizerID, types.AuditActionEventStatusChanged, before, event)
+	e.planEventReminders(ctx, event)
+	e.publishEventStatusChange(event, change)
+
+	// Guests only hear about changes that affect their plans; a failed email does not undo the change
+	affectsGuests := change.To == types.EventStatusCancelled || change.To == types.EventStatusPostponed
+	if affectsGuests && from != types.EventStatusDraft && !statusDTO.SuppressNotifications {
+		if err := e.notificationService.NotifyEventStatusChangeService(ctx, event, change); err != nil {
+			log.Printf("Failed to notify guests of event %s: %v", event.ID, err)
+		}
+	}
+
+	return event, nil
+}
+
+func (e *EventService) CompleteEndedEventsService(ctx context.Context) (int, error) {
+	events, err := e.repository.FindPastEvents(ctx)
+	if err != nil {
+		return 0, newerrors.Wrap(err, "failed to load past events")
+	}
+
+	now := time.Now().UTC()
+	completed := 0
+	for _, event := range events {
+		// A recurring series is only over once its last occurrence has ended
+		if event.CurrentStatus() != types.EventStatusPublished || utils.NextEventOccurrence(event, now) != nil {
+			continue
+		}
+
+		// Completions made by the system carry no actor
+		before := utils.AuditSnapshot(event)
+		change := types.NewEventStatusChange(types.EventStatusPublished, types.EventStatusCompleted, "event ended", uuid.Nil)
+		if err := e.repository.TransitionEventStatus(ctx, event.ID, change); err != nil {
+			if errors.Is(err, repositories.ErrEventStatusChanged) {
+				continue
+			}
+			return completed, newerrors.Wrap(err, "failed to complete event")
+		}
+		applyEventStatusChange(event, change)
+		e.auditEvent(ctx, uuid.Nil, types.AuditActionEventCompleted, before, event)
+		e.publishEventStatusChange(event, change)
+		completed++
+	}
+	return completed, nil
+}
+
+func (e *EventService) FindEventByIDService(ctx context.Context, eventID uuid.UUID) (*types.EventType, error) {
+	event, err := e.repository.FindEventByID(ctx, eventID)
+	if err != nil || event == nil {
+		return nil, newerrors.NewValidationError("event not found")
+	}
+	return event, nil
+}
+
+func (e *EventService) FindEventForViewerService(ctx context.Context, viewerID, eventID uuid.UUID) (*types.EventType, error) {
+	event, err := e.FindEventByIDService(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	// Drafts are only visible to their organizer, the members of the organization owning them and whoever they were shared with
+	if event.CurrentStatus() == types.EventStatusDraft && !hasEventAccess(ctx, e.organizationRepository, e.eventGrantRepository, viewerID, event, types.OrganizationRoleViewer, types.EventGrantRoleCheckIn) {
+		return nil, newerrors.NewValidationError("event not found")
+	}
+	return event, nil
+}
+
+func (e *EventService) FindAllEventsService(ctx context.Context) ([]*types.EventType, error) {
+	events, err := e.repository.FindAllEvents(ctx)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to find events")
+	}
+	sortEventsByStartTime(events)
+	return events, nil
+}
+
+func (e *EventService) SearchEventsByTitleService(ctx context.Context, title string) ([]*types.EventType, error) {
+	events, err := e.repository.SearchEventsByTitle(ctx, title)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to search events")
+	}
+	sortEventsByStartTime(events)
+	return events, nil
+}
+
+func (e *EventService) FindUpcomingEventsService(ctx context.Context) ([]*utils.UpcomingEventResponse, error) {
+	events, err := e.repository.FindUpcomingEvents(ctx)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to find upcoming events")
+	}
+
+	// Recurring series are expanded in their own timezone, so the next occurrence is correct across DST changes
+	now := time.Now().UTC()
+	results := []*utils.UpcomingEventResponse{}
+	for _, event := range events {
+		if event.CurrentStatus() != types.EventStatusPublished {
+			continue
+		}
+		next := utils.NextEventOccurrence(event, now)
+		if next == nil || next.StartTime.Before(now) {
+			continue
+		}
+		results = append(results, &utils.UpcomingEventResponse{
+			Event:          utils.TransformToRegisterEventResponse(event),
+			NextOccurrence: utils.OccurrenceInEventTimezone(*next, event.Timezone),
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].NextOccurrence.StartTime.Before(results[j].NextOccurrence.StartTime)
+	})
+	return results, nil
+}
+
+func (e *EventService) FindEventOccurrencesService(ctx context.Context, viewerID, eventID uuid.UUID, from, to time.Time, limit int) ([]utils.EventOccurrence, error) {
+	event, err := e.FindEventForViewerService(ctx, viewerID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if from.IsZero() {
+		from = time.Now().UTC()
+	}
+	if to.IsZero() {
+		to = from.AddDate(0, 0, 90)
+	}
+	if limit <= 0 {
+		limit = 100
+	}
+
+	occurrences := utils.ExpandEventOccurrences(event, from, to, limit)
+	for i := range occurrences {
+		occurrences[i] = utils.OccurrenceInEventTimezone(occurrences[i], event.Timezone)
+	}
+	return occurrences, nil
+}
+
+func (e *EventService) GenerateEventICSService(ctx context.Context, viewerID, eventID uuid.UUID) (string, error) {
+	event, err := e.FindEventForViewerService(ctx, viewerID, eventID)
+	if err != nil {
+		return "", err
+	}
+	return utils.GenerateEventICS(event, utils.ICSMethodPublish), nil
+}
+
+func (e *EventService) FindEventsNearService(ctx context.Context, latitude, longitude, radiusKm float64) ([]*utils.NearbyEventResponse, error) {
+	if radiusKm <= 0 {
+		radiusKm = configs.DefaultSearchRadiusKm
+	}
+
+	venues, err := e.venueRepository.FindVenuesWithinRadius(ctx, latitude, longitude, utils.KilometersToMeters(radiusKm))
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to search venues")
+	}
+
+	return e.findEventsAtVenues(ctx, venues, latitude, longitude)
+}
+
+func (e *EventService) FindEventsWithinBoundingBoxService(ctx context.Context, minLatitude, minLongitude, maxLatitude, maxLongitude float64) ([]*utils.NearbyEventResponse, error) {
+	venues, err := e.venueRepository.FindVenuesWithinBoundingBox(ctx, minLatitude, minLongitude, maxLatitude, maxLongitude)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to search venues")
+	}
+
+	// Distances are measured from the center of the box
+	return e.findEventsAtVenues(ctx, venues, (minLatitude+maxLatitude)/2, (minLongitude+maxLongitude)/2)
+}
+
+// findEventsAtVenues loads the published events that have not ended yet at the given venues, nearest venue first
+func (e *EventService) findEventsAtVenues(ctx context.Context, venues []*types.VenueType, latitude, longitude float64) ([]*utils.NearbyEventResponse, error) {
+	results := []*utils.NearbyEventResponse{}
+	if len(venues) == 0 {
+		return results, nil
+	}
+
+	venuesByID := make(map[uuid.UUID]*types.VenueType, len(venues))
+	venueIDs := make([]uuid.UUID, 0, len(venues))
+	for _, venue := range venues {
+		venuesByID[venue.ID] = venue
+		venueIDs = append(venueIDs, venue.ID)
+	}
+
+	events, err := e.repository.FindEventsByVenueIDs(ctx, venueIDs)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to find events for venues")
+	}
+
+	now := time.Now()
+	for _, event := range events {
+		if event.EndTime.Before(now) || event.VenueID == nil || event.CurrentStatus() != types.EventStatusPublished {
+			continue
+		}
+		venue := venuesByID[*event.VenueID]
+		results = append(results, &utils.NearbyEventResponse{
+			Event:      utils.TransformToRegisterEventResponse(event),
+			Venue:      utils.TransformToVenueResponse(venue),
+			DistanceKm: utils.HaversineDistance(latitude, longitude, venue.Latitude, venue.Longitude) / 1000,
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].DistanceKm == results[j].DistanceKm {
+			return results[i].Event.StartTime.Before(results[j].Event.StartTime)
+		}
+		return results[i].DistanceKm < results[j].DistanceKm
+	})
+	return results, nil
+}
+
+// findScheduleConflicts lists the existing events that double-book the candidate's venue or organizer.
+// Every booking blocks its configured setup and teardown buffers, so two events clash when their buffered slots overlap.
+func (e *EventService) findScheduleConflicts(ctx context.Context, candidate *types.EventType) ([]*utils.EventConflictResponse, error) {
+	conflicts := []*utils.EventConflictResponse{}
+
+	occurrences := utils.ExpandEventOccurrences(candidate, candidate.StartTime, candidate.StartTime.AddDate(conflictCheckHorizonYears, 0, 0), conflictCheckLimit)
+	if len(occurrences) == 0 {
+		return conflicts, nil
+	}
+
+	before, after := configs.EventBufferBefore, configs.EventBufferAfter
+	windowStart := occurrences[0].StartTime.Add(-before - after)
+	windowEnd := occurrences[len(occurrences)-1].EndTime.Add(before + after)
+
+	// A venue or an organizer is double-booked whichever tenant the other event belongs to
+	existing, err := e.repository.FindOverlappingEvents(repositories.WithoutTenantScope(ctx), candidate.VenueID, &candidate.OrganizerID, windowStart, windowEnd)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to check for scheduling conflicts")
+	}
+
+	for _, other := range existing {
+		if other.ID == candidate.ID {
+			continue
+		}
+
+		reasons := []string{}
+		if candidate.VenueID != nil && other.VenueID != nil && *candidate.VenueID == *other.VenueID {
+			reasons = append(reasons, utils.ConflictReasonVenue)
+		}
+		if candidate.OrganizerID == other.OrganizerID {
+			reasons = append(reasons, utils.ConflictReasonOrganizer)
+		}
+		if len(reasons) == 0 {
+			continue
+		}
+
+		if clash, ok := firstClashingOccurrence(occurrences, utils.ExpandEventOccurrences(other, windowStart, windowEnd, 0), before, after); ok {
+			conflicts = append(conflicts, utils.NewEventConflictResponse(other, clash, reasons))
+		}
+	}
+
+	sort.SliceStable(conflicts, func(i, j int) bool {
+		return conflicts[i].StartTime.Before(conflicts[j].StartTime)
+	})
+	return conflicts, nil
+}
+
+// firstClashingOccurrence returns the first of the existing occurrences whose buffered slot overlaps a candidate occurrence
+func firstClashingOccurrence(candidate, existing []utils.EventOccurrence, before, after time.Duration) (utils.EventOccurrence, bool) {
+	for _, other := range existing {
+		otherSlot := utils.TimeSlot{StartTime: other.StartTime, EndTime: other.EndTime}.WithBuffer(before, after)
+		for _, occurrence := range candidate {
+			slot := utils.TimeSlot{StartTime: occurrence.StartTime, EndTime: occurrence.EndTime}.WithBuffer(before, after)
+			if slot.Overlaps(otherSlot) {
+				return other, true
+			}
+		}
+	}
+	return utils.EventOccurrence{}, false
+}
+
+// resolveConflictMode falls back to the configured conflict mode, and to rejecting when none is configured
+func resolveConflictMode(mode string) string {
+	if mode == "" {
+		mode = configs.DefaultConflictMode
+	}
+	if mode == utils.ConflictModeWarn {
+		return utils.ConflictModeWarn
+	}
+	return utils.ConflictModeReject
+}
+
+// validateRescheduledRecurrence makes sure a recurring series still has occurrences after moving its first one
+func validateRescheduledRecurrence(rule *types.EventRecurrenceType, startTime time.Time) error {
+	if rule != nil && rule.Until != nil && rule.Until.Before(startTime) {
+		return newerrors.NewValidationError("new start time is after the end of the recurrence")
+	}
+	return nil
+}
+
+// planEventReminders re-plans the reminders of an event after it changed; a failure is logged and does not undo the change
+func (e *EventService) planEventReminders(ctx context.Context, event *types.EventType) {
+	if err := e.reminderService.PlanEventRemindersService(ctx, event); err != nil {
+		log.Printf("Failed to plan reminders for event %s: %v", event.ID, err)
+	}
+}
+
+// auditEvent records an action on an event with the fields it changed since the before snapshot
+func (e *EventService) auditEvent(ctx context.Context, actorID uuid.UUID, action string, before map[string]json.RawMessage, event *types.EventType) {
+	changes := utils.AuditDiff(before, utils.AuditSnapshot(event))
+	recordAudit(ctx, e.auditService, actorID, action, types.AuditTargetEvent, event.ID, changes)
+}
+
+// publishEventChange tells subscribers such as webhooks that an event was created or changed
+func (e *EventService) publishEventChange(eventType string, event *types.EventType, data map[string]interface{}) {
+	payload := map[string]interface{}{
+		"title":        event.Title,
+		"status":       event.CurrentStatus(),
+		"start_time":   event.StartTime,
+		"end_time":     event.EndTime,
+		"timezone":     event.Timezone,
+		"location":     event.Location,
+		"organizer_id": event.OrganizerID,
+	}
+	if event.VenueID != nil {
+		payload["venue_id"] = *event.VenueID
+	}
+	for key, value := range data {
+		payload[key] = value
+	}
+	e.eventBus.Publish(types.NewDomainEvent(eventType, event.ID, payload))
+}
+
+// publishEventStatusChange publishes a lifecycle transition; cancellations get their own event type
+func (e *EventService) publishEventStatusChange(event *types.EventType, change *types.EventStatusChangeType) {
+	eventType := types.DomainEventEventUpdated
+	if change.To == types.EventStatusCancelled {
+		eventType = types.DomainEventEventCancelled
+	}
+	e.publishEventChange(eventType, event, map[string]interface{}{
+		"change":          "status",
+		"previous_status": change.From,
+		"reason":          change.Reason,
+	})
+}
+
+// applyEventStatusChange mirrors a stored lifecycle transition onto an already loaded event
+func applyEventStatusChange(event *types.EventType, change *types.EventStatusChangeType) {
+	event.Status = change.To
+	if change.To == types.EventStatusCancelled {
+		event.CancellationReason = change.Reason
+		event.Sequence++
+	}
+	event.StatusHistory = append(event.StatusHistory, *change)
+}
 
-	return createdEvent, nil
+// sortEventsByStartTime orders events with the latest start first
+func sortEventsByStartTime(events []*types.EventType) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].StartTime.After(events[j].StartTime)
+	})
 }
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lordofthemind/EventureGo/internals/types"
@@ -10,11 +11,27 @@ import (
 
 // EventServiceInterface defines the methods required for managing events
 type EventServiceInterface interface {
-	// CreateEventService handles the creation of a new event
-	CreateEventService(ctx context.Context, OrganizerID uuid.UUID, event *utils.EventDTO) (*types.EventType, error)
+	// CreateEventService handles the creation of a new event, checking the venue and organizer for double bookings.
+	// The clashing events are returned with a ConflictError in "reject" mode, or alongside the saved event in "warn" mode.
+	CreateEventService(ctx context.Context, OrganizerID uuid.UUID, event *utils.EventDTO) (*types.EventType, []*utils.EventConflictResponse, error)
 
-	// // FindEventByIDService retrieves an event by its unique identifier
-	// FindEventByIDService(ctx context.Context, eventID uuid.UUID) (*types.EventType, error)
+	// FindEventsNearService retrieves upcoming and ongoing published events held at venues within radiusKm of a point
+	FindEventsNearService(ctx context.Context, latitude, longitude, radiusKm float64) ([]*utils.NearbyEventResponse, error)
+
+	// FindEventsWithinBoundingBoxService retrieves upcoming and ongoing published events held at venues inside a bounding box
+	FindEventsWithinBoundingBoxService(ctx context.Context, minLatitude, minLongitude, maxLatitude, maxLongitude float64) ([]*utils.NearbyEventResponse, error)
+
+	// FindEventByIDService retrieves an event by its unique identifier
+	FindEventByIDService(ctx context.Context, eventID uuid.UUID) (*types.EventType, error)
+
+	// FindEventForViewerService retrieves an event the viewer may see; drafts are only visible to their organizer
+	FindEventForViewerService(ctx context.Context, viewerID, eventID uuid.UUID) (*types.EventType, error)
+
+	// FindEventOccurrencesService expands an event into its occurrences within [from, to), rendered in the event's timezone
+	FindEventOccurrencesService(ctx context.Context, viewerID, eventID uuid.UUID, from, to time.Time, limit int) ([]utils.EventOccurrence, error)
+
+	// GenerateEventICSService renders an event as an iCalendar document in the event's timezone
+	GenerateEventICSService(ctx context.Context, viewerID, eventID uuid.UUID) (string, error)
 
 	// // FindEventsByOrganizerIDService retrieves all events created by a specific organizer
 	// FindEventsByOrganizerIDService(ctx context.Context, organizerID uuid.UUID) ([]*types.EventType, error)
@@ -25,8 +42,8 @@ type EventServiceInterface interface {
 	// // DeleteEventByIDService removes an event from the system by its unique identifier
 	// DeleteEventByIDService(ctx context.Context, eventID uuid.UUID) error
 
-	// // FindAllEventsService retrieves all events in the system
-	// FindAllEventsService(ctx context.Context) ([]*types.EventType, error)
+	// FindAllEventsService retrieves all events in the system, drafts included, for administrators
+	FindAllEventsService(ctx context.Context) ([]*types.EventType, error)
 
 	// // FindEventsByDateRangeService retrieves events occurring within a specified date range
 	// FindEventsByDateRangeService(ctx context.Context, startDate, endDate time.Time) ([]*types.EventType, error)
@@ -43,8 +60,8 @@ type EventServiceInterface interface {
 	// // DeactivateEventService deactivates an event, making it inactive
 	// DeactivateEventService(ctx context.Context, eventID uuid.UUID) error
 
-	// // FindUpcomingEventsService retrieves events scheduled for future dates
-	// FindUpcomingEventsService(ctx context.Context) ([]*types.EventType, error)
+	// FindUpcomingEventsService retrieves published events with a future occurrence, ordered by their next occurrence
+	FindUpcomingEventsService(ctx context.Context) ([]*utils.UpcomingEventResponse, error)
 
 	// // FindPastEventsService retrieves events that have already occurred
 	// FindPastEventsService(ctx context.Context) ([]*types.EventType, error)
@@ -55,14 +72,24 @@ type EventServiceInterface interface {
 	// // FindInactiveEventsService retrieves all inactive events
 	// FindInactiveEventsService(ctx context.Context) ([]*types.EventType, error)
 
-	// // SearchEventsByTitleService searches for events by their title
-	// SearchEventsByTitleService(ctx context.Context, title string) ([]*types.EventType, error)
+	// SearchEventsByTitleService searches all events, drafts included, by part of their title, for administrators
+	SearchEventsByTitleService(ctx context.Context, title string) ([]*types.EventType, error)
+
+	// CompleteEndedEventsService marks published events whose last occurrence has ended as Completed and
+	// returns how many were completed
+	CompleteEndedEventsService(ctx context.Context) (int, error)
+
+	// ChangeEventStatusService moves an event through its lifecycle (publish, postpone, cancel, complete),
+	// recording the actor and time, and notifies guests when the event is cancelled or postponed unless suppressed
+	ChangeEventStatusService(ctx context.Context, organizerID, eventID uuid.UUID, status *utils.EventStatusChangeDTO) (*types.EventType, error)
 
-	// // CancelEventService cancels an event, marking it as canceled
-	// CancelEventService(ctx context.Context, eventID uuid.UUID) error
+	// ChangeEventSlugService gives the public page of an event a new shareable name; the old link stops working.
+	// Slugs are unique across events.
+	ChangeEventSlugService(ctx context.Context, organizerID, eventID uuid.UUID, slug string) (*types.EventType, error)
 
-	// // RescheduleEventService reschedules an event to a new time slot
-	// RescheduleEventService(ctx context.Context, eventID uuid.UUID, newStartTime, newEndTime time.Time) error
+	// RescheduleEventService moves an event to a new time slot, running the same double-booking checks as CreateEventService.
+	// Rescheduling a postponed event publishes it again. Guests get the old and new time with an updated .ics unless suppressed.
+	RescheduleEventService(ctx context.Context, organizerID, eventID uuid.UUID, reschedule *utils.RescheduleEventDTO) (*types.EventType, []*utils.EventConflictResponse, error)
 
 	// // CountTotalEventsService returns the total number of events in the system
 	// CountTotalEventsService(ctx context.Context) (int64, error)
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// findEventForStaff loads an event for someone working at it: its organizer, organization members with at least
+// minimumRole and anyone the event was shared with always may, other users only with one of the configured staff
+// roles. Staff work at the events of every tenant, so the returned context, which the caller should use for its
+// further queries about the event, is no longer limited to one for them. forbiddenMessage explains a refusal.
+func findEventForStaff(
+	ctx context.Context,
+	eventRepository repositories.EventRepositoryInterface,
+	superUserRepository repositories.SuperUserRepositoryInterface,
+	organizationRepository repositories.OrganizationRepositoryInterface,
+	eventGrantRepository repositories.EventGrantRepositoryInterface,
+	userID, eventID uuid.UUID,
+	minimumRole string,
+	forbiddenMessage string,
+) (context.Context, *types.EventType, error) {
+	staff := isCheckInStaff(ctx, superUserRepository, userID)
+	if staff {
+		ctx = repositories.WithoutTenantScope(ctx)
+	}
+
+	event, err := eventRepository.FindEventByID(ctx, eventID)
+	if err != nil || event == nil {
+		return nil, nil, newerrors.NewValidationError("event not found")
+	}
+	if staff || hasEventAccess(ctx, organizationRepository, eventGrantRepository, userID, event, minimumRole, types.EventGrantRoleCheckIn) {
+		return ctx, event, nil
+	}
+	return nil, nil, newerrors.NewForbiddenError(forbiddenMessage)
+}
+
+// isCheckInStaff reports whether the user is an active account with one of the configured staff roles
+func isCheckInStaff(ctx context.Context, superUserRepository repositories.SuperUserRepositoryInterface, userID uuid.UUID) bool {
+	staff, err := superUserRepository.FindSuperUserByID(ctx, userID)
+	if err != nil || staff == nil || !staff.IsActive {
+		return false
+	}
+	for _, role := range configs.CheckInStaffRoles {
+		if staff.Role == role {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// FakePaymentProviderName is the name of the built-in fake provider in the configuration and on orders
+const FakePaymentProviderName = "fake"
+
+// FakePaymentProvider keeps payments in memory without moving any money, for development and tests.
+// With autoApprove every payment succeeds right away; otherwise it stays pending until CompletePayment is called.
+type FakePaymentProvider struct {
+	mu          sync.Mutex
+	autoApprove bool
+	payments    map[string]*types.PaymentType
+}
+
+func NewFakePaymentProvider(autoApprove bool) *FakePaymentProvider {
+	return &FakePaymentProvider{
+		autoApprove: autoApprove,
+		payments:    make(map[string]*types.PaymentType),
+	}
+}
+
+func (f *FakePaymentProvider) Name() string {
+	return FakePaymentProviderName
+}
+
+func (f *FakePaymentProvider) CreatePayment(ctx context.Context, order *types.OrderType) (*types.PaymentType, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	payment := &types.PaymentType{
+		ID:          "fake_" + uuid.NewString(),
+		Status:      types.PaymentStatusPending,
+		AmountCents: order.TotalCents,
+		Currency:    order.Currency,
+	}
+	if f.autoApprove {
+		payment.Status = types.PaymentStatusSucceeded
+	}
+	f.payments[payment.ID] = payment
+
+	cloned := *payment
+	return &cloned, nil
+}
+
+func (f *FakePaymentProvider) FindPayment(ctx context.Context, paymentID string) (*types.PaymentType, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	payment, exists := f.payments[paymentID]
+	if !exists {
+		return nil, errors.New("payment not found")
+	}
+	cloned := *payment
+	return &cloned, nil
+}
+
+func (f *FakePaymentProvider) CancelPayment(ctx context.Context, paymentID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	payment, exists := f.payments[paymentID]
+	if !exists {
+		return errors.New("payment not found")
+	}
+	if payment.Status == types.PaymentStatusPending {
+		payment.Status = types.PaymentStatusFailed
+	}
+	return nil
+}
+
+func (f *FakePaymentProvider) RefundPayment(ctx context.Context, paymentID string, amountCents int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	payment, exists := f.payments[paymentID]
+	if !exists {
+		return errors.New("payment not found")
+	}
+	if payment.Status != types.PaymentStatusSucceeded {
+		return errors.New("only succeeded payments can be refunded")
+	}
+	if amountCents > payment.AmountCents {
+		return errors.New("refund exceeds the payment amount")
+	}
+	payment.Status = types.PaymentStatusRefunded
+	return nil
+}
+
+// CompletePayment settles a pending payment as if the buyer had paid (succeeded) or given up
+func (f *FakePaymentProvider) CompletePayment(paymentID string, succeeded bool) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	payment, exists := f.payments[paymentID]
+	if !exists {
+		return errors.New("payment not found")
+	}
+	if payment.Status != types.PaymentStatusPending {
+		return errors.New("payment is no longer pending")
+	}
+	payment.Status = types.PaymentStatusFailed
+	if succeeded {
+		payment.Status = types.PaymentStatusSucceeded
+	}
+	return nil
+}
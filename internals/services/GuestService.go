@@ -0,0 +1,506 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/mail"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// guestImportBatchSize caps how many guests are written per AddBulkGuest call
+const guestImportBatchSize = 500
+
+type GuestService struct {
+	repository             repositories.GuestRepositoryInterface
+	eventRepository        repositories.EventRepositoryInterface
+	venueRepository        repositories.VenueRepositoryInterface
+	formRepository         repositories.RegistrationFormRepositoryInterface
+	organizationRepository repositories.OrganizationRepositoryInterface
+	eventGrantRepository   repositories.EventGrantRepositoryInterface
+	ticketService          TicketServiceInterface
+	eventBus               EventBusServiceInterface
+	auditService           AuditServiceInterface
+}
+
+func NewGuestService(
+	repository repositories.GuestRepositoryInterface,
+	eventRepository repositories.EventRepositoryInterface,
+	venueRepository repositories.VenueRepositoryInterface,
+	formRepository repositories.RegistrationFormRepositoryInterface,
+	organizationRepository repositories.OrganizationRepositoryInterface,
+	eventGrantRepository repositories.EventGrantRepositoryInterface,
+	ticketService TicketServiceInterface,
+	eventBus EventBusServiceInterface,
+	auditService AuditServiceInterface,
+) GuestServiceInterface {
+	return &GuestService{
+		repository:             repository,
+		eventRepository:        eventRepository,
+		venueRepository:        venueRepository,
+		formRepository:         formRepository,
+		organizationRepository: organizationRepository,
+		eventGrantRepository:   eventGrantRepository,
+		ticketService:          ticketService,
+		eventBus:               eventBus,
+		auditService:           auditService,
+	}
+}
+
+func (g *GuestService) UpdateRSVPService(ctx context.Context, organizerID, eventID, guestID uuid.UUID, rsvpDTO *utils.UpdateRSVPDTO) (*types.GuestType, error) {
+	event, err := g.eventRepository.FindEventByID(ctx, eventID)
+	if err != nil || event == nil {
+		return nil, newerrors.NewValidationError("event not found")
+	}
+	if !hasEventAccess(ctx, g.organizationRepository, g.eventGrantRepository, organizerID, event, types.OrganizationRoleEditor, types.EventGrantRoleEditor) {
+		return nil, newerrors.NewForbiddenError("only the organizer or an editor can record RSVPs for this event")
+	}
+	guest, err := g.repository.FindGuestByID(ctx, guestID)
+	if err != nil || guest == nil || guest.EventID != eventID {
+		return nil, newerrors.NewValidationError("guest not found")
+	}
+	if status := event.CurrentStatus(); rsvpDTO.Status == types.RSVPStatusAccepted &&
+		(status == types.EventStatusCancelled || status == types.EventStatusCompleted) {
+		return nil, newerrors.NewValidationError(fmt.Sprintf("invitations to a %s event cannot be accepted", strings.ToLower(status)))
+	}
+
+	before := utils.AuditSnapshot(guest)
+	answersChanged, err := g.applyRegistrationAnswers(ctx, guest, rsvpDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	previousStatus := guest.RSVPStatus
+	status := rsvpDTO.Status
+	if status == types.RSVPStatusAccepted && previousStatus != types.RSVPStatusAccepted {
+		full, err := g.eventIsFull(ctx, event)
+		if err != nil {
+			return nil, err
+		}
+		if full {
+			status = types.RSVPStatusWaitlisted
+		}
+	}
+	if status == previousStatus {
+		// The status stays, and a waitlisted guest keeps their place in the queue
+		if answersChanged {
+			if err := g.repository.UpdateGuest(ctx, guest); err != nil {
+				return nil, newerrors.Wrap(err, "failed to update guest")
+			}
+			g.auditGuest(ctx, organizerID, types.AuditActionGuestRSVPUpdated, before, guest)
+		}
+		return guest, nil
+	}
+
+	if err := g.changeRSVP(ctx, event, guest, status); err != nil {
+		return nil, err
+	}
+	g.auditGuest(ctx, organizerID, types.AuditActionGuestRSVPUpdated, before, guest)
+
+	// A guest giving up their seat lets the longest-waiting guest in
+	if previousStatus == types.RSVPStatusAccepted && status != types.RSVPStatusAccepted {
+		if err := g.promoteWaitlistedGuest(ctx, event); err != nil {
+			log.Printf("Failed to promote a waitlisted guest of event %s: %v", event.ID, err)
+		}
+	}
+	return guest, nil
+}
+
+func (g *GuestService) ImportGuestsService(ctx context.Context, organizerID, eventID uuid.UUID, importDTO *utils.ImportGuestsDTO) (*utils.GuestImportReport, error) {
+	event, err := g.eventRepository.FindEventByID(ctx, eventID)
+	if err != nil || event == nil {
+		return nil, newerrors.NewValidationError("event not found")
+	}
+	if !hasEventAccess(ctx, g.organizationRepository, g.eventGrantRepository, organizerID, event, types.OrganizationRoleEditor, types.EventGrantRoleEditor) {
+		return nil, newerrors.NewForbiddenError("only the organizer or an editor can import guests for this event")
+	}
+	if status := event.CurrentStatus(); status == types.EventStatusCancelled || status == types.EventStatusCompleted {
+		return nil, newerrors.NewValidationError(fmt.Sprintf("guests cannot be added to a %s event", strings.ToLower(status)))
+	}
+
+	if len(importDTO.Rows) == 0 {
+		return nil, newerrors.NewValidationError("guest list is empty")
+	}
+	if len(importDTO.Rows)-1 > utils.MaxGuestImportRows {
+		return nil, newerrors.NewValidationError(fmt.Sprintf("guest list has more than %d rows", utils.MaxGuestImportRows))
+	}
+
+	header := importDTO.Rows[0]
+	columns, err := utils.MapGuestImportColumns(header, importDTO.EmailColumn, importDTO.NameColumn)
+	if err != nil {
+		return nil, newerrors.NewValidationError(err.Error())
+	}
+
+	existingGuests, err := g.repository.FindGuestsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load guests")
+	}
+	// Emails are compared case-insensitively; the value is the row that first used the email, 0 for existing guests
+	seen := make(map[string]int, len(existingGuests))
+	for _, guest := range existingGuests {
+		seen[strings.ToLower(guest.Email)] = 0
+	}
+
+	report := newGuestImportReport(eventID, importDTO.DryRun, header, columns)
+	var pendingGuests []*types.GuestType
+	var pendingResults []*utils.GuestImportRowResult
+
+	for index, row := range importDTO.Rows[1:] {
+		if isBlankGuestImportRow(row) {
+			continue
+		}
+
+		result := &utils.GuestImportRowResult{Row: index + 2}
+		report.Rows = append(report.Rows, result)
+		report.TotalRows++
+
+		email, emailErr := normalizeGuestEmail(utils.GuestImportCell(row, columns.Email))
+		result.Email = email
+		result.FullName = utils.GuestImportCell(row, columns.Name)
+		if emailErr != nil {
+			result.Status, result.Error = utils.GuestImportRowInvalid, emailErr.Error()
+			report.Invalid++
+			continue
+		}
+		if result.FullName == "" {
+			// Many guest lists only carry emails, so fall back to the mailbox name
+			result.FullName = email[:strings.Index(email, "@")]
+		}
+
+		if firstRow, duplicate := seen[email]; duplicate {
+			result.Status = utils.GuestImportRowDuplicate
+			result.Error = "already on the guest list"
+			if firstRow > 0 {
+				result.Error = fmt.Sprintf("same email as row %d", firstRow)
+			}
+			report.Duplicates++
+			continue
+		}
+		seen[email] = result.Row
+
+		guest := types.NewGuest(email, result.FullName)
+		guest.AttachToEvent(event)
+		guest.CustomFields = guestImportCustomFields(row, columns)
+		pendingGuests = append(pendingGuests, guest)
+		pendingResults = append(pendingResults, result)
+	}
+
+	if importDTO.DryRun {
+		for _, result := range pendingResults {
+			result.Status = utils.GuestImportRowValid
+		}
+		report.Valid = len(pendingResults)
+		return report, nil
+	}
+
+	// Write in batches so one bad batch does not lose the rest of the list
+	for start := 0; start < len(pendingGuests); start += guestImportBatchSize {
+		end := start + guestImportBatchSize
+		if end > len(pendingGuests) {
+			end = len(pendingGuests)
+		}
+
+		batch := pendingGuests[start:end]
+		now := time.Now()
+		for _, guest := range batch {
+			guest.InvitedAt = now
+		}
+
+		_, err := g.repository.AddBulkGuest(ctx, batch)
+		for offset, result := range pendingResults[start:end] {
+			if err != nil {
+				result.Status, result.Error = utils.GuestImportRowFailed, err.Error()
+				report.Failed++
+				continue
+			}
+			guestID := batch[offset].ID
+			result.Status, result.GuestID = utils.GuestImportRowImported, &guestID
+			report.Imported++
+		}
+	}
+
+	if report.Imported > 0 {
+		g.eventBus.Publish(types.NewDomainEvent(types.DomainEventGuestsImported, eventID, map[string]interface{}{
+			"imported": report.Imported,
+		}))
+		// One record for the whole list rather than one per guest; the target is the event the list belongs to
+		recordAudit(ctx, g.auditService, organizerID, types.AuditActionGuestsImported, types.AuditTargetEvent, eventID, map[string]*types.AuditChangeType{
+			"imported_guests": types.NewAuditChange(nil, report.Imported),
+		})
+	}
+
+	return report, nil
+}
+
+func (g *GuestService) ExportGuestsService(ctx context.Context, organizerID, eventID uuid.UUID, exportDTO *utils.GuestExportDTO, w io.Writer) error {
+	event, err := g.eventRepository.FindEventByID(ctx, eventID)
+	if err != nil || event == nil {
+		return newerrors.NewValidationError("event not found")
+	}
+	if !hasEventAccess(ctx, g.organizationRepository, g.eventGrantRepository, organizerID, event, types.OrganizationRoleViewer, types.EventGrantRoleEditor) {
+		return newerrors.NewForbiddenError("only the organizer, an organization member or an event editor can export the guests of this event")
+	}
+
+	// Registration form answers come first, in form order. A first pass then collects the custom field columns,
+	// so the header can be written before any guest.
+	var columns []utils.GuestExportColumn
+	if exportDTO.Format != utils.GuestExportFormatPDF {
+		form, err := g.formRepository.FindRegistrationFormByEventID(ctx, eventID)
+		if err != nil {
+			return newerrors.Wrap(err, "failed to load registration form")
+		}
+		titles := make(map[string]struct{})
+		if form != nil {
+			for _, field := range form.Fields {
+				columns = append(columns, utils.GuestExportColumn{Title: field.Label, RegistrationField: field.Key})
+				titles[field.Label] = struct{}{}
+			}
+		}
+
+		fieldSet := make(map[string]struct{})
+		err = g.repository.StreamGuestsByEventID(ctx, eventID, exportDTO.RSVPStatus, exportDTO.CheckedIn, func(guest *types.GuestType) error {
+			for field := range guest.CustomFields {
+				fieldSet[field] = struct{}{}
+			}
+			return nil
+		})
+		if err != nil {
+			return newerrors.Wrap(err, "failed to load guests")
+		}
+		var customFields []string
+		for field := range fieldSet {
+			// A custom field named like a form question fills that column for guests who have not answered it
+			if _, taken := titles[field]; !taken {
+				customFields = append(customFields, field)
+			}
+		}
+		sort.Strings(customFields)
+		for _, field := range customFields {
+			columns = append(columns, utils.GuestExportColumn{Title: field})
+		}
+	}
+
+	exportWriter, err := utils.NewGuestExportWriter(exportDTO.Format, w, event, columns)
+	if err != nil {
+		return newerrors.Wrap(err, "failed to start guest export")
+	}
+	err = g.repository.StreamGuestsByEventID(ctx, eventID, exportDTO.RSVPStatus, exportDTO.CheckedIn, exportWriter.WriteGuest)
+	if err != nil {
+		exportWriter.Close()
+		return newerrors.Wrap(err, "failed to export guests")
+	}
+	if err := exportWriter.Close(); err != nil {
+		return newerrors.Wrap(err, "failed to finish guest export")
+	}
+	return nil
+}
+
+func (g *GuestService) ConfirmGuestRegistrationService(ctx context.Context, event *types.EventType, guest *types.GuestType) (*types.GuestType, error) {
+	if guest.RSVPStatus == types.RSVPStatusAccepted || guest.RSVPStatus == types.RSVPStatusWaitlisted {
+		// Already holding a seat or a place in the queue; only the name and answers change
+		if err := g.repository.UpdateGuest(ctx, guest); err != nil {
+			return nil, newerrors.Wrap(err, "failed to update guest")
+		}
+		return guest, nil
+	}
+
+	// A full event waitlists the guest instead
+	if err := g.changeRSVP(ctx, event, guest, types.RSVPStatusAccepted); err != nil {
+		return nil, err
+	}
+	return guest, nil
+}
+
+func (g *GuestService) FindEventGuestsService(ctx context.Context, eventID uuid.UUID) ([]*types.GuestType, error) {
+	guests, err := g.repository.FindGuestsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to find guests")
+	}
+	sort.SliceStable(guests, func(i, j int) bool { return guests[i].Email < guests[j].Email })
+	return guests, nil
+}
+
+// applyRegistrationAnswers checks the answers sent with an RSVP against the event's registration form and
+// stores them on the guest, replacing earlier answers. Accepting requires every required field to be answered,
+// whether now or before. It reports whether the answers changed.
+func (g *GuestService) applyRegistrationAnswers(ctx context.Context, guest *types.GuestType, rsvpDTO *utils.UpdateRSVPDTO) (bool, error) {
+	if rsvpDTO.Answers == nil && rsvpDTO.Status != types.RSVPStatusAccepted {
+		return false, nil
+	}
+	form, err := g.formRepository.FindRegistrationFormByEventID(ctx, guest.EventID)
+	if err != nil {
+		return false, newerrors.Wrap(err, "failed to load registration form")
+	}
+	if form == nil {
+		if len(rsvpDTO.Answers) > 0 {
+			return false, newerrors.NewValidationError("event has no registration form")
+		}
+		return false, nil
+	}
+
+	answers := guest.RegistrationAnswers
+	if rsvpDTO.Answers != nil {
+		if answers, err = utils.ParseRegistrationAnswers(form, rsvpDTO.Answers); err != nil {
+			return false, newerrors.NewValidationError(err.Error())
+		}
+	}
+	if rsvpDTO.Status == types.RSVPStatusAccepted {
+		if field := utils.MissingRegistrationAnswer(form, answers); field != nil {
+			return false, newerrors.NewValidationError(fmt.Sprintf("%s is required", field.Label))
+		}
+	}
+	if rsvpDTO.Answers == nil {
+		return false, nil
+	}
+	guest.RegistrationAnswers = answers
+	return true, nil
+}
+
+// changeRSVP stores a new RSVP status, keeps the guest's ticket in line with it and announces the change.
+// A guest accepting an event whose last seat was just taken is waitlisted instead; one already waitlisted keeps
+// their place in the queue.
+func (g *GuestService) changeRSVP(ctx context.Context, event *types.EventType, guest *types.GuestType, status string) error {
+	previousStatus, previousUpdatedAt := guest.RSVPStatus, guest.UpdatedAt
+	guest.RSVPStatus = status
+	guest.UpdatedAt = time.Now() // Orders the waitlist
+	var err error
+	if status == types.RSVPStatusAccepted {
+		// The repository takes the seat, so concurrent acceptances cannot overbook the event
+		err = g.repository.AcceptGuestWithinCapacity(ctx, guest, eventCapacity(ctx, g.venueRepository, event))
+		if errors.Is(err, repositories.ErrEventFull) {
+			status = types.RSVPStatusWaitlisted
+			guest.RSVPStatus = status
+			if previousStatus == types.RSVPStatusWaitlisted {
+				guest.UpdatedAt = previousUpdatedAt
+			}
+			err = g.repository.UpdateGuest(ctx, guest)
+		}
+	} else {
+		err = g.repository.UpdateGuest(ctx, guest)
+	}
+	if err != nil {
+		return newerrors.Wrap(err, "failed to update guest")
+	}
+	if status == previousStatus {
+		return nil
+	}
+
+	// Only guests who accepted hold a ticket
+	if status == types.RSVPStatusAccepted {
+		if _, err := g.ticketService.IssueGuestTicketService(ctx, event, guest); err != nil {
+			log.Printf("Failed to issue ticket to guest %s: %v", guest.ID, err)
+		}
+	} else if err := g.ticketService.CancelGuestTicketService(ctx, guest.ID, "RSVP changed to "+status); err != nil {
+		log.Printf("Failed to revoke ticket of guest %s: %v", guest.ID, err)
+	}
+
+	g.eventBus.Publish(types.NewDomainEvent(types.DomainEventGuestRSVPChanged, event.ID, map[string]interface{}{
+		"guest_id":             guest.ID,
+		"rsvp_status":          status,
+		"previous_rsvp_status": previousStatus,
+	}))
+	return nil
+}
+
+// eventIsFull reports whether every seat at the venue of an event is taken; events without a capacity never fill up
+func (g *GuestService) eventIsFull(ctx context.Context, event *types.EventType) (bool, error) {
+	capacity := eventCapacity(ctx, g.venueRepository, event)
+	if capacity <= 0 {
+		return false, nil
+	}
+	counts, err := g.repository.CountGuestsByRSVPStatus(ctx, event.ID)
+	if err != nil {
+		return false, newerrors.Wrap(err, "failed to count RSVPs")
+	}
+	return counts[types.RSVPStatusAccepted] >= int64(capacity), nil
+}
+
+// promoteWaitlistedGuest accepts the longest-waiting guest when a seat is free
+func (g *GuestService) promoteWaitlistedGuest(ctx context.Context, event *types.EventType) error {
+	full, err := g.eventIsFull(ctx, event)
+	if err != nil || full {
+		return err
+	}
+	next, err := g.repository.FindNextWaitlistedGuest(ctx, event.ID)
+	if err != nil || next == nil {
+		return err
+	}
+	return g.changeRSVP(ctx, event, next, types.RSVPStatusAccepted)
+}
+
+// auditGuest records an action on a guest with the fields it changed since the before snapshot
+func (g *GuestService) auditGuest(ctx context.Context, actorID uuid.UUID, action string, before map[string]json.RawMessage, guest *types.GuestType) {
+	changes := utils.AuditDiff(before, utils.AuditSnapshot(guest))
+	recordAudit(ctx, g.auditService, actorID, action, types.AuditTargetGuest, guest.ID, changes)
+}
+
+// newGuestImportReport starts an empty report describing how the header was mapped
+func newGuestImportReport(eventID uuid.UUID, dryRun bool, header []string, columns *utils.GuestImportColumns) *utils.GuestImportReport {
+	report := &utils.GuestImportReport{
+		EventID:      eventID,
+		DryRun:       dryRun,
+		EmailColumn:  strings.TrimSpace(header[columns.Email]),
+		CustomFields: []string{},
+		Rows:         []*utils.GuestImportRowResult{},
+	}
+	if columns.Name >= 0 {
+		report.NameColumn = strings.TrimSpace(header[columns.Name])
+	}
+
+	indexes := make([]int, 0, len(columns.CustomFields))
+	for index := range columns.CustomFields {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	for _, index := range indexes {
+		report.CustomFields = append(report.CustomFields, columns.CustomFields[index])
+	}
+	return report
+}
+
+// normalizeGuestEmail lower-cases a bare email address, rejecting display names and malformed addresses
+func normalizeGuestEmail(email string) (string, error) {
+	if email == "" {
+		return "", fmt.Errorf("email is required")
+	}
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email || address.Name != "" {
+		return email, fmt.Errorf("invalid email address")
+	}
+	return strings.ToLower(email), nil
+}
+
+// guestImportCustomFields collects the non-empty custom field cells of a row, or nil when there are none
+func guestImportCustomFields(row []string, columns *utils.GuestImportColumns) map[string]string {
+	var fields map[string]string
+	for index, title := range columns.CustomFields {
+		if value := utils.GuestImportCell(row, index); value != "" {
+			if fields == nil {
+				fields = make(map[string]string)
+			}
+			fields[title] = value
+		}
+	}
+	return fields
+}
+
+// isBlankGuestImportRow reports whether every cell of a row is empty, as spreadsheets often end with blank rows
+func isBlankGuestImportRow(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,34 @@
+package services
+
+import (
+	"context"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// GuestServiceInterface defines the methods for managing the guest lists of events
+type GuestServiceInterface interface {
+	// UpdateRSVPService records a guest's answer to the invitation. Accepting issues and emails a ticket,
+	// or puts the guest on the waitlist when the venue is full; any other answer revokes the ticket the guest
+	// may hold and gives the seat to the longest-waiting guest. Answers sent along are checked against the event's
+	// registration form and replace the guest's earlier ones; accepting requires every required field to be answered.
+	UpdateRSVPService(ctx context.Context, organizerID, eventID, guestID uuid.UUID, rsvpDTO *utils.UpdateRSVPDTO) (*types.GuestType, error)
+
+	// ImportGuestsService adds the guests of a parsed CSV or XLSX guest list to an event and reports every row.
+	// Invalid emails and guests already on the list or repeated in the file are skipped; a dry run writes nothing.
+	ImportGuestsService(ctx context.Context, organizerID, eventID uuid.UUID, importDTO *utils.ImportGuestsDTO) (*utils.GuestImportReport, error)
+
+	// ExportGuestsService streams the guests of an event, optionally filtered by RSVP status and check-in,
+	// to w as a CSV or XLSX file or a printable PDF sign-in sheet. Spreadsheets get a column per registration form field.
+	ExportGuestsService(ctx context.Context, organizerID, eventID uuid.UUID, exportDTO *utils.GuestExportDTO, w io.Writer) error
+
+	// ConfirmGuestRegistrationService accepts a guest whose self-registration was confirmed, or puts them on the
+	// waitlist when the venue is full. The guest is saved as given, so their name and answers should be set first.
+	ConfirmGuestRegistrationService(ctx context.Context, event *types.EventType, guest *types.GuestType) (*types.GuestType, error)
+
+	// FindEventGuestsService retrieves the guest list of an event for administrators, whoever organizes the event
+	FindEventGuestsService(ctx context.Context, eventID uuid.UUID) ([]*types.GuestType, error)
+}
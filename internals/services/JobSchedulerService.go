@@ -0,0 +1,264 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/robfig/cron/v3"
+)
+
+// jobBatchSize caps how many due jobs one instance picks up per poll
+const jobBatchSize = 20
+
+// jobReleaseTimeout bounds storing the outcome of a run, which still happens while shutting down
+const jobReleaseTimeout = 10 * time.Second
+
+// cronParser accepts standard five-field cron expressions and descriptors such as "@hourly" or "@every 1m"
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+type JobSchedulerService struct {
+	repository repositories.JobRepositoryInterface
+	instanceID string
+
+	mu       sync.RWMutex
+	handlers map[string]JobHandlerFunc
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewJobSchedulerService(repository repositories.JobRepositoryInterface) JobSchedulerServiceInterface {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "eventurego"
+	}
+
+	return &JobSchedulerService{
+		repository: repository,
+		instanceID: fmt.Sprintf("%s-%s", hostname, uuid.NewString()[:8]),
+		handlers:   make(map[string]JobHandlerFunc),
+	}
+}
+
+func (s *JobSchedulerService) RegisterJobHandler(kind string, handler JobHandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[kind] = handler
+}
+
+func (s *JobSchedulerService) ScheduleCronJobService(ctx context.Context, name, kind, schedule string) (*types.JobType, error) {
+	existing, err := s.repository.FindJobByName(ctx, name)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load job")
+	}
+
+	if schedule == "" {
+		if existing == nil || !existing.IsActive {
+			return existing, nil
+		}
+		existing.IsActive = false
+		return s.repository.UpsertJob(ctx, existing, false)
+	}
+
+	parsed, err := cronParser.Parse(schedule)
+	if err != nil {
+		return nil, newerrors.NewValidationError(fmt.Sprintf("invalid schedule %q for job %s: %v", schedule, name, err))
+	}
+
+	// Keep the stored next run across restarts, unless the timetable itself changed or the job was switched back on
+	job := types.NewCronJob(name, kind, schedule, parsed.Next(time.Now()), configs.JobMaxAttempts)
+	overwriteNextRun := existing != nil && (existing.Schedule != schedule || !existing.IsActive)
+	return s.repository.UpsertJob(ctx, job, overwriteNextRun)
+}
+
+func (s *JobSchedulerService) ScheduleOneOffJobService(ctx context.Context, name, kind string, runAt time.Time, payload map[string]string) (*types.JobType, error) {
+	job := types.NewOneOffJob(name, kind, runAt, payload, configs.JobMaxAttempts)
+	return s.repository.UpsertJob(ctx, job, true)
+}
+
+func (s *JobSchedulerService) FindJobsService(ctx context.Context) ([]*types.JobType, error) {
+	return s.repository.FindAllJobs(ctx)
+}
+
+func (s *JobSchedulerService) FindJobRunsService(ctx context.Context, jobName string, limit int) ([]*types.JobRunType, error) {
+	return s.repository.FindJobRuns(ctx, jobName, limit)
+}
+
+func (s *JobSchedulerService) StartJobScheduler(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		log.Printf("Job scheduler started as %s", s.instanceID)
+		for {
+			s.runDueJobs(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (s *JobSchedulerService) StopJobScheduler() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+	log.Println("Job scheduler stopped")
+}
+
+// runDueJobs locks and starts every due job; jobs another instance locked first are left to that instance
+func (s *JobSchedulerService) runDueJobs(ctx context.Context) {
+	now := time.Now().UTC()
+	jobs, err := s.repository.FindDueJobs(ctx, now, jobBatchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("Failed to load due jobs: %v", err)
+		}
+		return
+	}
+
+	lockDuration := configs.JobLockDuration
+	if lockDuration <= 0 {
+		lockDuration = 5 * time.Minute
+	}
+
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			return
+		}
+
+		acquired, err := s.repository.AcquireJob(ctx, job.ID, s.instanceID, now, now.Add(lockDuration))
+		if err != nil {
+			log.Printf("Failed to lock job %s: %v", job.Name, err)
+			continue
+		}
+		if !acquired {
+			continue
+		}
+		job.LockedBy = s.instanceID
+
+		s.wg.Add(1)
+		go func(job *types.JobType) {
+			defer s.wg.Done()
+			s.runJob(ctx, job)
+		}(job)
+	}
+}
+
+// runJob runs a locked job, records the run and releases the lease with the next run time
+func (s *JobSchedulerService) runJob(ctx context.Context, job *types.JobType) {
+	releaseCtx, cancel := context.WithTimeout(context.Background(), jobReleaseTimeout)
+	defer cancel()
+
+	run := types.NewJobRun(job, s.instanceID)
+	if err := s.repository.CreateJobRun(releaseCtx, run); err != nil {
+		log.Printf("Failed to record run of job %s: %v", job.Name, err)
+	}
+
+	err := s.callJobHandler(ctx, job)
+
+	finishedAt := time.Now().UTC()
+	run.FinishedAt = &finishedAt
+	run.Status = types.JobRunStatusSucceeded
+	if err != nil {
+		run.Status = types.JobRunStatusFailed
+		run.Error = err.Error()
+		log.Printf("Job %s failed on attempt %d: %v", job.Name, run.Attempt, err)
+	}
+	if err := s.repository.FinishJobRun(releaseCtx, run); err != nil {
+		log.Printf("Failed to record outcome of job %s: %v", job.Name, err)
+	}
+
+	// A run cut short by shutdown is not the job's fault, so it is picked up again right away without using an attempt
+	if err != nil && ctx.Err() != nil {
+		job.NextRunAt = finishedAt
+		job.LastError = err.Error()
+	} else {
+		applyJobOutcome(job, err, finishedAt)
+	}
+
+	if err := s.repository.ReleaseJob(releaseCtx, job); err != nil {
+		if errors.Is(err, repositories.ErrJobLockLost) {
+			log.Printf("Job %s outlived its lock and was taken over by another instance", job.Name)
+			return
+		}
+		log.Printf("Failed to release job %s: %v", job.Name, err)
+	}
+}
+
+// callJobHandler runs the handler registered for the job's kind, turning a panic into an error
+func (s *JobSchedulerService) callJobHandler(ctx context.Context, job *types.JobType) (err error) {
+	s.mu.RLock()
+	handler, exists := s.handlers[job.Kind]
+	s.mu.RUnlock()
+	if !exists {
+		return fmt.Errorf("no handler registered for job kind %s", job.Kind)
+	}
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("job panicked: %v", recovered)
+		}
+	}()
+	return handler(ctx, job)
+}
+
+// applyJobOutcome works out the next run of a job after an attempt. A failure is retried with a growing backoff until
+// MaxAttempts is reached; after that, and after a success, a cron job waits for its next scheduled time and a one-off
+// job is deactivated.
+func applyJobOutcome(job *types.JobType, runErr error, finishedAt time.Time) {
+	job.LastRunAt = &finishedAt
+	job.LastError = ""
+
+	if runErr != nil {
+		job.LastError = runErr.Error()
+		job.Attempts++
+		if job.Attempts < job.MaxAttempts {
+			backoff := configs.JobRetryBackoff
+			if backoff <= 0 {
+				backoff = time.Minute
+			}
+			job.NextRunAt = finishedAt.Add(backoff * time.Duration(job.Attempts))
+			return
+		}
+		log.Printf("Job %s gave up after %d attempts", job.Name, job.Attempts)
+	}
+	job.Attempts = 0
+
+	if !job.IsRecurring() {
+		job.IsActive = false
+		return
+	}
+
+	schedule, err := cronParser.Parse(job.Schedule)
+	if err != nil {
+		job.IsActive = false
+		job.LastError = fmt.Sprintf("invalid schedule: %v", err)
+		return
+	}
+	job.NextRunAt = schedule.Next(finishedAt).UTC()
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// JobHandlerFunc runs one job of a given kind; a returned error makes the run count as a failed attempt
+type JobHandlerFunc func(ctx context.Context, job *types.JobType) error
+
+// JobSchedulerServiceInterface defines the methods for scheduling and running persisted background jobs
+type JobSchedulerServiceInterface interface {
+	// RegisterJobHandler sets the handler that runs jobs of the given kind
+	RegisterJobHandler(kind string, handler JobHandlerFunc)
+
+	// ScheduleCronJobService creates or updates a recurring job. Restarts keep the job on its timetable unless the
+	// schedule changed; an empty schedule deactivates an existing job.
+	ScheduleCronJobService(ctx context.Context, name, kind, schedule string) (*types.JobType, error)
+
+	// ScheduleOneOffJobService creates or replaces a job that runs once at runAt
+	ScheduleOneOffJobService(ctx context.Context, name, kind string, runAt time.Time, payload map[string]string) (*types.JobType, error)
+
+	// FindJobsService retrieves every job with its next run and lease
+	FindJobsService(ctx context.Context) ([]*types.JobType, error)
+
+	// FindJobRunsService retrieves the latest runs, newest first, optionally only those of one job
+	FindJobRunsService(ctx context.Context, jobName string, limit int) ([]*types.JobRunType, error)
+
+	// StartJobScheduler polls for due jobs every interval and runs the ones this instance manages to lock
+	StartJobScheduler(ctx context.Context, interval time.Duration)
+
+	// StopJobScheduler stops polling and waits for running jobs to return
+	StopJobScheduler()
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"log"
+
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// maintenanceJobScheduleKeys maps each built-in job kind to its schedule key under jobs.schedules in config.yaml
+var maintenanceJobScheduleKeys = map[string]string{
+	types.JobKindSendReminders:           "send_reminders",
+	types.JobKindPurgeExpiredOTPs:        "purge_expired_otps",
+	types.JobKindPurgeExpiredResetTokens: "purge_expired_reset_tokens",
+	types.JobKindCompleteEndedEvents:     "complete_ended_events",
+	types.JobKindDeliverWebhooks:         "deliver_webhooks",
+	types.JobKindExpireOrderHolds:        "expire_order_holds",
+	types.JobKindPurgeLoginChallenges:    "purge_expired_login_challenges",
+}
+
+// RegisterMaintenanceJobs registers the handlers of the built-in periodic jobs and schedules them from the configuration.
+// Each built-in job is named after its kind, so every instance upserts the same jobs and only one runs each due run.
+func RegisterMaintenanceJobs(
+	ctx context.Context,
+	scheduler JobSchedulerServiceInterface,
+	reminderService ReminderServiceInterface,
+	superUserService SuperUserServiceInterface,
+	passwordlessLoginService PasswordlessLoginServiceInterface,
+	eventService EventServiceInterface,
+	webhookService WebhookServiceInterface,
+	orderService OrderServiceInterface,
+) error {
+	scheduler.RegisterJobHandler(types.JobKindSendReminders, func(ctx context.Context, job *types.JobType) error {
+		return reminderService.SendDueRemindersService(ctx)
+	})
+
+	scheduler.RegisterJobHandler(types.JobKindPurgeExpiredOTPs, func(ctx context.Context, job *types.JobType) error {
+		cleared, err := superUserService.PurgeExpiredOTPs(ctx)
+		if cleared > 0 {
+			log.Printf("Cleared %d expired OTPs", cleared)
+		}
+		return err
+	})
+
+	scheduler.RegisterJobHandler(types.JobKindPurgeExpiredResetTokens, func(ctx context.Context, job *types.JobType) error {
+		cleared, err := superUserService.PurgeExpiredResetTokens(ctx)
+		if cleared > 0 {
+			log.Printf("Cleared %d expired password reset tokens", cleared)
+		}
+		return err
+	})
+
+	scheduler.RegisterJobHandler(types.JobKindPurgeLoginChallenges, func(ctx context.Context, job *types.JobType) error {
+		deleted, err := passwordlessLoginService.PurgeExpiredLoginChallengesService(ctx)
+		if deleted > 0 {
+			log.Printf("Deleted %d expired passwordless login challenges", deleted)
+		}
+		return err
+	})
+
+	scheduler.RegisterJobHandler(types.JobKindCompleteEndedEvents, func(ctx context.Context, job *types.JobType) error {
+		completed, err := eventService.CompleteEndedEventsService(ctx)
+		if completed > 0 {
+			log.Printf("Marked %d ended events as completed", completed)
+		}
+		return err
+	})
+
+	scheduler.RegisterJobHandler(types.JobKindDeliverWebhooks, func(ctx context.Context, job *types.JobType) error {
+		delivered, err := webhookService.DeliverDueWebhooksService(ctx)
+		if delivered > 0 {
+			log.Printf("Delivered %d retried webhook deliveries", delivered)
+		}
+		return err
+	})
+
+	scheduler.RegisterJobHandler(types.JobKindExpireOrderHolds, func(ctx context.Context, job *types.JobType) error {
+		expired, err := orderService.ExpireOrderHoldsService(ctx)
+		if expired > 0 {
+			log.Printf("Released the tickets of %d expired orders", expired)
+		}
+		return err
+	})
+
+	for kind, key := range maintenanceJobScheduleKeys {
+		if _, err := scheduler.ScheduleCronJobService(ctx, kind, kind, configs.JobSchedules[key]); err != nil {
+			return newerrors.Wrap(err, "failed to schedule job "+kind)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,513 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// orderExpiryBatchSize caps how many expired orders one run of the expiry job closes
+const orderExpiryBatchSize = 100
+
+// orderTicketTierField is the guest custom field recording the tier an attendee bought a ticket of
+const orderTicketTierField = "Ticket tier"
+
+type OrderService struct {
+	repository             repositories.OrderRepositoryInterface
+	ticketTierRepository   repositories.TicketTierRepositoryInterface
+	promoCodeRepository    repositories.PromoCodeRepositoryInterface
+	eventRepository        repositories.EventRepositoryInterface
+	organizationRepository repositories.OrganizationRepositoryInterface
+	eventGrantRepository   repositories.EventGrantRepositoryInterface
+	guestRepository        repositories.GuestRepositoryInterface
+	ticketService          TicketServiceInterface
+	paymentProvider        PaymentProviderInterface
+	eventBus               EventBusServiceInterface
+	auditService           AuditServiceInterface
+}
+
+func NewOrderService(
+	repository repositories.OrderRepositoryInterface,
+	ticketTierRepository repositories.TicketTierRepositoryInterface,
+	promoCodeRepository repositories.PromoCodeRepositoryInterface,
+	eventRepository repositories.EventRepositoryInterface,
+	organizationRepository repositories.OrganizationRepositoryInterface,
+	eventGrantRepository repositories.EventGrantRepositoryInterface,
+	guestRepository repositories.GuestRepositoryInterface,
+	ticketService TicketServiceInterface,
+	paymentProvider PaymentProviderInterface,
+	eventBus EventBusServiceInterface,
+	auditService AuditServiceInterface,
+) OrderServiceInterface {
+	return &OrderService{
+		repository:             repository,
+		ticketTierRepository:   ticketTierRepository,
+		promoCodeRepository:    promoCodeRepository,
+		eventRepository:        eventRepository,
+		organizationRepository: organizationRepository,
+		eventGrantRepository:   eventGrantRepository,
+		guestRepository:        guestRepository,
+		ticketService:          ticketService,
+		paymentProvider:        paymentProvider,
+		eventBus:               eventBus,
+		auditService:           auditService,
+	}
+}
+
+func (o *OrderService) CreateOrderService(ctx context.Context, buyerID, eventID uuid.UUID, orderDTO *utils.CreateOrderDTO) (*types.OrderType, error) {
+	event, err := o.eventRepository.FindEventByID(ctx, eventID)
+	if err != nil || event == nil {
+		return nil, newerrors.NewValidationError("event not found")
+	}
+	now := time.Now()
+	if event.CurrentStatus() != types.EventStatusPublished || !now.Before(event.EndTime) {
+		return nil, newerrors.NewValidationError("tickets can only be ordered for upcoming published events")
+	}
+	tier, err := o.ticketTierRepository.FindTicketTierByID(ctx, orderDTO.TicketTierID)
+	if err != nil || tier == nil || tier.EventID != eventID {
+		return nil, newerrors.NewValidationError("ticket tier not found")
+	}
+	if !tier.OnSale(now) {
+		return nil, newerrors.NewValidationError("ticket tier is not on sale")
+	}
+
+	order := types.NewOrder(tier, buyerID, orderDTO.Attendees, now.Add(configs.OrderHoldDuration))
+	var promoCode *types.PromoCodeType
+	if orderDTO.PromoCode != "" {
+		promoCode, err = o.findApplicablePromoCode(ctx, tier, orderDTO.PromoCode, now)
+		if err != nil {
+			return nil, err
+		}
+		order.ApplyPromoCode(promoCode, promoCode.DiscountFor(order.TotalCents))
+	}
+
+	if err := o.ticketTierRepository.ReserveTicketTierInventory(ctx, tier.ID, order.Quantity); err != nil {
+		if errors.Is(err, repositories.ErrTicketTierSoldOut) {
+			return nil, newerrors.NewConflictError(fmt.Sprintf("only %d tickets left in this tier", tier.Available()))
+		}
+		return nil, newerrors.Wrap(err, "failed to reserve tickets")
+	}
+	if promoCode != nil {
+		redemption := types.NewPromoRedemption(promoCode, order.ID, buyerID, order.DiscountCents)
+		if err := o.promoCodeRepository.RedeemPromoCode(ctx, redemption); err != nil {
+			o.releaseTickets(ctx, order)
+			if errors.Is(err, repositories.ErrPromoCodeExhausted) || errors.Is(err, repositories.ErrPromoCodeUserLimit) {
+				return nil, newerrors.NewConflictError(err.Error())
+			}
+			return nil, newerrors.Wrap(err, "failed to redeem promo code")
+		}
+	}
+
+	// Free orders skip the payment provider
+	var payment *types.PaymentType
+	if order.TotalCents > 0 {
+		payment, err = o.paymentProvider.CreatePayment(ctx, order)
+		if err != nil {
+			o.releaseTickets(ctx, order)
+			o.releasePromoCode(ctx, order)
+			return nil, newerrors.Wrap(err, "failed to start payment")
+		}
+		order.PaymentProvider = o.paymentProvider.Name()
+		order.PaymentID = payment.ID
+		order.CheckoutURL = payment.CheckoutURL
+	}
+
+	if _, err := o.repository.CreateOrder(ctx, order); err != nil {
+		o.releaseTickets(ctx, order)
+		o.releasePromoCode(ctx, order)
+		o.cancelPayment(ctx, order)
+		return nil, newerrors.Wrap(err, "failed to create order")
+	}
+	o.auditOrder(ctx, buyerID, types.AuditActionOrderCreated, nil, order)
+
+	if payment == nil || payment.Status == types.PaymentStatusSucceeded {
+		if err := o.confirmOrder(ctx, buyerID, event, tier.Name, order); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+func (o *OrderService) ConfirmOrderService(ctx context.Context, buyerID, orderID uuid.UUID) (*types.OrderType, error) {
+	order, err := o.findBuyerOrder(ctx, buyerID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch order.Status {
+	case types.OrderStatusConfirmed:
+		return order, nil
+	case types.OrderStatusPending:
+	case types.OrderStatusCancelled, types.OrderStatusExpired:
+		// The buyer may have paid after the hold ran out; their tickets may be gone, so the money goes back
+		if o.paymentSucceeded(ctx, order) {
+			if err := o.paymentProvider.RefundPayment(ctx, order.PaymentID, order.TotalCents); err != nil {
+				return nil, newerrors.Wrap(err, "failed to refund late payment")
+			}
+			return nil, newerrors.NewConflictError(fmt.Sprintf("order is %s and its payment was refunded", strings.ToLower(order.Status)))
+		}
+		return nil, newerrors.NewValidationError(fmt.Sprintf("order is %s", strings.ToLower(order.Status)))
+	default:
+		return nil, newerrors.NewValidationError(fmt.Sprintf("order is %s", strings.ToLower(order.Status)))
+	}
+
+	// Free orders have nothing to pay
+	paymentStatus := types.PaymentStatusSucceeded
+	if order.PaymentID != "" {
+		payment, err := o.paymentProvider.FindPayment(ctx, order.PaymentID)
+		if err != nil {
+			return nil, newerrors.Wrap(err, "failed to check payment")
+		}
+		paymentStatus = payment.Status
+	}
+	switch paymentStatus {
+	case types.PaymentStatusSucceeded:
+		event, err := o.eventRepository.FindEventByID(ctx, order.EventID)
+		if err != nil || event == nil {
+			return nil, newerrors.NewValidationError("event not found")
+		}
+		if err := o.confirmOrder(ctx, buyerID, event, o.ticketTierName(ctx, order), order); err != nil {
+			return nil, err
+		}
+		return order, nil
+	case types.PaymentStatusPending:
+		return nil, newerrors.NewValidationError("payment has not been completed yet")
+	default:
+		if err := o.closePendingOrder(ctx, buyerID, order, types.OrderStatusCancelled, "payment "+paymentStatus); err != nil {
+			return nil, err
+		}
+		return nil, newerrors.NewValidationError("payment " + paymentStatus + "; the tickets were released")
+	}
+}
+
+func (o *OrderService) CancelOrderService(ctx context.Context, buyerID, orderID uuid.UUID) (*types.OrderType, error) {
+	order, err := o.findBuyerOrder(ctx, buyerID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status == types.OrderStatusCancelled {
+		return order, nil
+	}
+	if order.Status != types.OrderStatusPending {
+		return nil, newerrors.NewValidationError(fmt.Sprintf("only pending orders can be cancelled, this one is %s", strings.ToLower(order.Status)))
+	}
+	if err := o.closePendingOrder(ctx, buyerID, order, types.OrderStatusCancelled, "cancelled by the buyer"); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+func (o *OrderService) RefundOrderService(ctx context.Context, organizerID, orderID uuid.UUID, reason string) (*types.OrderType, error) {
+	order, event, err := o.findOrganizerOrder(ctx, organizerID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != types.OrderStatusConfirmed {
+		return nil, newerrors.NewValidationError(fmt.Sprintf("only confirmed orders can be refunded, this one is %s", strings.ToLower(order.Status)))
+	}
+
+	// Claim the order before moving money, so two refund requests cannot both pay out
+	before := utils.AuditSnapshot(order)
+	now := time.Now()
+	order.Status = types.OrderStatusRefunded
+	order.ClosedAt = &now
+	order.CloseReason = reason
+	if err := o.repository.UpdateOrder(ctx, order, types.OrderStatusConfirmed); err != nil {
+		if errors.Is(err, repositories.ErrOrderStatusChanged) {
+			return nil, newerrors.NewConflictError("order was changed by another request")
+		}
+		return nil, newerrors.Wrap(err, "failed to update order")
+	}
+
+	if order.PaymentID != "" {
+		if err := o.paymentProvider.RefundPayment(ctx, order.PaymentID, order.TotalCents); err != nil {
+			order.Status = types.OrderStatusConfirmed
+			order.ClosedAt = nil
+			order.CloseReason = ""
+			if rollbackErr := o.repository.UpdateOrder(ctx, order, types.OrderStatusRefunded); rollbackErr != nil {
+				log.Printf("Failed to restore order %s after a failed refund: %v", order.ID, rollbackErr)
+			}
+			return nil, newerrors.Wrap(err, "failed to refund payment")
+		}
+	}
+	o.auditOrder(ctx, organizerID, types.AuditActionOrderRefunded, before, order)
+
+	if err := o.ticketTierRepository.RestockTicketTierInventory(ctx, order.TicketTierID, order.Quantity); err != nil {
+		log.Printf("Failed to restock tickets of refunded order %s: %v", order.ID, err)
+	}
+	for _, attendee := range order.Attendees {
+		if attendee.GuestID != nil {
+			o.declineGuest(ctx, event, *attendee.GuestID)
+		}
+	}
+	return order, nil
+}
+
+func (o *OrderService) FindOrderService(ctx context.Context, userID, orderID uuid.UUID) (*types.OrderType, error) {
+	order, err := o.repository.FindOrderByID(ctx, orderID)
+	if err != nil || order == nil {
+		return nil, newerrors.NewValidationError("order not found")
+	}
+	if order.BuyerID == userID {
+		return order, nil
+	}
+	event, err := o.eventRepository.FindEventByID(ctx, order.EventID)
+	if err != nil || event == nil ||
+		!hasEventAccess(ctx, o.organizationRepository, o.eventGrantRepository, userID, event, types.OrganizationRoleAdmin, types.EventGrantRoleCoOrganizer) {
+		return nil, newerrors.NewForbiddenError("only the buyer and the organizers can view this order")
+	}
+	return order, nil
+}
+
+func (o *OrderService) FindEventOrdersService(ctx context.Context, organizerID, eventID uuid.UUID) ([]*types.OrderType, error) {
+	event, err := o.eventRepository.FindEventByID(ctx, eventID)
+	if err != nil || event == nil {
+		return nil, newerrors.NewValidationError("event not found")
+	}
+	if !hasEventAccess(ctx, o.organizationRepository, o.eventGrantRepository, organizerID, event, types.OrganizationRoleAdmin, types.EventGrantRoleCoOrganizer) {
+		return nil, newerrors.NewForbiddenError("only the organizers can view the orders of this event")
+	}
+	return o.repository.FindOrdersByEventID(ctx, eventID)
+}
+
+func (o *OrderService) ExpireOrderHoldsService(ctx context.Context) (int, error) {
+	orders, err := o.repository.FindExpiredPendingOrders(ctx, time.Now(), orderExpiryBatchSize)
+	if err != nil {
+		return 0, newerrors.Wrap(err, "failed to load expired orders")
+	}
+
+	expired := 0
+	for _, order := range orders {
+		// A buyer who paid but never came back to confirm still gets their tickets
+		if order.PaymentID != "" && o.paymentSucceeded(ctx, order) {
+			event, err := o.eventRepository.FindEventByID(ctx, order.EventID)
+			if err == nil && event != nil {
+				if err := o.confirmOrder(ctx, uuid.Nil, event, o.ticketTierName(ctx, order), order); err != nil {
+					log.Printf("Failed to confirm paid order %s: %v", order.ID, err)
+				}
+				continue
+			}
+		}
+
+		if err := o.closePendingOrder(ctx, uuid.Nil, order, types.OrderStatusExpired, "not paid in time"); err != nil {
+			if !newerrors.IsConflictError(err) {
+				log.Printf("Failed to expire order %s: %v", order.ID, err)
+			}
+			continue
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+// confirmOrder marks a pending order as confirmed, sells its held tickets and adds every attendee to the event as
+// an accepted guest with a ticket. The system confirms paid orders whose buyer never came back, with uuid.Nil as actor.
+func (o *OrderService) confirmOrder(ctx context.Context, actorID uuid.UUID, event *types.EventType, tierName string, order *types.OrderType) error {
+	before := utils.AuditSnapshot(order)
+	now := time.Now()
+	order.Status = types.OrderStatusConfirmed
+	order.ConfirmedAt = &now
+	if err := o.repository.UpdateOrder(ctx, order, types.OrderStatusPending); err != nil {
+		if errors.Is(err, repositories.ErrOrderStatusChanged) {
+			return newerrors.NewConflictError("order was changed by another request")
+		}
+		return newerrors.Wrap(err, "failed to confirm order")
+	}
+	if err := o.ticketTierRepository.CommitTicketTierInventory(ctx, order.TicketTierID, order.Quantity); err != nil {
+		log.Printf("Failed to commit tickets of order %s: %v", order.ID, err)
+	}
+
+	for i := range order.Attendees {
+		attendee := &order.Attendees[i]
+		guest := types.NewGuest(attendee.Email, attendee.FullName)
+		guest.AttachToEvent(event)
+		guest.RSVPStatus = types.RSVPStatusAccepted
+		guest.CustomFields = map[string]string{orderTicketTierField: tierName}
+		if _, err := o.guestRepository.AddGuest(ctx, guest); err != nil {
+			log.Printf("Failed to add attendee %s of order %s as a guest: %v", attendee.Email, order.ID, err)
+			continue
+		}
+		attendee.GuestID = &guest.ID
+
+		if _, err := o.ticketService.IssueGuestTicketService(ctx, event, guest); err != nil {
+			log.Printf("Failed to issue ticket to guest %s: %v", guest.ID, err)
+		}
+		o.eventBus.Publish(types.NewDomainEvent(types.DomainEventGuestRSVPChanged, event.ID, map[string]interface{}{
+			"guest_id":             guest.ID,
+			"rsvp_status":          types.RSVPStatusAccepted,
+			"previous_rsvp_status": "",
+			"order_id":             order.ID,
+		}))
+	}
+
+	// Keep the guest IDs on the order, so a refund can revoke their tickets
+	if err := o.repository.UpdateOrder(ctx, order, types.OrderStatusConfirmed); err != nil {
+		log.Printf("Failed to link guests to order %s: %v", order.ID, err)
+	}
+	o.auditOrder(ctx, actorID, types.AuditActionOrderConfirmed, before, order)
+	return nil
+}
+
+// closePendingOrder moves a pending order to a final status, puts its tickets back on sale, gives back its promo code
+// redemption and abandons its payment. Holds expired by the system have uuid.Nil as actor.
+func (o *OrderService) closePendingOrder(ctx context.Context, actorID uuid.UUID, order *types.OrderType, status, reason string) error {
+	before := utils.AuditSnapshot(order)
+	now := time.Now()
+	order.Status = status
+	order.ClosedAt = &now
+	order.CloseReason = reason
+	if err := o.repository.UpdateOrder(ctx, order, types.OrderStatusPending); err != nil {
+		if errors.Is(err, repositories.ErrOrderStatusChanged) {
+			return newerrors.NewConflictError("order was changed by another request")
+		}
+		return newerrors.Wrap(err, "failed to update order")
+	}
+	o.releaseTickets(ctx, order)
+	o.releasePromoCode(ctx, order)
+	o.cancelPayment(ctx, order)
+
+	action := types.AuditActionOrderCancelled
+	if status == types.OrderStatusExpired {
+		action = types.AuditActionOrderExpired
+	}
+	o.auditOrder(ctx, actorID, action, before, order)
+	return nil
+}
+
+// auditOrder records an action on an order with the fields it changed since the before snapshot
+func (o *OrderService) auditOrder(ctx context.Context, actorID uuid.UUID, action string, before map[string]json.RawMessage, order *types.OrderType) {
+	changes := utils.AuditDiff(before, utils.AuditSnapshot(order))
+	recordAudit(ctx, o.auditService, actorID, action, types.AuditTargetOrder, order.ID, changes)
+}
+
+// declineGuest marks a guest of a refunded order as declined and revokes their ticket
+func (o *OrderService) declineGuest(ctx context.Context, event *types.EventType, guestID uuid.UUID) {
+	guest, err := o.guestRepository.FindGuestByID(ctx, guestID)
+	if err != nil || guest == nil {
+		return
+	}
+	previousStatus := guest.RSVPStatus
+	guest.RSVPStatus = types.RSVPStatusDeclined
+	guest.UpdatedAt = time.Now()
+	if err := o.guestRepository.UpdateGuest(ctx, guest); err != nil {
+		log.Printf("Failed to decline guest %s of a refunded order: %v", guest.ID, err)
+		return
+	}
+	if err := o.ticketService.CancelGuestTicketService(ctx, guest.ID, "order refunded"); err != nil {
+		log.Printf("Failed to revoke ticket of guest %s: %v", guest.ID, err)
+	}
+	o.eventBus.Publish(types.NewDomainEvent(types.DomainEventGuestRSVPChanged, event.ID, map[string]interface{}{
+		"guest_id":             guest.ID,
+		"rsvp_status":          types.RSVPStatusDeclined,
+		"previous_rsvp_status": previousStatus,
+	}))
+}
+
+// releaseTickets puts the held tickets of an order back on sale
+func (o *OrderService) releaseTickets(ctx context.Context, order *types.OrderType) {
+	if err := o.ticketTierRepository.ReleaseTicketTierInventory(ctx, order.TicketTierID, order.Quantity); err != nil {
+		log.Printf("Failed to release tickets of order %s: %v", order.ID, err)
+	}
+}
+
+// releasePromoCode gives back the promo code redemption of an order that did not go through, if it has one
+func (o *OrderService) releasePromoCode(ctx context.Context, order *types.OrderType) {
+	if order.PromoCodeID == nil {
+		return
+	}
+	if err := o.promoCodeRepository.ReleasePromoRedemption(ctx, order.ID); err != nil {
+		log.Printf("Failed to release promo code %s of order %s: %v", order.PromoCode, order.ID, err)
+	}
+}
+
+// findApplicablePromoCode loads a promo code of the tier's event and checks that it can be used for the tier now.
+// Its limits are only checked when it is redeemed.
+func (o *OrderService) findApplicablePromoCode(ctx context.Context, tier *types.TicketTierType, code string, now time.Time) (*types.PromoCodeType, error) {
+	promoCode, err := o.promoCodeRepository.FindPromoCodeByCode(ctx, tier.EventID, code)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to look up promo code")
+	}
+	if promoCode == nil || !promoCode.IsActive {
+		return nil, newerrors.NewValidationError("promo code is not valid")
+	}
+	if !promoCode.ValidAt(now) {
+		return nil, newerrors.NewValidationError("promo code is not valid at this time")
+	}
+	if !promoCode.AppliesToTier(tier.ID) {
+		return nil, newerrors.NewValidationError("promo code does not apply to this ticket tier")
+	}
+	if tier.PriceCents == 0 {
+		return nil, newerrors.NewValidationError("promo codes cannot be used for free tickets")
+	}
+	if promoCode.DiscountType == types.PromoDiscountFixed && promoCode.Currency != tier.Currency {
+		return nil, newerrors.NewValidationError("promo code does not apply to prices in " + tier.Currency)
+	}
+	return promoCode, nil
+}
+
+// cancelPayment abandons the payment of an order, if it has one
+func (o *OrderService) cancelPayment(ctx context.Context, order *types.OrderType) {
+	if order.PaymentID == "" {
+		return
+	}
+	if err := o.paymentProvider.CancelPayment(ctx, order.PaymentID); err != nil {
+		log.Printf("Failed to cancel payment %s of order %s: %v", order.PaymentID, order.ID, err)
+	}
+}
+
+// paymentSucceeded reports whether the payment of an order has gone through
+func (o *OrderService) paymentSucceeded(ctx context.Context, order *types.OrderType) bool {
+	if order.PaymentID == "" {
+		return false
+	}
+	payment, err := o.paymentProvider.FindPayment(ctx, order.PaymentID)
+	return err == nil && payment.Status == types.PaymentStatusSucceeded
+}
+
+// ticketTierName returns the name of the tier an order is for, which is recorded on its guests
+func (o *OrderService) ticketTierName(ctx context.Context, order *types.OrderType) string {
+	tier, err := o.ticketTierRepository.FindTicketTierByID(ctx, order.TicketTierID)
+	if err != nil || tier == nil {
+		return ""
+	}
+	return tier.Name
+}
+
+// findBuyerOrder loads an order for the user who placed it
+func (o *OrderService) findBuyerOrder(ctx context.Context, buyerID, orderID uuid.UUID) (*types.OrderType, error) {
+	order, err := o.repository.FindOrderByID(ctx, orderID)
+	if err != nil || order == nil {
+		return nil, newerrors.NewValidationError("order not found")
+	}
+	if order.BuyerID != buyerID {
+		return nil, newerrors.NewForbiddenError("only the buyer can change this order")
+	}
+	return order, nil
+}
+
+// findOrganizerOrder loads an order and its event for someone who may refund the event's orders
+func (o *OrderService) findOrganizerOrder(ctx context.Context, organizerID, orderID uuid.UUID) (*types.OrderType, *types.EventType, error) {
+	order, err := o.repository.FindOrderByID(ctx, orderID)
+	if err != nil || order == nil {
+		return nil, nil, newerrors.NewValidationError("order not found")
+	}
+	event, err := o.eventRepository.FindEventByID(ctx, order.EventID)
+	if err != nil || event == nil {
+		return nil, nil, newerrors.NewValidationError("event not found")
+	}
+	if !hasEventAccess(ctx, o.organizationRepository, o.eventGrantRepository, organizerID, event, types.OrganizationRoleAdmin, types.EventGrantRoleCoOrganizer) {
+		return nil, nil, newerrors.NewForbiddenError("only the organizers can refund orders of this event")
+	}
+	return order, event, nil
+}
@@ -0,0 +1,39 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// OrderServiceInterface defines the methods for buying tickets and managing orders
+type OrderServiceInterface interface {
+	// CreateOrderService places an order for tickets of a tier and holds them until the order expires. A promo code
+	// is redeemed together with the hold and given back if the order is cancelled or expires.
+	// Free orders, and paid ones the payment provider approves right away, are confirmed immediately;
+	// otherwise the buyer pays at the checkout URL of the order and then confirms it.
+	CreateOrderService(ctx context.Context, buyerID, eventID uuid.UUID, orderDTO *utils.CreateOrderDTO) (*types.OrderType, error)
+
+	// ConfirmOrderService checks the payment of a pending order and, once it succeeded, turns every attendee into
+	// an accepted guest with a ticket. Confirming a confirmed order again returns it unchanged.
+	ConfirmOrderService(ctx context.Context, buyerID, orderID uuid.UUID) (*types.OrderType, error)
+
+	// CancelOrderService abandons a pending order and puts its tickets back on sale
+	CancelOrderService(ctx context.Context, buyerID, orderID uuid.UUID) (*types.OrderType, error)
+
+	// RefundOrderService lets the organizer refund a confirmed order. The money goes back to the buyer, the
+	// tickets go back on sale and the attendees' guest tickets are revoked.
+	RefundOrderService(ctx context.Context, organizerID, orderID uuid.UUID, reason string) (*types.OrderType, error)
+
+	// FindOrderService retrieves an order for its buyer or the organizer of its event
+	FindOrderService(ctx context.Context, userID, orderID uuid.UUID) (*types.OrderType, error)
+
+	// FindEventOrdersService lists every order of an event for its organizer, newest first
+	FindEventOrdersService(ctx context.Context, organizerID, eventID uuid.UUID) ([]*types.OrderType, error)
+
+	// ExpireOrderHoldsService closes the pending orders whose hold ran out and releases their tickets, and returns
+	// how many expired. Orders whose payment succeeded in the meantime are confirmed instead.
+	ExpireOrderHoldsService(ctx context.Context) (int, error)
+}
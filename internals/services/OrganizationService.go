@@ -0,0 +1,342 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/htmltemplates"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/mygopher/gophersmtp"
+)
+
+type OrganizationService struct {
+	repository          repositories.OrganizationRepositoryInterface
+	superUserRepository repositories.SuperUserRepositoryInterface
+	emailService        gophersmtp.GopherSmtpInterface
+	auditService        AuditServiceInterface
+}
+
+func NewOrganizationService(
+	repository repositories.OrganizationRepositoryInterface,
+	superUserRepository repositories.SuperUserRepositoryInterface,
+	emailService gophersmtp.GopherSmtpInterface,
+	auditService AuditServiceInterface,
+) OrganizationServiceInterface {
+	return &OrganizationService{
+		repository:          repository,
+		superUserRepository: superUserRepository,
+		emailService:        emailService,
+		auditService:        auditService,
+	}
+}
+
+func (o *OrganizationService) CreateOrganizationService(ctx context.Context, userID uuid.UUID, organizationDTO *utils.OrganizationDTO) (*utils.OrganizationResponse, error) {
+	organization, err := o.repository.CreateOrganization(ctx, types.NewOrganization(organizationDTO.Name, userID))
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to create organization")
+	}
+	owner := types.NewOrganizationMember(organization.ID, userID, types.OrganizationRoleOwner)
+	if err := o.repository.AddOrganizationMember(ctx, owner); err != nil {
+		return nil, newerrors.Wrap(err, "failed to add owner to organization")
+	}
+
+	recordAudit(ctx, o.auditService, userID, types.AuditActionOrganizationCreated, types.AuditTargetOrganization, organization.ID,
+		utils.AuditDiff(nil, utils.AuditSnapshot(organization)))
+	return utils.TransformToOrganizationResponse(organization, owner.Role), nil
+}
+
+func (o *OrganizationService) FindMyOrganizationsService(ctx context.Context, userID uuid.UUID) ([]*utils.OrganizationResponse, error) {
+	memberships, err := o.repository.FindMembershipsBySuperUserID(ctx, userID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load memberships")
+	}
+
+	organizations := make([]*utils.OrganizationResponse, 0, len(memberships))
+	for _, membership := range memberships {
+		organization, err := o.repository.FindOrganizationByID(ctx, membership.OrganizationID)
+		if err != nil {
+			continue
+		}
+		organizations = append(organizations, utils.TransformToOrganizationResponse(organization, membership.Role))
+	}
+	return organizations, nil
+}
+
+func (o *OrganizationService) FindOrganizationService(ctx context.Context, userID, organizationID uuid.UUID) (*utils.OrganizationDetailsResponse, error) {
+	membership, err := o.FindMembershipService(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	organization, err := o.repository.FindOrganizationByID(ctx, organizationID)
+	if err != nil {
+		return nil, newerrors.NewValidationError("organization not found")
+	}
+	members, err := o.repository.FindOrganizationMembers(ctx, organizationID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load members")
+	}
+
+	details := &utils.OrganizationDetailsResponse{
+		OrganizationResponse: utils.TransformToOrganizationResponse(organization, membership.Role),
+		Members:              make([]*utils.OrganizationMemberResponse, 0, len(members)),
+	}
+	for _, member := range members {
+		details.Members = append(details.Members, o.memberResponse(ctx, member))
+	}
+	return details, nil
+}
+
+func (o *OrganizationService) FindMembershipService(ctx context.Context, userID, organizationID uuid.UUID) (*types.OrganizationMemberType, error) {
+	membership, err := o.repository.FindOrganizationMember(ctx, organizationID, userID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load membership")
+	}
+	if membership == nil {
+		return nil, newerrors.NewForbiddenError("you are not a member of this organization")
+	}
+	return membership, nil
+}
+
+func (o *OrganizationService) ChangeMemberRoleService(ctx context.Context, userID, organizationID, memberID uuid.UUID, role string) (*utils.OrganizationMemberResponse, error) {
+	actor, err := o.findManagingMember(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	member, err := o.findMember(ctx, organizationID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if (member.Role == types.OrganizationRoleOwner || role == types.OrganizationRoleOwner) && actor.Role != types.OrganizationRoleOwner {
+		return nil, newerrors.NewForbiddenError("only owners can make or unmake owners")
+	}
+	if member.Role == role {
+		return o.memberResponse(ctx, member), nil
+	}
+	if member.Role == types.OrganizationRoleOwner {
+		if err := o.ensureAnotherOwner(ctx, organizationID, memberID); err != nil {
+			return nil, err
+		}
+	}
+
+	previousRole := member.Role
+	member.Role = role
+	if err := o.repository.UpdateOrganizationMemberRole(ctx, member); err != nil {
+		return nil, newerrors.Wrap(err, "failed to change role")
+	}
+	recordAudit(ctx, o.auditService, userID, types.AuditActionOrganizationMemberRoleChanged, types.AuditTargetOrganization, organizationID, map[string]*types.AuditChangeType{
+		"member_id": types.NewAuditChange(memberID, memberID),
+		"role":      types.NewAuditChange(previousRole, role),
+	})
+	return o.memberResponse(ctx, member), nil
+}
+
+func (o *OrganizationService) RemoveMemberService(ctx context.Context, userID, organizationID, memberID uuid.UUID) error {
+	member, err := o.findMember(ctx, organizationID, memberID)
+	if err != nil {
+		return err
+	}
+	// Everybody may leave; removing somebody else takes an admin, and removing an owner another owner
+	if memberID != userID {
+		actor, err := o.findManagingMember(ctx, userID, organizationID)
+		if err != nil {
+			return err
+		}
+		if member.Role == types.OrganizationRoleOwner && actor.Role != types.OrganizationRoleOwner {
+			return newerrors.NewForbiddenError("only owners can remove owners")
+		}
+	}
+	if member.Role == types.OrganizationRoleOwner {
+		if err := o.ensureAnotherOwner(ctx, organizationID, memberID); err != nil {
+			return err
+		}
+	}
+
+	if err := o.repository.RemoveOrganizationMember(ctx, organizationID, memberID); err != nil {
+		return newerrors.Wrap(err, "failed to remove member")
+	}
+	recordAudit(ctx, o.auditService, userID, types.AuditActionOrganizationMemberRemoved, types.AuditTargetOrganization, organizationID, map[string]*types.AuditChangeType{
+		"member_id": types.NewAuditChange(memberID, nil),
+		"role":      types.NewAuditChange(member.Role, nil),
+	})
+	return nil
+}
+
+func (o *OrganizationService) InviteMemberService(ctx context.Context, userID, organizationID uuid.UUID, invitationDTO *utils.OrganizationInvitationDTO) (*types.OrganizationInvitationType, error) {
+	actor, err := o.findManagingMember(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if invitationDTO.Role == types.OrganizationRoleOwner && actor.Role != types.OrganizationRoleOwner {
+		return nil, newerrors.NewForbiddenError("only owners can invite owners")
+	}
+	organization, err := o.repository.FindOrganizationByID(ctx, organizationID)
+	if err != nil {
+		return nil, newerrors.NewValidationError("organization not found")
+	}
+	if invitee, err := o.superUserRepository.FindSuperUserByEmail(ctx, invitationDTO.Email); err == nil && invitee != nil {
+		if existing, err := o.repository.FindOrganizationMember(ctx, organizationID, invitee.ID); err == nil && existing != nil {
+			return nil, newerrors.NewConflictError(fmt.Sprintf("%s is already a member of this organization", invitationDTO.Email))
+		}
+	}
+
+	token := utils.GenerateResetToken()
+	invitation := types.NewOrganizationInvitation(organizationID, invitationDTO.Email, invitationDTO.Role,
+		utils.HashRegistrationToken(token), userID, time.Now().Add(configs.OrganizationInvitationTTL))
+	if invitation, err = o.repository.CreateOrganizationInvitation(ctx, invitation); err != nil {
+		return nil, newerrors.Wrap(err, "failed to create invitation")
+	}
+	recordAudit(ctx, o.auditService, userID, types.AuditActionOrganizationMemberInvited, types.AuditTargetOrganization, organizationID, map[string]*types.AuditChangeType{
+		"invitation_id": types.NewAuditChange(nil, invitation.ID),
+		"email":         types.NewAuditChange(nil, invitation.Email),
+		"role":          types.NewAuditChange(nil, invitation.Role),
+	})
+
+	inviterName := "A colleague"
+	if inviter, err := o.superUserRepository.FindSuperUserByID(ctx, userID); err == nil && inviter != nil {
+		inviterName = inviter.FullName
+	}
+	acceptLink := fmt.Sprintf("%s/organizations/invitations/accept?token=%s", configs.BaseURL, url.QueryEscape(token))
+	emailBody, err := htmltemplates.LoadAndRenderTemplate("organization_invitation_email.html", map[string]interface{}{
+		"InviterName":      inviterName,
+		"OrganizationName": organization.Name,
+		"Role":             invitation.Role,
+		"AcceptLink":       acceptLink,
+		"ExpiresAt":        utils.FormatEventTime(invitation.ExpiresAt, "UTC"),
+	})
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to render organization invitation email template")
+	}
+	if err := o.emailService.SendEmail([]string{invitation.Email}, fmt.Sprintf("You are invited to join %s", organization.Name), emailBody, true); err != nil {
+		return nil, newerrors.Wrap(err, "failed to send organization invitation email")
+	}
+	return invitation, nil
+}
+
+func (o *OrganizationService) FindPendingInvitationsService(ctx context.Context, userID, organizationID uuid.UUID) ([]*types.OrganizationInvitationType, error) {
+	if _, err := o.findManagingMember(ctx, userID, organizationID); err != nil {
+		return nil, err
+	}
+	return o.repository.FindPendingOrganizationInvitations(ctx, organizationID, time.Now())
+}
+
+func (o *OrganizationService) RevokeInvitationService(ctx context.Context, userID, organizationID, invitationID uuid.UUID) error {
+	if _, err := o.findManagingMember(ctx, userID, organizationID); err != nil {
+		return err
+	}
+	invitation, err := o.repository.FindOrganizationInvitationByID(ctx, invitationID)
+	if err != nil || invitation.OrganizationID != organizationID || invitation.AcceptedAt != nil {
+		return newerrors.NewValidationError("invitation not found")
+	}
+
+	if err := o.repository.DeleteOrganizationInvitation(ctx, invitationID); err != nil {
+		return newerrors.Wrap(err, "failed to revoke invitation")
+	}
+	recordAudit(ctx, o.auditService, userID, types.AuditActionOrganizationInvitationRevoked, types.AuditTargetOrganization, organizationID, map[string]*types.AuditChangeType{
+		"invitation_id": types.NewAuditChange(invitation.ID, nil),
+		"email":         types.NewAuditChange(invitation.Email, nil),
+		"role":          types.NewAuditChange(invitation.Role, nil),
+	})
+	return nil
+}
+
+func (o *OrganizationService) AcceptInvitationService(ctx context.Context, userID uuid.UUID, token string) (*utils.OrganizationResponse, error) {
+	invalidLink := newerrors.NewValidationError("invitation link is invalid or has expired")
+	tokenHash := utils.HashRegistrationToken(token)
+	invitation, err := o.repository.FindOrganizationInvitationByTokenHash(ctx, tokenHash)
+	if err != nil || invitation == nil || !invitation.IsPending(time.Now()) ||
+		subtle.ConstantTimeCompare([]byte(invitation.TokenHash), []byte(tokenHash)) != 1 {
+		return nil, invalidLink
+	}
+	superUser, err := o.superUserRepository.FindSuperUserByID(ctx, userID)
+	if err != nil || superUser == nil {
+		return nil, newerrors.NewValidationError("user not found")
+	}
+	// The link could have been forwarded, so it only works for the address it was sent to
+	if !strings.EqualFold(superUser.Email, invitation.Email) {
+		return nil, newerrors.NewForbiddenError("this invitation was sent to a different email address")
+	}
+	organization, err := o.repository.FindOrganizationByID(ctx, invitation.OrganizationID)
+	if err != nil {
+		return nil, invalidLink
+	}
+	if existing, err := o.repository.FindOrganizationMember(ctx, organization.ID, userID); err == nil && existing != nil {
+		return nil, newerrors.NewConflictError("you are already a member of this organization")
+	}
+
+	if err := o.repository.MarkOrganizationInvitationAccepted(ctx, invitation.ID, userID, time.Now()); err != nil {
+		if errors.Is(err, repositories.ErrOrganizationInvitationSpent) {
+			return nil, invalidLink
+		}
+		return nil, newerrors.Wrap(err, "failed to accept invitation")
+	}
+	member := types.NewOrganizationMember(organization.ID, userID, invitation.Role)
+	if err := o.repository.AddOrganizationMember(ctx, member); err != nil {
+		if errors.Is(err, repositories.ErrOrganizationMemberExists) {
+			return nil, newerrors.NewConflictError("you are already a member of this organization")
+		}
+		return nil, newerrors.Wrap(err, "failed to add member")
+	}
+	recordAudit(ctx, o.auditService, userID, types.AuditActionOrganizationInvitationAccepted, types.AuditTargetOrganization, organization.ID, map[string]*types.AuditChangeType{
+		"invitation_id": types.NewAuditChange(invitation.ID, invitation.ID),
+		"member_id":     types.NewAuditChange(nil, userID),
+		"role":          types.NewAuditChange(nil, member.Role),
+	})
+	return utils.TransformToOrganizationResponse(organization, member.Role), nil
+}
+
+// findManagingMember loads the membership of a user who wants to manage members or invitations, which takes an admin
+func (o *OrganizationService) findManagingMember(ctx context.Context, userID, organizationID uuid.UUID) (*types.OrganizationMemberType, error) {
+	actor, err := o.FindMembershipService(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if !types.OrganizationRoleAtLeast(actor.Role, types.OrganizationRoleAdmin) {
+		return nil, newerrors.NewForbiddenError("only owners and admins can manage members of this organization")
+	}
+	return actor, nil
+}
+
+// findMember loads a membership that is being changed
+func (o *OrganizationService) findMember(ctx context.Context, organizationID, memberID uuid.UUID) (*types.OrganizationMemberType, error) {
+	member, err := o.repository.FindOrganizationMember(ctx, organizationID, memberID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load member")
+	}
+	if member == nil {
+		return nil, newerrors.NewValidationError("member not found")
+	}
+	return member, nil
+}
+
+// ensureAnotherOwner refuses to demote or remove an owner when nobody else would be left to own the organization
+func (o *OrganizationService) ensureAnotherOwner(ctx context.Context, organizationID, ownerID uuid.UUID) error {
+	members, err := o.repository.FindOrganizationMembers(ctx, organizationID)
+	if err != nil {
+		return newerrors.Wrap(err, "failed to load members")
+	}
+	for _, member := range members {
+		if member.Role == types.OrganizationRoleOwner && member.SuperUserID != ownerID {
+			return nil
+		}
+	}
+	return newerrors.NewValidationError("an organization needs at least one owner; make someone else an owner first")
+}
+
+// memberResponse describes a member together with their account details
+func (o *OrganizationService) memberResponse(ctx context.Context, member *types.OrganizationMemberType) *utils.OrganizationMemberResponse {
+	superUser, err := o.superUserRepository.FindSuperUserByID(ctx, member.SuperUserID)
+	if err != nil {
+		superUser = nil
+	}
+	return utils.TransformToOrganizationMemberResponse(member, superUser)
+}
@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// OrganizationServiceInterface defines the methods for managing organizations, their members and invitations
+type OrganizationServiceInterface interface {
+	// CreateOrganizationService creates an organization with the user as its first owner
+	CreateOrganizationService(ctx context.Context, userID uuid.UUID, organizationDTO *utils.OrganizationDTO) (*utils.OrganizationResponse, error)
+
+	// FindMyOrganizationsService retrieves the organizations a user is a member of, with their role in each
+	FindMyOrganizationsService(ctx context.Context, userID uuid.UUID) ([]*utils.OrganizationResponse, error)
+
+	// FindOrganizationService retrieves an organization and its members for one of its members
+	FindOrganizationService(ctx context.Context, userID, organizationID uuid.UUID) (*utils.OrganizationDetailsResponse, error)
+
+	// FindMembershipService retrieves the membership of a user in an organization, or a forbidden error when they are not a member
+	FindMembershipService(ctx context.Context, userID, organizationID uuid.UUID) (*types.OrganizationMemberType, error)
+
+	// ChangeMemberRoleService changes the role of a member. Admins manage the roles below owner; only owners
+	// can make or unmake owners, and the last owner cannot be demoted.
+	ChangeMemberRoleService(ctx context.Context, userID, organizationID, memberID uuid.UUID, role string) (*utils.OrganizationMemberResponse, error)
+
+	// RemoveMemberService removes a member, or lets a user leave when memberID is their own ID. The last owner cannot leave.
+	RemoveMemberService(ctx context.Context, userID, organizationID, memberID uuid.UUID) error
+
+	// InviteMemberService emails an invitation to join the organization with a role
+	InviteMemberService(ctx context.Context, userID, organizationID uuid.UUID, invitationDTO *utils.OrganizationInvitationDTO) (*types.OrganizationInvitationType, error)
+
+	// FindPendingInvitationsService retrieves the invitations of an organization that can still be accepted
+	FindPendingInvitationsService(ctx context.Context, userID, organizationID uuid.UUID) ([]*types.OrganizationInvitationType, error)
+
+	// RevokeInvitationService withdraws a pending invitation
+	RevokeInvitationService(ctx context.Context, userID, organizationID, invitationID uuid.UUID) error
+
+	// AcceptInvitationService makes the user a member of the organization an emailed invitation token is for.
+	// The invitation must have been sent to the user's own email address.
+	AcceptInvitationService(ctx context.Context, userID uuid.UUID, token string) (*utils.OrganizationResponse, error)
+}
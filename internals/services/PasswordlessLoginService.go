@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/htmltemplates"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/mygopher/gophersmtp"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+// errInvalidLoginChallenge is the one error for every link or code that does not log in, so the response does
+// not tell a wrong code from an expired or used one
+var errInvalidLoginChallenge = newerrors.NewValidationError("invalid or expired login link or code")
+
+type PasswordlessLoginService struct {
+	repository          repositories.LoginChallengeRepositoryInterface
+	superUserRepository repositories.SuperUserRepositoryInterface
+	tokenManager        gophertoken.TokenManager
+	emailService        gophersmtp.GopherSmtpInterface
+}
+
+func NewPasswordlessLoginService(
+	repository repositories.LoginChallengeRepositoryInterface,
+	superUserRepository repositories.SuperUserRepositoryInterface,
+	tokenManager gophertoken.TokenManager,
+	emailService gophersmtp.GopherSmtpInterface,
+) PasswordlessLoginServiceInterface {
+	return &PasswordlessLoginService{
+		repository:          repository,
+		superUserRepository: superUserRepository,
+		tokenManager:        tokenManager,
+		emailService:        emailService,
+	}
+}
+
+func (p *PasswordlessLoginService) RequestLoginService(ctx context.Context, email string) (string, error) {
+	nonce := utils.GenerateResetToken()
+
+	// Unknown, deleted, deactivated and password-only accounts get no email, but the same answer
+	superUser, err := p.superUserRepository.FindSuperUserByEmail(ctx, email)
+	if err != nil || superUser == nil || superUser.DeletedAt != nil || !superUser.IsActive ||
+		!utils.AllowsPasswordlessLogin(superUser.Role) {
+		return nonce, nil
+	}
+
+	// Each account may only be sent so many login emails per window
+	if configs.PasswordlessRateLimit > 0 {
+		sent, err := p.repository.CountLoginChallengesSince(ctx, superUser.ID, time.Now().Add(-configs.PasswordlessRateWindow))
+		if err != nil {
+			return "", newerrors.Wrap(err, "failed to count login challenges")
+		}
+		if sent >= int64(configs.PasswordlessRateLimit) {
+			return nonce, nil
+		}
+	}
+
+	token := utils.GenerateResetToken()
+	code := utils.GenerateLoginCode()
+	challenge := types.NewLoginChallenge(superUser.ID, utils.HashRegistrationToken(token), utils.HashLoginCode(nonce, code),
+		utils.HashRegistrationToken(nonce), time.Now().Add(configs.PasswordlessCodeTTL))
+	if err := p.repository.CreateLoginChallenge(ctx, challenge); err != nil {
+		return "", newerrors.Wrap(err, "failed to create login challenge")
+	}
+
+	loginLink := fmt.Sprintf("%s%s/verify?token=%s", configs.BaseURL, utils.PasswordlessLoginPath, url.QueryEscape(token))
+	emailBody, err := htmltemplates.LoadAndRenderTemplate("passwordless_login_email.html", map[string]interface{}{
+		"FullName":  superUser.FullName,
+		"LoginLink": loginLink,
+		"Code":      code,
+		"ExpiresAt": utils.FormatEventTime(challenge.ExpiresAt, "UTC"),
+	})
+	if err != nil {
+		return "", newerrors.Wrap(err, "failed to render email template")
+	}
+	if err := p.emailService.SendEmail([]string{superUser.Email}, "Your EventureGo Login Link", emailBody, true); err != nil {
+		return "", newerrors.Wrap(err, "failed to send login email")
+	}
+	return nonce, nil
+}
+
+func (p *PasswordlessLoginService) LogInWithLinkService(ctx context.Context, token, nonce string) (*utils.LoginSuperuserResponse, error) {
+	challenge, err := p.repository.FindLoginChallengeByTokenHash(ctx, utils.HashRegistrationToken(token))
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to find login challenge")
+	}
+	if challenge == nil || !challenge.IsUsable(time.Now(), configs.PasswordlessMaxAttempts) {
+		return nil, errInvalidLoginChallenge
+	}
+
+	// A leaked or forwarded link is useless without the nonce cookie of the browser that asked for it
+	if nonce == "" || subtle.ConstantTimeCompare([]byte(utils.HashRegistrationToken(nonce)), []byte(challenge.NonceHash)) != 1 {
+		return nil, newerrors.NewForbiddenError("open the login link in the browser you requested it from")
+	}
+	return p.logIn(ctx, challenge)
+}
+
+func (p *PasswordlessLoginService) LogInWithCodeService(ctx context.Context, code, nonce string) (*utils.LoginSuperuserResponse, error) {
+	if nonce == "" {
+		return nil, errInvalidLoginChallenge
+	}
+	challenge, err := p.repository.FindLoginChallengeByNonceHash(ctx, utils.HashRegistrationToken(nonce))
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to find login challenge")
+	}
+	if challenge == nil || !challenge.IsUsable(time.Now(), configs.PasswordlessMaxAttempts) {
+		return nil, errInvalidLoginChallenge
+	}
+
+	if subtle.ConstantTimeCompare([]byte(utils.HashLoginCode(nonce, code)), []byte(challenge.CodeHash)) != 1 {
+		if err := p.repository.RecordFailedLoginChallengeAttempt(ctx, challenge.ID); err != nil {
+			return nil, newerrors.Wrap(err, "failed to record login attempt")
+		}
+		return nil, errInvalidLoginChallenge
+	}
+	return p.logIn(ctx, challenge)
+}
+
+func (p *PasswordlessLoginService) PurgeExpiredLoginChallengesService(ctx context.Context) (int64, error) {
+	deleted, err := p.repository.DeleteExpiredLoginChallenges(ctx, time.Now())
+	if err != nil {
+		return 0, newerrors.Wrap(err, "failed to delete expired login challenges")
+	}
+	return deleted, nil
+}
+
+// logIn uses up challenge and logs its superuser in, if their account may still log in without a password
+func (p *PasswordlessLoginService) logIn(ctx context.Context, challenge *types.LoginChallengeType) (*utils.LoginSuperuserResponse, error) {
+	if err := p.repository.UseLoginChallenge(ctx, challenge.ID, time.Now()); err != nil {
+		if errors.Is(err, repositories.ErrLoginChallengeUsed) {
+			return nil, errInvalidLoginChallenge
+		}
+		return nil, newerrors.Wrap(err, "failed to use login challenge")
+	}
+
+	superUser, err := p.superUserRepository.FindSuperUserByID(ctx, challenge.SuperUserID)
+	if err != nil || superUser == nil || superUser.DeletedAt != nil {
+		return nil, errInvalidLoginChallenge
+	}
+	if err := checkCanLogIn(superUser); err != nil {
+		return nil, err
+	}
+	if !utils.AllowsPasswordlessLogin(superUser.Role) {
+		return nil, newerrors.NewForbiddenError("this account logs in with a password")
+	}
+	return issueLogin(p.tokenManager, superUser)
+}
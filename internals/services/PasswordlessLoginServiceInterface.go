@@ -0,0 +1,24 @@
+package services
+
+import (
+	"context"
+
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// PasswordlessLoginServiceInterface defines the methods for logging superusers in with an emailed link or code
+type PasswordlessLoginServiceInterface interface {
+	// RequestLoginService emails a login link and code to the superuser with the given email, when their role
+	// allows it, and returns the nonce binding the login to the requesting browser. A nonce is returned either
+	// way, so the response does not reveal whether the account exists.
+	RequestLoginService(ctx context.Context, email string) (string, error)
+
+	// LogInWithLinkService logs in with the token of an emailed login link, from the browser holding nonce
+	LogInWithLinkService(ctx context.Context, token, nonce string) (*utils.LoginSuperuserResponse, error)
+
+	// LogInWithCodeService logs in with an emailed code, from the browser holding nonce
+	LogInWithCodeService(ctx context.Context, code, nonce string) (*utils.LoginSuperuserResponse, error)
+
+	// PurgeExpiredLoginChallengesService removes login links and codes that can no longer be used
+	PurgeExpiredLoginChallengesService(ctx context.Context) (int64, error)
+}
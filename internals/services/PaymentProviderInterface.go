@@ -0,0 +1,27 @@
+package services
+
+import (
+	"context"
+
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// PaymentProviderInterface is implemented by every payment provider orders can be paid through. Providers only
+// move money; the order service decides what a payment status means for an order.
+type PaymentProviderInterface interface {
+	// Name identifies the provider on the orders it handled
+	Name() string
+
+	// CreatePayment starts collecting the total of an order. The returned payment may already have succeeded,
+	// or may need the buyer to complete it at its checkout URL.
+	CreatePayment(ctx context.Context, order *types.OrderType) (*types.PaymentType, error)
+
+	// FindPayment retrieves the current state of a payment
+	FindPayment(ctx context.Context, paymentID string) (*types.PaymentType, error)
+
+	// CancelPayment abandons a payment that has not succeeded yet
+	CancelPayment(ctx context.Context, paymentID string) error
+
+	// RefundPayment returns amountCents of a succeeded payment to the buyer
+	RefundPayment(ctx context.Context, paymentID string, amountCents int64) error
+}
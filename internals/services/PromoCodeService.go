@@ -0,0 +1,237 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+type PromoCodeService struct {
+	repository             repositories.PromoCodeRepositoryInterface
+	eventRepository        repositories.EventRepositoryInterface
+	organizationRepository repositories.OrganizationRepositoryInterface
+	eventGrantRepository   repositories.EventGrantRepositoryInterface
+	ticketTierRepository   repositories.TicketTierRepositoryInterface
+	orderRepository        repositories.OrderRepositoryInterface
+	auditService           AuditServiceInterface
+}
+
+func NewPromoCodeService(
+	repository repositories.PromoCodeRepositoryInterface,
+	eventRepository repositories.EventRepositoryInterface,
+	organizationRepository repositories.OrganizationRepositoryInterface,
+	eventGrantRepository repositories.EventGrantRepositoryInterface,
+	ticketTierRepository repositories.TicketTierRepositoryInterface,
+	orderRepository repositories.OrderRepositoryInterface,
+	auditService AuditServiceInterface,
+) PromoCodeServiceInterface {
+	return &PromoCodeService{
+		repository:             repository,
+		eventRepository:        eventRepository,
+		organizationRepository: organizationRepository,
+		eventGrantRepository:   eventGrantRepository,
+		ticketTierRepository:   ticketTierRepository,
+		orderRepository:        orderRepository,
+		auditService:           auditService,
+	}
+}
+
+func (p *PromoCodeService) CreatePromoCodeService(ctx context.Context, organizerID, eventID uuid.UUID, promoDTO *utils.PromoCodeDTO) (*types.PromoCodeType, error) {
+	if err := p.checkOrganizerEvent(ctx, organizerID, eventID); err != nil {
+		return nil, err
+	}
+	if err := p.checkTicketTiers(ctx, eventID, promoDTO.TicketTierIDs); err != nil {
+		return nil, err
+	}
+	existing, err := p.repository.FindPromoCodeByCode(ctx, eventID, promoDTO.Code)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to look up promo code")
+	}
+	if existing != nil {
+		return nil, newerrors.NewConflictError("this event already has a promo code " + promoDTO.Code)
+	}
+
+	promoCode := types.NewPromoCode(eventID, promoDTO.Code, promoDTO.DiscountType)
+	applyPromoCodeSettings(promoCode, promoDTO)
+	createdPromoCode, err := p.repository.CreatePromoCode(ctx, promoCode)
+	if err != nil {
+		return nil, err
+	}
+	p.auditPromoCode(ctx, organizerID, types.AuditActionPromoCodeCreated, nil, createdPromoCode, createdPromoCode.ID)
+	return createdPromoCode, nil
+}
+
+func (p *PromoCodeService) FindPromoCodesService(ctx context.Context, organizerID, eventID uuid.UUID) ([]*types.PromoCodeType, error) {
+	if err := p.checkOrganizerEvent(ctx, organizerID, eventID); err != nil {
+		return nil, err
+	}
+	return p.repository.FindPromoCodesByEventID(ctx, eventID)
+}
+
+func (p *PromoCodeService) UpdatePromoCodeService(ctx context.Context, organizerID, eventID, promoCodeID uuid.UUID, promoDTO *utils.PromoCodeDTO) (*types.PromoCodeType, error) {
+	promoCode, err := p.findOrganizerPromoCode(ctx, organizerID, eventID, promoCodeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkTicketTiers(ctx, eventID, promoDTO.TicketTierIDs); err != nil {
+		return nil, err
+	}
+
+	before := utils.AuditSnapshot(promoCode)
+	promoCode.DiscountType = promoDTO.DiscountType
+	applyPromoCodeSettings(promoCode, promoDTO)
+	promoCode.UpdatedAt = time.Now()
+	if err := p.repository.UpdatePromoCode(ctx, promoCode); err != nil {
+		return nil, newerrors.Wrap(err, "failed to update promo code")
+	}
+	// Reload for the current redemption count
+	updatedPromoCode, err := p.repository.FindPromoCodeByID(ctx, promoCodeID)
+	if err != nil {
+		return nil, err
+	}
+	p.auditPromoCode(ctx, organizerID, types.AuditActionPromoCodeUpdated, before, updatedPromoCode, promoCodeID)
+	return updatedPromoCode, nil
+}
+
+func (p *PromoCodeService) DeletePromoCodeService(ctx context.Context, organizerID, eventID, promoCodeID uuid.UUID) error {
+	promoCode, err := p.findOrganizerPromoCode(ctx, organizerID, eventID, promoCodeID)
+	if err != nil {
+		return err
+	}
+	if promoCode.Redemptions > 0 {
+		return newerrors.NewConflictError("promo code has been redeemed; deactivate it instead")
+	}
+	before := utils.AuditSnapshot(promoCode)
+	if err := p.repository.DeletePromoCodeByID(ctx, promoCodeID); err != nil {
+		return newerrors.Wrap(err, "failed to delete promo code")
+	}
+	p.auditPromoCode(ctx, organizerID, types.AuditActionPromoCodeDeleted, before, nil, promoCodeID)
+	return nil
+}
+
+func (p *PromoCodeService) PromoCodeUsageReportService(ctx context.Context, organizerID, eventID uuid.UUID) ([]*utils.PromoCodeUsageReport, error) {
+	if err := p.checkOrganizerEvent(ctx, organizerID, eventID); err != nil {
+		return nil, err
+	}
+	promoCodes, err := p.repository.FindPromoCodesByEventID(ctx, eventID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load promo codes")
+	}
+	redemptions, err := p.repository.FindPromoRedemptionsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load promo code redemptions")
+	}
+	orders, err := p.orderRepository.FindOrdersByEventID(ctx, eventID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load orders")
+	}
+
+	reports := make([]*utils.PromoCodeUsageReport, 0, len(promoCodes))
+	byID := make(map[uuid.UUID]*utils.PromoCodeUsageReport, len(promoCodes))
+	for _, promoCode := range promoCodes {
+		report := utils.NewPromoCodeUsageReport(promoCode)
+		reports = append(reports, report)
+		byID[promoCode.ID] = report
+	}
+
+	users := make(map[uuid.UUID]map[uuid.UUID]bool, len(promoCodes))
+	for _, redemption := range redemptions {
+		report, exists := byID[redemption.PromoCodeID]
+		if !exists {
+			continue
+		}
+		if users[redemption.PromoCodeID] == nil {
+			users[redemption.PromoCodeID] = make(map[uuid.UUID]bool)
+		}
+		users[redemption.PromoCodeID][redemption.UserID] = true
+		if report.LastRedeemedAt == nil || redemption.CreatedAt.After(*report.LastRedeemedAt) {
+			redeemedAt := redemption.CreatedAt
+			report.LastRedeemedAt = &redeemedAt
+		}
+	}
+	for id, report := range byID {
+		report.UniqueUsers = len(users[id])
+	}
+
+	for _, order := range orders {
+		if order.PromoCodeID == nil {
+			continue
+		}
+		report, exists := byID[*order.PromoCodeID]
+		if !exists {
+			continue
+		}
+		switch order.Status {
+		case types.OrderStatusPending:
+			report.PendingOrders++
+		case types.OrderStatusConfirmed:
+			report.ConfirmedOrders++
+			report.TicketsSold += order.Quantity
+			report.TotalDiscountCents += order.DiscountCents
+		case types.OrderStatusRefunded:
+			report.RefundedOrders++
+		}
+	}
+	return reports, nil
+}
+
+// checkOrganizerEvent makes sure an event exists and the user may manage its promo codes
+func (p *PromoCodeService) checkOrganizerEvent(ctx context.Context, organizerID, eventID uuid.UUID) error {
+	event, err := p.eventRepository.FindEventByID(ctx, eventID)
+	if err != nil || event == nil {
+		return newerrors.NewValidationError("event not found")
+	}
+	if !hasEventAccess(ctx, p.organizationRepository, p.eventGrantRepository, organizerID, event, types.OrganizationRoleEditor, types.EventGrantRoleEditor) {
+		return newerrors.NewForbiddenError("only the organizer or an editor can manage the promo codes of this event")
+	}
+	return nil
+}
+
+// findOrganizerPromoCode loads a promo code of an event its organizer is managing
+func (p *PromoCodeService) findOrganizerPromoCode(ctx context.Context, organizerID, eventID, promoCodeID uuid.UUID) (*types.PromoCodeType, error) {
+	if err := p.checkOrganizerEvent(ctx, organizerID, eventID); err != nil {
+		return nil, err
+	}
+	promoCode, err := p.repository.FindPromoCodeByID(ctx, promoCodeID)
+	if err != nil || promoCode == nil || promoCode.EventID != eventID {
+		return nil, newerrors.NewValidationError("promo code not found")
+	}
+	return promoCode, nil
+}
+
+// checkTicketTiers makes sure every tier a promo code is restricted to belongs to the event
+func (p *PromoCodeService) checkTicketTiers(ctx context.Context, eventID uuid.UUID, tierIDs []uuid.UUID) error {
+	for _, tierID := range tierIDs {
+		tier, err := p.ticketTierRepository.FindTicketTierByID(ctx, tierID)
+		if err != nil || tier == nil || tier.EventID != eventID {
+			return newerrors.NewValidationError("ticket tier " + tierID.String() + " not found")
+		}
+	}
+	return nil
+}
+
+// auditPromoCode records an action on a promo code with the fields it changed since the before snapshot;
+// promoCode is nil once the code is deleted
+func (p *PromoCodeService) auditPromoCode(ctx context.Context, actorID uuid.UUID, action string, before map[string]json.RawMessage, promoCode *types.PromoCodeType, promoCodeID uuid.UUID) {
+	changes := utils.AuditDiff(before, utils.AuditSnapshot(promoCode))
+	recordAudit(ctx, p.auditService, actorID, action, types.AuditTargetPromoCode, promoCodeID, changes)
+}
+
+// applyPromoCodeSettings copies the editable settings of a promo code from the DTO
+func applyPromoCodeSettings(promoCode *types.PromoCodeType, promoDTO *utils.PromoCodeDTO) {
+	promoCode.PercentOff = promoDTO.PercentOff
+	promoCode.AmountOffCents = promoDTO.AmountOffCents
+	promoCode.Currency = promoDTO.Currency
+	promoCode.MaxRedemptions = promoDTO.MaxRedemptions
+	promoCode.MaxRedemptionsPerUser = promoDTO.MaxRedemptionsPerUser
+	promoCode.ValidFrom = promoDTO.ValidFrom
+	promoCode.ValidUntil = promoDTO.ValidUntil
+	promoCode.TicketTierIDs = promoDTO.TicketTierIDs
+	promoCode.IsActive = promoDTO.IsActive
+}
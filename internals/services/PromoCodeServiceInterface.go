@@ -0,0 +1,28 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// PromoCodeServiceInterface defines the methods for managing the promo codes of events
+type PromoCodeServiceInterface interface {
+	// CreatePromoCodeService adds a promo code to an event of the organizer; codes are unique per event
+	CreatePromoCodeService(ctx context.Context, organizerID, eventID uuid.UUID, promoDTO *utils.PromoCodeDTO) (*types.PromoCodeType, error)
+
+	// FindPromoCodesService lists the promo codes of an event for its organizer
+	FindPromoCodesService(ctx context.Context, organizerID, eventID uuid.UUID) ([]*types.PromoCodeType, error)
+
+	// UpdatePromoCodeService replaces the discount, limits, validity window, tiers and active flag of a promo code.
+	// Orders placed earlier keep the discount they got.
+	UpdatePromoCodeService(ctx context.Context, organizerID, eventID, promoCodeID uuid.UUID, promoDTO *utils.PromoCodeDTO) (*types.PromoCodeType, error)
+
+	// DeletePromoCodeService removes a promo code nobody has redeemed
+	DeletePromoCodeService(ctx context.Context, organizerID, eventID, promoCodeID uuid.UUID) error
+
+	// PromoCodeUsageReportService reports how often each promo code of an event was used
+	PromoCodeUsageReportService(ctx context.Context, organizerID, eventID uuid.UUID) ([]*utils.PromoCodeUsageReport, error)
+}
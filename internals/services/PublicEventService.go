@@ -0,0 +1,223 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/htmltemplates"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/mygopher/gophersmtp"
+)
+
+// registrationEmailInterval is how long a pending self-registration waits before another confirmation email is sent
+const registrationEmailInterval = time.Minute
+
+type PublicEventService struct {
+	eventRepository repositories.EventRepositoryInterface
+	venueRepository repositories.VenueRepositoryInterface
+	formRepository  repositories.RegistrationFormRepositoryInterface
+	guestRepository repositories.GuestRepositoryInterface
+	guestService    GuestServiceInterface
+	emailService    gophersmtp.GopherSmtpInterface
+	auditService    AuditServiceInterface
+}
+
+func NewPublicEventService(
+	eventRepository repositories.EventRepositoryInterface,
+	venueRepository repositories.VenueRepositoryInterface,
+	formRepository repositories.RegistrationFormRepositoryInterface,
+	guestRepository repositories.GuestRepositoryInterface,
+	guestService GuestServiceInterface,
+	emailService gophersmtp.GopherSmtpInterface,
+	auditService AuditServiceInterface,
+) PublicEventServiceInterface {
+	return &PublicEventService{
+		eventRepository: eventRepository,
+		venueRepository: venueRepository,
+		formRepository:  formRepository,
+		guestRepository: guestRepository,
+		guestService:    guestService,
+		emailService:    emailService,
+		auditService:    auditService,
+	}
+}
+
+func (p *PublicEventService) FindPublicEventService(ctx context.Context, slug string) (*utils.PublicEventResponse, error) {
+	event, err := p.findPublicEvent(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	form, err := p.formRepository.FindRegistrationFormByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load registration form")
+	}
+
+	var spotsLeft *int
+	if capacity := eventCapacity(ctx, p.venueRepository, event); capacity > 0 {
+		counts, err := p.guestRepository.CountGuestsByRSVPStatus(ctx, event.ID)
+		if err != nil {
+			return nil, newerrors.Wrap(err, "failed to count RSVPs")
+		}
+		left := capacity - int(counts[types.RSVPStatusAccepted])
+		if left < 0 {
+			left = 0
+		}
+		spotsLeft = &left
+	}
+	return utils.TransformToPublicEventResponse(event, form, registrationOpen(event), spotsLeft), nil
+}
+
+func (p *PublicEventService) RegisterForEventService(ctx context.Context, slug string, registrationDTO *utils.SelfRegistrationDTO) error {
+	event, err := p.findPublicEvent(ctx, slug)
+	if err != nil {
+		return err
+	}
+	if registrationDTO.Honeypot != "" {
+		// Only bots fill in the hidden field; they are told it worked so they do not try again
+		log.Printf("Ignored a self-registration for event %s that filled in the honeypot", event.ID)
+		return nil
+	}
+	if !registrationOpen(event) {
+		return newerrors.NewValidationError("registration for this event is closed")
+	}
+	email, err := normalizeGuestEmail(registrationDTO.Email)
+	if err != nil {
+		return newerrors.NewValidationError(err.Error())
+	}
+
+	form, err := p.formRepository.FindRegistrationFormByEventID(ctx, event.ID)
+	if err != nil {
+		return newerrors.Wrap(err, "failed to load registration form")
+	}
+	var answers map[string][]string
+	if form != nil {
+		if answers, err = utils.ParseRegistrationAnswers(form, registrationDTO.Answers); err != nil {
+			return newerrors.NewValidationError(err.Error())
+		}
+		if field := utils.MissingRegistrationAnswer(form, answers); field != nil {
+			return newerrors.NewValidationError(fmt.Sprintf("%s is required", field.Label))
+		}
+	} else if len(registrationDTO.Answers) > 0 {
+		return newerrors.NewValidationError("event has no registration form")
+	}
+
+	guest, err := p.guestRepository.FindGuestByEventIDAndEmail(ctx, event.ID, email)
+	if err != nil {
+		return newerrors.Wrap(err, "failed to load guest")
+	}
+	if guest != nil && guest.PendingRegistration != nil && time.Since(guest.PendingRegistration.RequestedAt) < registrationEmailInterval {
+		// A confirmation email was just sent; do not flood the inbox
+		return nil
+	}
+
+	// The response is the same whether or not the address is already on the guest list,
+	// so the form cannot be used to find out who is coming
+	token := utils.GenerateResetToken()
+	pending := &types.GuestRegistrationType{
+		TokenHash:   utils.HashRegistrationToken(token),
+		FullName:    registrationDTO.FullName,
+		Answers:     answers,
+		RequestedAt: time.Now(),
+		ExpiresAt:   time.Now().Add(configs.RegistrationConfirmationTTL),
+	}
+	before := utils.AuditSnapshot(guest)
+	if guest == nil {
+		guest = types.NewGuest(email, registrationDTO.FullName)
+		guest.AttachToEvent(event)
+		guest.PendingRegistration = pending
+		if guest, err = p.guestRepository.AddGuest(ctx, guest); err != nil {
+			return newerrors.Wrap(err, "failed to add guest")
+		}
+	} else {
+		guest.PendingRegistration = pending
+		if err := p.guestRepository.UpdateGuest(ctx, guest); err != nil {
+			return newerrors.Wrap(err, "failed to update guest")
+		}
+	}
+	// Visitors are anonymous, so their actions carry no actor
+	recordAudit(ctx, p.auditService, uuid.Nil, types.AuditActionGuestRegistered, types.AuditTargetGuest, guest.ID,
+		utils.AuditDiff(before, utils.AuditSnapshot(guest)))
+
+	confirmLink := fmt.Sprintf("%s/e/%s/confirm?guest=%s&token=%s",
+		configs.BaseURL, url.PathEscape(event.Slug), guest.ID, url.QueryEscape(token))
+	emailBody, err := htmltemplates.LoadAndRenderTemplate("registration_confirmation_email.html", map[string]interface{}{
+		"FullName":    registrationDTO.FullName,
+		"EventTitle":  event.Title,
+		"StartTime":   utils.FormatEventTime(event.StartTime, event.Timezone),
+		"Location":    event.Location,
+		"ConfirmLink": confirmLink,
+		"ExpiresAt":   utils.FormatEventTime(pending.ExpiresAt, event.Timezone),
+	})
+	if err != nil {
+		return newerrors.Wrap(err, "failed to render registration confirmation email template")
+	}
+	if err := p.emailService.SendEmail([]string{email}, fmt.Sprintf("Confirm your registration: %s", event.Title), emailBody, true); err != nil {
+		return newerrors.Wrap(err, "failed to send registration confirmation email")
+	}
+	return nil
+}
+
+func (p *PublicEventService) ConfirmRegistrationService(ctx context.Context, slug string, guestID uuid.UUID, token string) (*utils.ConfirmedRegistrationResponse, error) {
+	event, err := p.findPublicEvent(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	invalidLink := newerrors.NewValidationError("confirmation link is invalid or has expired")
+	guest, err := p.guestRepository.FindGuestByID(ctx, guestID)
+	if err != nil || guest == nil || guest.EventID != event.ID || guest.PendingRegistration == nil {
+		return nil, invalidLink
+	}
+	pending := guest.PendingRegistration
+	if time.Now().After(pending.ExpiresAt) ||
+		subtle.ConstantTimeCompare([]byte(pending.TokenHash), []byte(utils.HashRegistrationToken(token))) != 1 {
+		return nil, invalidLink
+	}
+	if !registrationOpen(event) {
+		return nil, newerrors.NewValidationError("registration for this event is closed")
+	}
+
+	before := utils.AuditSnapshot(guest)
+	guest.FullName = pending.FullName
+	if pending.Answers != nil {
+		guest.RegistrationAnswers = pending.Answers
+	}
+	guest.PendingRegistration = nil
+	guest.UpdatedAt = time.Now()
+	if guest, err = p.guestService.ConfirmGuestRegistrationService(ctx, event, guest); err != nil {
+		return nil, err
+	}
+	recordAudit(ctx, p.auditService, uuid.Nil, types.AuditActionGuestRegistrationConfirmed, types.AuditTargetGuest, guest.ID,
+		utils.AuditDiff(before, utils.AuditSnapshot(guest)))
+	return &utils.ConfirmedRegistrationResponse{
+		FullName:   guest.FullName,
+		Email:      guest.Email,
+		RSVPStatus: guest.RSVPStatus,
+	}, nil
+}
+
+// findPublicEvent loads the event behind a slug; drafts are not public and look like they do not exist
+func (p *PublicEventService) findPublicEvent(ctx context.Context, slug string) (*types.EventType, error) {
+	event, err := p.eventRepository.FindEventBySlug(ctx, utils.NormalizeEventSlug(slug))
+	if err != nil || event == nil || event.CurrentStatus() == types.EventStatusDraft {
+		return nil, newerrors.NewValidationError("event not found")
+	}
+	return event, nil
+}
+
+// registrationOpen reports whether guests may still sign up for an event: it is published and has not ended,
+// unless it repeats
+func registrationOpen(event *types.EventType) bool {
+	if event.CurrentStatus() != types.EventStatusPublished {
+		return false
+	}
+	return event.Recurrence != nil || event.EndTime.After(time.Now())
+}
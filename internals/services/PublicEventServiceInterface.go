@@ -0,0 +1,24 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// PublicEventServiceInterface defines the methods behind the public pages of published events, which need no account
+type PublicEventServiceInterface interface {
+	// FindPublicEventService returns what anyone may see of the event behind a slug, with its registration
+	// questions and the seats left. Draft events are reported as not found.
+	FindPublicEventService(ctx context.Context, slug string) (*utils.PublicEventResponse, error)
+
+	// RegisterForEventService checks a self-registration against the event's registration form and emails a
+	// confirmation link to the address given. Nothing about an existing guest changes until the link is opened.
+	// Registrations that fill in the honeypot are dropped without an error.
+	RegisterForEventService(ctx context.Context, slug string, registrationDTO *utils.SelfRegistrationDTO) error
+
+	// ConfirmRegistrationService applies the self-registration a confirmation link was sent for and accepts
+	// the guest, or puts them on the waitlist when the venue is full
+	ConfirmRegistrationService(ctx context.Context, slug string, guestID uuid.UUID, token string) (*utils.ConfirmedRegistrationResponse, error)
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+type RegistrationFormService struct {
+	repository             repositories.RegistrationFormRepositoryInterface
+	eventRepository        repositories.EventRepositoryInterface
+	organizationRepository repositories.OrganizationRepositoryInterface
+	eventGrantRepository   repositories.EventGrantRepositoryInterface
+	auditService           AuditServiceInterface
+}
+
+func NewRegistrationFormService(
+	repository repositories.RegistrationFormRepositoryInterface,
+	eventRepository repositories.EventRepositoryInterface,
+	organizationRepository repositories.OrganizationRepositoryInterface,
+	eventGrantRepository repositories.EventGrantRepositoryInterface,
+	auditService AuditServiceInterface,
+) RegistrationFormServiceInterface {
+	return &RegistrationFormService{
+		repository:             repository,
+		eventRepository:        eventRepository,
+		organizationRepository: organizationRepository,
+		eventGrantRepository:   eventGrantRepository,
+		auditService:           auditService,
+	}
+}
+
+func (r *RegistrationFormService) FindRegistrationFormService(ctx context.Context, userID, eventID uuid.UUID) (*types.RegistrationFormType, error) {
+	event, err := r.eventRepository.FindEventByID(ctx, eventID)
+	if err != nil || event == nil {
+		return nil, newerrors.NewValidationError("event not found")
+	}
+	if event.CurrentStatus() == types.EventStatusDraft &&
+		!hasEventAccess(ctx, r.organizationRepository, r.eventGrantRepository, userID, event, types.OrganizationRoleViewer, types.EventGrantRoleCheckIn) {
+		return nil, newerrors.NewValidationError("event not found")
+	}
+
+	form, err := r.repository.FindRegistrationFormByEventID(ctx, eventID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load registration form")
+	}
+	if form == nil {
+		return nil, newerrors.NewValidationError("event has no registration form")
+	}
+	return form, nil
+}
+
+func (r *RegistrationFormService) SaveRegistrationFormService(ctx context.Context, organizerID, eventID uuid.UUID, formDTO *utils.RegistrationFormDTO) (*types.RegistrationFormType, error) {
+	if err := r.checkOrganizer(ctx, organizerID, eventID); err != nil {
+		return nil, err
+	}
+
+	previous, err := r.repository.FindRegistrationFormByEventID(ctx, eventID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load registration form")
+	}
+	before := utils.AuditSnapshot(previous)
+
+	form, err := r.repository.SaveRegistrationForm(ctx, types.NewRegistrationForm(eventID, formDTO.Fields))
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to save registration form")
+	}
+	recordAudit(ctx, r.auditService, organizerID, types.AuditActionRegistrationFormSaved, types.AuditTargetRegistrationForm, form.ID,
+		utils.AuditDiff(before, utils.AuditSnapshot(form)))
+	return form, nil
+}
+
+func (r *RegistrationFormService) DeleteRegistrationFormService(ctx context.Context, organizerID, eventID uuid.UUID) error {
+	if err := r.checkOrganizer(ctx, organizerID, eventID); err != nil {
+		return err
+	}
+	form, err := r.repository.FindRegistrationFormByEventID(ctx, eventID)
+	if err != nil {
+		return newerrors.Wrap(err, "failed to load registration form")
+	}
+	if form == nil {
+		return nil
+	}
+	if err := r.repository.DeleteRegistrationFormByEventID(ctx, eventID); err != nil {
+		return newerrors.Wrap(err, "failed to delete registration form")
+	}
+	recordAudit(ctx, r.auditService, organizerID, types.AuditActionRegistrationFormDeleted, types.AuditTargetRegistrationForm, form.ID,
+		utils.AuditDiff(utils.AuditSnapshot(form), nil))
+	return nil
+}
+
+// checkOrganizer makes sure the event exists and the user managing its form may edit it
+func (r *RegistrationFormService) checkOrganizer(ctx context.Context, organizerID, eventID uuid.UUID) error {
+	event, err := r.eventRepository.FindEventByID(ctx, eventID)
+	if err != nil || event == nil {
+		return newerrors.NewValidationError("event not found")
+	}
+	if !hasEventAccess(ctx, r.organizationRepository, r.eventGrantRepository, organizerID, event, types.OrganizationRoleEditor, types.EventGrantRoleEditor) {
+		return newerrors.NewForbiddenError("only the organizer or an editor can manage the registration form of this event")
+	}
+	return nil
+}
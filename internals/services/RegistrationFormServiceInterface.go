@@ -0,0 +1,22 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// RegistrationFormServiceInterface defines the methods for managing the registration forms of events
+type RegistrationFormServiceInterface interface {
+	// FindRegistrationFormService returns the form of an event. The form of a draft event is only shown to its organizer.
+	FindRegistrationFormService(ctx context.Context, userID, eventID uuid.UUID) (*types.RegistrationFormType, error)
+
+	// SaveRegistrationFormService creates the form of an event of the organizer, or replaces its fields.
+	// Answers guests already gave stay stored under their field keys.
+	SaveRegistrationFormService(ctx context.Context, organizerID, eventID uuid.UUID, formDTO *utils.RegistrationFormDTO) (*types.RegistrationFormType, error)
+
+	// DeleteRegistrationFormService removes the form of an event, so RSVPs no longer ask anything
+	DeleteRegistrationFormService(ctx context.Context, organizerID, eventID uuid.UUID) error
+}
@@ -0,0 +1,230 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/htmltemplates"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/mygopher/gophersmtp"
+)
+
+// reminderBatchSize caps how many due reminders are handled per poll
+const reminderBatchSize = 100
+
+// reminderPlanLookahead caps how many occurrences of a recurring event are skipped when every reminder offset
+// of an occurrence is already in the past
+const reminderPlanLookahead = 10
+
+type ReminderService struct {
+	reminderRepository repositories.ReminderRepositoryInterface
+	eventRepository    repositories.EventRepositoryInterface
+	guestRepository    repositories.GuestRepositoryInterface
+	emailService       gophersmtp.GopherSmtpInterface
+}
+
+func NewReminderService(
+	reminderRepository repositories.ReminderRepositoryInterface,
+	eventRepository repositories.EventRepositoryInterface,
+	guestRepository repositories.GuestRepositoryInterface,
+	emailService gophersmtp.GopherSmtpInterface,
+) ReminderServiceInterface {
+	return &ReminderService{
+		reminderRepository: reminderRepository,
+		eventRepository:    eventRepository,
+		guestRepository:    guestRepository,
+		emailService:       emailService,
+	}
+}
+
+func (r *ReminderService) PlanEventRemindersService(ctx context.Context, event *types.EventType) error {
+	if err := r.reminderRepository.DeletePendingRemindersByEventID(ctx, event.ID); err != nil {
+		return newerrors.Wrap(err, "failed to clear pending reminders")
+	}
+	if event.CurrentStatus() != types.EventStatusPublished || !event.IsActive {
+		return nil
+	}
+	return r.planRemindersAfter(ctx, event, time.Now().UTC())
+}
+
+func (r *ReminderService) SendDueRemindersService(ctx context.Context) error {
+	reminders, err := r.reminderRepository.FindDueReminders(ctx, time.Now().UTC(), reminderBatchSize)
+	if err != nil {
+		return newerrors.Wrap(err, "failed to load due reminders")
+	}
+
+	failed := 0
+	for _, reminder := range reminders {
+		if err := r.sendReminder(ctx, reminder); err != nil {
+			log.Printf("Failed to send reminder %s for event %s: %v", reminder.ID, reminder.EventID, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to send %d of %d due reminders", failed, len(reminders))
+	}
+	return nil
+}
+
+// sendReminder emails the guests of one due reminder who have not been reached yet, then marks it sent.
+// Guests are recorded one by one, so a failure part-way through is retried on the next poll for the rest only.
+func (r *ReminderService) sendReminder(ctx context.Context, reminder *types.ReminderType) error {
+	event, err := r.eventRepository.FindEventByID(ctx, reminder.EventID)
+	if err != nil || event == nil {
+		// The event is gone, so there is nobody left to remind
+		return r.reminderRepository.UpdateReminderStatus(ctx, reminder.ID, types.ReminderStatusSkipped)
+	}
+
+	// Reminders that came due while the server was down are useless once the occurrence has started
+	if event.CurrentStatus() != types.EventStatusPublished || !event.IsActive || !reminder.OccurrenceStart.After(time.Now()) {
+		return r.reminderRepository.UpdateReminderStatus(ctx, reminder.ID, types.ReminderStatusSkipped)
+	}
+
+	guests, err := r.guestRepository.FindGuestsByEventID(ctx, event.ID)
+	if err != nil {
+		return newerrors.Wrap(err, "failed to load guests")
+	}
+
+	occurrenceEnd := reminder.OccurrenceStart.Add(event.EndTime.Sub(event.StartTime))
+	subject := fmt.Sprintf("Reminder: %s", event.Title)
+	data := map[string]interface{}{
+		"EventTitle": event.Title,
+		"StartsIn":   formatReminderOffset(time.Duration(reminder.OffsetMinutes) * time.Minute),
+		"StartTime":  utils.FormatEventTime(reminder.OccurrenceStart, event.Timezone),
+		"EndTime":    utils.FormatEventTime(occurrenceEnd, event.Timezone),
+		"Location":   event.Location,
+	}
+
+	failed := 0
+	for _, guest := range guests {
+		if guest.RSVPStatus == types.RSVPStatusDeclined || reminder.HasNotified(guest.ID) {
+			continue
+		}
+
+		data["FullName"] = guest.FullName
+		emailBody, err := htmltemplates.LoadAndRenderTemplate("event_reminder_email.html", data)
+		if err != nil {
+			return newerrors.Wrap(err, "failed to render reminder email template")
+		}
+		if err := r.emailService.SendEmail([]string{guest.Email}, subject, emailBody, true); err != nil {
+			log.Printf("Failed to queue reminder for event %s to %s: %v", event.ID, guest.Email, err)
+			failed++
+			continue
+		}
+		if err := r.reminderRepository.MarkGuestNotified(ctx, reminder.ID, guest.ID); err != nil {
+			return newerrors.Wrap(err, "failed to record reminded guest")
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to remind %d guests", failed)
+	}
+
+	if err := r.reminderRepository.UpdateReminderStatus(ctx, reminder.ID, types.ReminderStatusSent); err != nil {
+		return newerrors.Wrap(err, "failed to mark reminder as sent")
+	}
+
+	// Once the last reminder of an occurrence is out, plan the next occurrence of a recurring series
+	if event.Recurrence == nil {
+		return nil
+	}
+	reminders, err := r.reminderRepository.FindRemindersByEventID(ctx, event.ID)
+	if err != nil {
+		return newerrors.Wrap(err, "failed to load event reminders")
+	}
+	for _, planned := range reminders {
+		if planned.Status == types.ReminderStatusPending {
+			return nil
+		}
+	}
+	return r.planRemindersAfter(ctx, event, reminder.OccurrenceStart)
+}
+
+// planRemindersAfter creates the reminders of the first occurrence starting after the given instant whose reminders
+// are still in the future, skipping offsets that were already handled for that occurrence before a restart
+func (r *ReminderService) planRemindersAfter(ctx context.Context, event *types.EventType, after time.Time) error {
+	if len(configs.ReminderOffsets) == 0 {
+		return nil
+	}
+
+	existing, err := r.reminderRepository.FindRemindersByEventID(ctx, event.ID)
+	if err != nil {
+		return newerrors.Wrap(err, "failed to load event reminders")
+	}
+
+	now := time.Now().UTC()
+	for i := 0; i < reminderPlanLookahead; i++ {
+		occurrence := nextOccurrenceStartingAfter(event, after)
+		if occurrence == nil {
+			return nil
+		}
+
+		planned := 0
+		for _, offset := range configs.ReminderOffsets {
+			reminder := types.NewReminder(event.ID, occurrence.StartTime, offset)
+			if !reminder.SendAt.After(now) || reminderAlreadyHandled(existing, reminder) {
+				continue
+			}
+			if _, err := r.reminderRepository.CreateReminder(ctx, reminder); err != nil {
+				return newerrors.Wrap(err, "failed to plan reminder")
+			}
+			planned++
+		}
+		if planned > 0 || event.Recurrence == nil {
+			return nil
+		}
+		after = occurrence.StartTime
+	}
+	return nil
+}
+
+// nextOccurrenceStartingAfter returns the first occurrence that starts strictly after the given instant, or nil
+func nextOccurrenceStartingAfter(event *types.EventType, after time.Time) *utils.EventOccurrence {
+	// The first overlapping occurrence may already be under way, so look at one more
+	for _, occurrence := range utils.ExpandEventOccurrences(event, after, after.AddDate(100, 0, 0), 2) {
+		if occurrence.StartTime.After(after) {
+			return &occurrence
+		}
+	}
+	return nil
+}
+
+// reminderAlreadyHandled reports whether a reminder for the same occurrence and offset was already sent or skipped
+func reminderAlreadyHandled(existing []*types.ReminderType, reminder *types.ReminderType) bool {
+	for _, handled := range existing {
+		if handled.Status != types.ReminderStatusPending &&
+			handled.OffsetMinutes == reminder.OffsetMinutes &&
+			handled.OccurrenceStart.Equal(reminder.OccurrenceStart) {
+			return true
+		}
+	}
+	return false
+}
+
+// formatReminderOffset renders an offset such as 24h or 90m as "24 hours" or "1 hour 30 minutes"
+func formatReminderOffset(offset time.Duration) string {
+	hours := int(offset / time.Hour)
+	minutes := int((offset % time.Hour) / time.Minute)
+
+	unit := func(value int, name string) string {
+		if value == 1 {
+			return fmt.Sprintf("1 %s", name)
+		}
+		return fmt.Sprintf("%d %ss", value, name)
+	}
+
+	switch {
+	case hours > 0 && minutes > 0:
+		return unit(hours, "hour") + " " + unit(minutes, "minute")
+	case hours > 0:
+		return unit(hours, "hour")
+	default:
+		return unit(minutes, "minute")
+	}
+}
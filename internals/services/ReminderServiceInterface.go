@@ -0,0 +1,18 @@
+package services
+
+import (
+	"context"
+
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// ReminderServiceInterface defines the methods for planning and sending reminder emails before events
+type ReminderServiceInterface interface {
+	// PlanEventRemindersService replaces the pending reminders of an event with one per configured offset before its next
+	// occurrence. Only published events get reminders, so calling it after a cancellation just clears them.
+	PlanEventRemindersService(ctx context.Context, event *types.EventType) error
+
+	// SendDueRemindersService emails every guest of each reminder that has come due and records who was reached,
+	// so a restart part-way through never emails a guest twice
+	SendDueRemindersService(ctx context.Context) error
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/mygopher/gophertoken"
+)
+
+// ErrSessionRevoked is returned for a token issued before its superuser revoked their sessions
+var ErrSessionRevoked = errors.New("session has been revoked, please log in again")
+
+// sessionTokenManager wraps a TokenManager so that validating a token also checks it was issued after the
+// superuser last revoked their sessions, and that the account may still be used. The auth middlewares only see a TokenManager, so every route
+// rejects revoked sessions without changes of its own.
+type sessionTokenManager struct {
+	gophertoken.TokenManager
+	superUserRepository repositories.SuperUserRepositoryInterface
+}
+
+// NewSessionTokenManager returns a TokenManager that rejects tokens of revoked sessions
+func NewSessionTokenManager(tokenManager gophertoken.TokenManager, superUserRepository repositories.SuperUserRepositoryInterface) gophertoken.TokenManager {
+	return &sessionTokenManager{
+		TokenManager:        tokenManager,
+		superUserRepository: superUserRepository,
+	}
+}
+
+// ValidateToken validates token and rejects it when it was issued before the sessions of its superuser were
+// revoked, or when the account is deactivated, deleted or waiting for a forced password reset. A superuser
+// that cannot be loaded fails the check.
+func (m *sessionTokenManager) ValidateToken(token string) (*gophertoken.Payload, error) {
+	payload, err := m.TokenManager.ValidateToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	superUser, err := m.superUserRepository.FindSuperUserByID(context.Background(), payload.UserID)
+	if err != nil || superUser == nil {
+		return nil, ErrSessionRevoked
+	}
+	if !superUser.IsActive || superUser.DeletedAt != nil || superUser.PasswordResetRequired {
+		return nil, ErrSessionRevoked
+	}
+	if payload.IssuedAt.Before(superUser.SessionsValidAfter) {
+		return nil, ErrSessionRevoked
+	}
+	return payload, nil
+}
+
+// revokeSessionsNow returns the time to store in SessionsValidAfter to revoke every session issued so far.
+// Some token types only keep whole seconds of the issue time, so it is truncated to the second: a token
+// issued right after the revocation stays valid, at the cost of tokens issued within the same second.
+func revokeSessionsNow() time.Time {
+	return time.Now().Truncate(time.Second)
+}
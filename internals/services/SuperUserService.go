@@ -2,8 +2,12 @@ package services
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -22,22 +26,29 @@ type SuperUserService struct {
 	repo         repositories.SuperUserRepositoryInterface
 	tokenManager gophertoken.TokenManager
 	emailService gophersmtp.GopherSmtpInterface
+	auditService AuditServiceInterface
 }
 
 func NewSuperUserService(
 	repo repositories.SuperUserRepositoryInterface,
 	tokenManager gophertoken.TokenManager,
 	emailService gophersmtp.GopherSmtpInterface,
+	auditService AuditServiceInterface,
 ) SuperUserServiceInterface {
 	return &SuperUserService{
 		repo:         repo,
 		tokenManager: tokenManager,
 		emailService: emailService,
+		auditService: auditService,
 	}
 }
 
 // RegisterSuperUser registers a new superuser and sends verification email
 func (s *SuperUserService) RegisterSuperUser(ctx context.Context, req *utils.RegisterSuperuserRequest) (*utils.RegisterSuperuserResponse, error) {
+	if err := checkPasswordPolicy(nil, req.Password); err != nil {
+		return nil, err
+	}
+
 	// Validate email and username availability via validation layer
 	if err := utils.ValidateUniqueness(ctx, req.Email, req.Username, s.repo); err != nil {
 		return nil, err
@@ -60,27 +71,11 @@ func (s *SuperUserService) RegisterSuperUser(ctx context.Context, req *utils.Reg
 	if err != nil {
 		return nil, newerrors.Wrap(err, "failed to create superuser")
 	}
-
-	// Generate verification link with OTP using the BaseURL from configuration
-	verificationLink := fmt.Sprintf("%s/superusers/verify?otp=%s", configs.BaseURL, otp)
-
-	// Prepare the email data
-	emailData := map[string]interface{}{
-		"FullName":         req.FullName,
-		"VerificationLink": verificationLink,
-		"OTP":              otp,
-	}
-
-	// Load and render the email template
-	emailBody, err := htmltemplates.LoadAndRenderTemplate("welcome_verification_email.html", emailData)
-	if err != nil {
-		return nil, newerrors.Wrap(err, "failed to render email template")
-	}
+	s.auditSuperUser(ctx, createdSuperUser.ID, types.AuditActionSuperUserRegistered, nil, createdSuperUser)
 
 	// Send the verification email
-	err = s.emailService.SendEmail([]string{req.Email}, "Welcome to EventureGo - Verify Your Account", emailBody, true)
-	if err != nil {
-		return nil, newerrors.Wrap(err, "failed to send verification email")
+	if err := s.sendVerificationEmail(createdSuperUser, otp); err != nil {
+		return nil, err
 	}
 
 	log.Printf("Generated OTP: %s", otp)
@@ -97,9 +92,11 @@ func (s *SuperUserService) VerifySuperUserOTP(ctx context.Context, otp string) (
 	}
 
 	// Mark the superuser as verified
+	before := superUserAuditSnapshot(superUser)
 	if err := s.repo.VerifySuperUserOTP(ctx, superUser); err != nil {
 		return nil, newerrors.Wrap(err, "failed to verify OTP")
 	}
+	s.auditSuperUser(ctx, superUser.ID, types.AuditActionSuperUserVerified, before, superUser)
 
 	// Send verification confirmation email
 	confirmationEmailBody, err := htmltemplates.LoadAndRenderTemplate("verification_success_email.html", map[string]interface{}{
@@ -132,7 +129,7 @@ func (s *SuperUserService) LogInSuperuser(ctx context.Context, loginRequest *uti
 		return nil, newerrors.NewValidationError("email or username is required")
 	}
 
-	if err != nil {
+	if err != nil || superUser == nil || superUser.DeletedAt != nil {
 		return nil, newerrors.NewValidationError("invalid email/username or password")
 	}
 
@@ -141,14 +138,47 @@ func (s *SuperUserService) LogInSuperuser(ctx context.Context, loginRequest *uti
 		return nil, newerrors.NewValidationError("invalid email/username or password")
 	}
 
+	if err := checkCanLogIn(superUser); err != nil {
+		return nil, err
+	}
+
+	// Roles configured for passwordless login only cannot use a password
+	if !utils.AllowsPasswordLogin(superUser.Role) {
+		return nil, newerrors.NewForbiddenError("this account logs in with an emailed link or code")
+	}
+
+	// Passwords older than the password policy allows have to be reset first
+	if expiresAt := utils.PasswordExpiresAt(passwordChangedAt(superUser)); !expiresAt.IsZero() && time.Now().After(expiresAt) {
+		return nil, newerrors.NewForbiddenError("password has expired; request a password reset to choose a new one")
+	}
+
+	return issueLogin(s.tokenManager, superUser)
+}
+
+// checkCanLogIn checks that the account of superUser may start a new session, however they prove who they are
+func checkCanLogIn(superUser *types.SuperUserType) error {
+	// Deactivated accounts cannot log in until an admin activates them again
+	if !superUser.IsActive {
+		return newerrors.NewForbiddenError("account is deactivated")
+	}
+
+	// An admin can require a new password before the account is used again
+	if superUser.PasswordResetRequired {
+		return newerrors.NewForbiddenError("password reset required; use the link in the password reset email")
+	}
+	return nil
+}
+
+// issueLogin generates the auth token of a new session of superUser and prepares the login response
+func issueLogin(tokenManager gophertoken.TokenManager, superUser *types.SuperUserType) (*utils.LoginSuperuserResponse, error) {
 	// Generate token with role
-	authToken, err := s.tokenManager.GenerateToken(superUser.ID, superUser.Username, configs.TokenExpiryDuration)
+	authToken, err := tokenManager.GenerateToken(superUser.ID, superUser.Username, configs.TokenExpiryDuration)
 	if err != nil {
 		return nil, err
 	}
 
 	// Prepare and return the response
-	return &utils.LoginSuperuserResponse{
+	response := &utils.LoginSuperuserResponse{
 		ID:           superUser.ID,
 		Email:        superUser.Email,
 		Username:     superUser.Username,
@@ -156,7 +186,11 @@ func (s *SuperUserService) LogInSuperuser(ctx context.Context, loginRequest *uti
 		Role:         superUser.Role,
 		Token:        authToken,
 		Is2FAEnabled: superUser.Is2FAEnabled,
-	}, nil
+	}
+	if expiresAt := utils.PasswordExpiresAt(passwordChangedAt(superUser)); !expiresAt.IsZero() {
+		response.PasswordExpiresAt = &expiresAt
+	}
+	return response, nil
 }
 
 func (s *SuperUserService) SendPasswordResetEmailWithUsernameOrEmail(ctx context.Context, email string, username string) error {
@@ -172,16 +206,476 @@ func (s *SuperUserService) SendPasswordResetEmailWithUsernameOrEmail(ctx context
 		return newerrors.NewValidationError("email or username is required")
 	}
 
+	if err == nil && (superUser == nil || superUser.DeletedAt != nil) {
+		err = errors.New("superuser not found")
+	}
 	if err != nil {
 		return newerrors.Wrap(err, "failed to find superuser")
 	}
 
 	// Generate and send a reset token
 	resetToken := utils.GenerateResetToken()
-	resetLink := fmt.Sprintf("%s/superuser/password-reset/%s", configs.BaseURL, resetToken)
+	if err := s.sendPasswordResetEmail(superUser, resetToken); err != nil {
+		return err
+	}
+
+	// Store the reset token and expiry in the repository
+	return s.repo.UpdateResetToken(ctx, superUser.ID, resetToken, time.Now().Add(configs.TokenExpiryDuration))
+}
+
+// ResetPassword resets the password of a superuser using a token.
+func (s *SuperUserService) ResetPassword(ctx context.Context, token, newPassword string) error {
+	superUser, err := s.repo.FindSuperUserByResetToken(ctx, token)
+	if err != nil {
+		return newerrors.NewValidationError("invalid or expired reset token")
+	}
+
+	// Check if the reset token has expired
+	if time.Now().After(superUser.ResetTokenExpiry) {
+		return newerrors.NewValidationError("reset token has expired")
+	}
+	if err := checkPasswordPolicy(superUser, newPassword); err != nil {
+		return err
+	}
+
+	// Hash the new password
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return newerrors.Wrap(err, "failed to hash new password")
+	}
+
+	// Update superuser's password and timestamp
+	before := superUserAuditSnapshot(superUser)
+	setPassword(superUser, string(hashedPassword))
+	superUser.UpdatedAt = time.Now()
+	superUser.ResetToken = nil
+	superUser.ResetTokenExpiry = time.Time{} // Clear the expiry
+	superUser.PasswordResetRequired = false
+
+	// Update the superuser record
+	if err := s.repo.UpdateSuperUser(ctx, superUser); err != nil {
+		return err
+	}
+	s.auditSuperUser(ctx, superUser.ID, types.AuditActionSuperUserPasswordReset, before, superUser)
+	return nil
+}
+
+// ChangePassword changes the password of a logged-in superuser who knows their current one. Every session is
+// revoked, and the returned login carries a fresh token for the session that made the change.
+func (s *SuperUserService) ChangePassword(ctx context.Context, superUserID uuid.UUID, req *utils.ChangePasswordRequest) (*utils.LoginSuperuserResponse, error) {
+	superUser, err := s.findOwnSuperUser(ctx, superUserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(superUser.HashedPassword), []byte(req.CurrentPassword)); err != nil {
+		return nil, newerrors.NewValidationError("current password is incorrect")
+	}
+	if err := checkPasswordPolicy(superUser, req.NewPassword); err != nil {
+		return nil, err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to hash new password")
+	}
+
+	before := superUserAuditSnapshot(superUser)
+	setPassword(superUser, string(hashedPassword))
+	superUser.SessionsValidAfter = revokeSessionsNow()
+	// A reset link sent earlier would set the password the superuser just replaced
+	superUser.ResetToken = nil
+	superUser.ResetTokenExpiry = time.Time{}
+	if err := s.repo.UpdateSuperUser(ctx, superUser); err != nil {
+		return nil, newerrors.Wrap(err, "failed to update superuser")
+	}
+	s.auditSuperUser(ctx, superUserID, types.AuditActionSuperUserPasswordChanged, before, superUser)
+	return issueLogin(s.tokenManager, superUser)
+}
 
-	// Set the reset token expiry using configs.TokenExpiryDuration
-	resetTokenExpiry := time.Now().Add(configs.TokenExpiryDuration)
+// UpdateProfile updates the profile fields a logged-in superuser may change themselves
+func (s *SuperUserService) UpdateProfile(ctx context.Context, superUserID uuid.UUID, req *utils.UpdateProfileRequest) (*utils.RegisterSuperuserResponse, error) {
+	superUser, err := s.findOwnSuperUser(ctx, superUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := superUserAuditSnapshot(superUser)
+	superUser.FullName = strings.TrimSpace(req.FullName)
+	if err := s.repo.UpdateSuperUser(ctx, superUser); err != nil {
+		return nil, newerrors.Wrap(err, "failed to update superuser")
+	}
+	s.auditSuperUser(ctx, superUserID, types.AuditActionSuperUserProfileUpdated, before, superUser)
+	return utils.CreateSuperuserResponse(superUser), nil
+}
+
+// RequestEmailChange starts changing the email address of a logged-in superuser: the new address gets a link
+// that makes the change. A later request replaces a pending one.
+func (s *SuperUserService) RequestEmailChange(ctx context.Context, superUserID uuid.UUID, req *utils.ChangeEmailRequest) error {
+	superUser, err := s.findOwnSuperUser(ctx, superUserID)
+	if err != nil {
+		return err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(superUser.HashedPassword), []byte(req.Password)); err != nil {
+		return newerrors.NewValidationError("password is incorrect")
+	}
+
+	email := strings.TrimSpace(req.Email)
+	if email == superUser.Email {
+		return newerrors.NewValidationError("this is already your email address")
+	}
+	if err := s.checkEmailAvailable(ctx, superUserID, email); err != nil {
+		return err
+	}
+
+	token := utils.GenerateResetToken()
+	tokenHash := utils.HashRegistrationToken(token)
+	superUser.PendingEmail = &email
+	superUser.EmailChangeToken = &tokenHash
+	superUser.EmailChangeExpiry = time.Now().Add(configs.EmailChangeTTL)
+	if err := s.repo.UpdateSuperUser(ctx, superUser); err != nil {
+		return newerrors.Wrap(err, "failed to update superuser")
+	}
+	recordAudit(ctx, s.auditService, superUserID, types.AuditActionSuperUserEmailChangeRequested, types.AuditTargetSuperUser, superUserID, map[string]*types.AuditChangeType{
+		"pending_email": types.NewAuditChange(nil, email),
+	})
+
+	confirmLink := fmt.Sprintf("%s/superusers/email/confirm?token=%s", configs.BaseURL, url.QueryEscape(token))
+	emailBody, err := htmltemplates.LoadAndRenderTemplate("email_change_verification_email.html", map[string]interface{}{
+		"FullName":    superUser.FullName,
+		"NewEmail":    email,
+		"ConfirmLink": confirmLink,
+		"ExpiresAt":   utils.FormatEventTime(superUser.EmailChangeExpiry, "UTC"),
+	})
+	if err != nil {
+		return newerrors.Wrap(err, "failed to render email template")
+	}
+	if err := s.emailService.SendEmail([]string{email}, "Confirm Your New Email Address", emailBody, true); err != nil {
+		return newerrors.Wrap(err, "failed to send email change verification email")
+	}
+	return nil
+}
+
+// ConfirmEmailChange makes the email change verified by token. The old address gets a notice with a link
+// that undoes the change for a while, in case someone else made it.
+func (s *SuperUserService) ConfirmEmailChange(ctx context.Context, token string) (*utils.RegisterSuperuserResponse, error) {
+	superUser, err := s.repo.FindSuperUserByEmailChangeToken(ctx, utils.HashRegistrationToken(token))
+	if err != nil || superUser == nil || superUser.PendingEmail == nil || superUser.DeletedAt != nil ||
+		time.Now().After(superUser.EmailChangeExpiry) {
+		return nil, newerrors.NewValidationError("invalid or expired email change link")
+	}
+	newEmail := *superUser.PendingEmail
+	if err := s.checkEmailAvailable(ctx, superUser.ID, newEmail); err != nil {
+		return nil, err
+	}
+
+	before := superUserAuditSnapshot(superUser)
+	oldEmail := superUser.Email
+	undoToken := utils.GenerateResetToken()
+	undoTokenHash := utils.HashRegistrationToken(undoToken)
+	superUser.Email = newEmail
+	superUser.PendingEmail = nil
+	superUser.EmailChangeToken = nil
+	superUser.EmailChangeExpiry = time.Time{}
+	superUser.PreviousEmail = &oldEmail
+	superUser.EmailUndoToken = &undoTokenHash
+	superUser.EmailUndoExpiry = time.Now().Add(configs.EmailChangeUndoTTL)
+	if err := s.repo.UpdateSuperUser(ctx, superUser); err != nil {
+		return nil, newerrors.Wrap(err, "failed to update superuser")
+	}
+	s.auditSuperUser(ctx, superUser.ID, types.AuditActionSuperUserEmailChanged, before, superUser)
+
+	undoLink := fmt.Sprintf("%s/superusers/email/undo?token=%s", configs.BaseURL, url.QueryEscape(undoToken))
+	emailBody, err := htmltemplates.LoadAndRenderTemplate("email_changed_notice_email.html", map[string]interface{}{
+		"FullName":  superUser.FullName,
+		"OldEmail":  oldEmail,
+		"NewEmail":  newEmail,
+		"UndoLink":  undoLink,
+		"ExpiresAt": utils.FormatEventTime(superUser.EmailUndoExpiry, "UTC"),
+	})
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to render email template")
+	}
+	if err := s.emailService.SendEmail([]string{oldEmail}, "Your Email Address Was Changed", emailBody, true); err != nil {
+		return nil, newerrors.Wrap(err, "failed to send email change notice")
+	}
+	return utils.CreateSuperuserResponse(superUser), nil
+}
+
+// UndoEmailChange restores the email address an email change replaced. Whoever made the change knew the
+// password, so every session is revoked and the restored address gets a link to set a new password.
+func (s *SuperUserService) UndoEmailChange(ctx context.Context, token string) error {
+	superUser, err := s.repo.FindSuperUserByEmailUndoToken(ctx, utils.HashRegistrationToken(token))
+	if err != nil || superUser == nil || superUser.PreviousEmail == nil || superUser.DeletedAt != nil ||
+		time.Now().After(superUser.EmailUndoExpiry) {
+		return newerrors.NewValidationError("invalid or expired undo link")
+	}
+	previousEmail := *superUser.PreviousEmail
+	if err := s.checkEmailAvailable(ctx, superUser.ID, previousEmail); err != nil {
+		return err
+	}
+
+	before := superUserAuditSnapshot(superUser)
+	resetToken := utils.GenerateResetToken()
+	superUser.Email = previousEmail
+	superUser.PreviousEmail = nil
+	superUser.EmailUndoToken = nil
+	superUser.EmailUndoExpiry = time.Time{}
+	superUser.PendingEmail = nil
+	superUser.EmailChangeToken = nil
+	superUser.EmailChangeExpiry = time.Time{}
+	superUser.SessionsValidAfter = revokeSessionsNow()
+	superUser.ResetToken = &resetToken
+	superUser.ResetTokenExpiry = time.Now().Add(configs.TokenExpiryDuration)
+	superUser.PasswordResetRequired = true
+	if err := s.repo.UpdateSuperUser(ctx, superUser); err != nil {
+		return newerrors.Wrap(err, "failed to update superuser")
+	}
+	s.auditSuperUser(ctx, superUser.ID, types.AuditActionSuperUserEmailChangeUndone, before, superUser)
+	return s.sendPasswordResetEmail(superUser, resetToken)
+}
+
+// findOwnSuperUser loads the account of a logged-in superuser changing it themselves
+func (s *SuperUserService) findOwnSuperUser(ctx context.Context, superUserID uuid.UUID) (*types.SuperUserType, error) {
+	superUser, err := s.FindSuperUserByID(ctx, superUserID)
+	if err != nil {
+		return nil, err
+	}
+	if superUser.DeletedAt != nil {
+		return nil, newerrors.NewValidationError("superuser not found")
+	}
+	return superUser, nil
+}
+
+// checkEmailAvailable reports a conflict when another superuser already uses email
+func (s *SuperUserService) checkEmailAvailable(ctx context.Context, superUserID uuid.UUID, email string) error {
+	if existing, err := s.repo.FindSuperUserByEmail(ctx, email); err == nil && existing != nil && existing.ID != superUserID {
+		return newerrors.NewConflictError("email already in use")
+	}
+	return nil
+}
+
+// FindSuperUserByID retrieves a superuser by ID, e.g. to check the stored role of an authenticated user
+func (s *SuperUserService) FindSuperUserByID(ctx context.Context, superUserID uuid.UUID) (*types.SuperUserType, error) {
+	superUser, err := s.repo.FindSuperUserByID(ctx, superUserID)
+	if err != nil || superUser == nil {
+		return nil, newerrors.NewValidationError("superuser not found")
+	}
+	return superUser, nil
+}
+
+// PurgeExpiredOTPs clears OTPs that can no longer be used to verify an account
+func (s *SuperUserService) PurgeExpiredOTPs(ctx context.Context) (int64, error) {
+	cleared, err := s.repo.ClearExpiredOTPs(ctx, time.Now())
+	if err != nil {
+		return 0, newerrors.Wrap(err, "failed to clear expired OTPs")
+	}
+	return cleared, nil
+}
+
+// PurgeExpiredResetTokens clears password reset tokens that can no longer be used
+func (s *SuperUserService) PurgeExpiredResetTokens(ctx context.Context) (int64, error) {
+	cleared, err := s.repo.ClearExpiredResetTokens(ctx, time.Now())
+	if err != nil {
+		return 0, newerrors.Wrap(err, "failed to clear expired reset tokens")
+	}
+	return cleared, nil
+}
+
+// ListSuperUsers lists one page of the superusers matching a query, for administrators
+func (s *SuperUserService) ListSuperUsers(ctx context.Context, query *utils.SuperUserListQuery) (*utils.SuperUserListResponse, error) {
+	if query.Page < 1 {
+		query.Page = 1
+	}
+	if query.PageSize < 1 {
+		query.PageSize = utils.SuperUserPageSize
+	}
+	if query.PageSize > utils.MaxSuperUserPageSize {
+		query.PageSize = utils.MaxSuperUserPageSize
+	}
+	offset := (query.Page - 1) * query.PageSize
+	superUsers, total, err := s.repo.ListSuperUsers(ctx, utils.TransformToSuperUserListFilter(query), offset, query.PageSize)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to list superusers")
+	}
+
+	list := &utils.SuperUserListResponse{
+		SuperUsers: make([]*utils.SuperUserAdminResponse, 0, len(superUsers)),
+		Query:      query,
+		Page:       query.Page,
+		PageSize:   query.PageSize,
+		Total:      total,
+	}
+	for _, superUser := range superUsers {
+		list.SuperUsers = append(list.SuperUsers, utils.TransformToSuperUserAdminResponse(superUser))
+	}
+	return list, nil
+}
+
+// SetSuperUserActive activates or deactivates a superuser account; admins cannot deactivate their own
+func (s *SuperUserService) SetSuperUserActive(ctx context.Context, actorID, superUserID uuid.UUID, active bool) (*types.SuperUserType, error) {
+	if actorID == superUserID && !active {
+		return nil, newerrors.NewForbiddenError("you cannot deactivate your own account")
+	}
+	superUser, err := s.findManagedSuperUser(ctx, actorID, superUserID)
+	if err != nil {
+		return nil, err
+	}
+	if superUser.IsActive == active {
+		return superUser, nil
+	}
+
+	before := superUserAuditSnapshot(superUser)
+	// Sessions end with the account's state, so a deactivated superuser is logged out everywhere
+	sessionsValidAfter := revokeSessionsNow()
+	if err := s.repo.SetSuperUserActive(ctx, superUser.ID, active, sessionsValidAfter); err != nil {
+		return nil, newerrors.Wrap(err, "failed to update superuser")
+	}
+	superUser.IsActive = active
+	superUser.SessionsValidAfter = sessionsValidAfter
+	action := types.AuditActionSuperUserDeactivated
+	if active {
+		action = types.AuditActionSuperUserActivated
+	}
+	s.auditSuperUser(ctx, actorID, action, before, superUser)
+	return superUser, nil
+}
+
+// ChangeSuperUserRole gives a superuser another role and permission groups; admins cannot change their own role
+func (s *SuperUserService) ChangeSuperUserRole(ctx context.Context, actorID, superUserID uuid.UUID, role string, permissionGroups []string) (*types.SuperUserType, error) {
+	if !isAllowedRole(role) {
+		return nil, newerrors.NewValidationError(fmt.Sprintf("role must be one of %s", strings.Join(configs.AllowedRoles, ", ")))
+	}
+	superUser, err := s.findManagedSuperUser(ctx, actorID, superUserID)
+	if err != nil {
+		return nil, err
+	}
+	if actorID == superUserID && role != superUser.Role {
+		return nil, newerrors.NewForbiddenError("you cannot change your own role")
+	}
+	if role == superUserRole {
+		if err := s.requireSuperUserActor(ctx, actorID); err != nil {
+			return nil, err
+		}
+	}
+
+	before := superUserAuditSnapshot(superUser)
+	if err := s.repo.UpdateSuperUserRole(ctx, superUser.ID, role, permissionGroups); err != nil {
+		return nil, newerrors.Wrap(err, "failed to update superuser")
+	}
+	superUser.Role = role
+	superUser.PermissionGroups = permissionGroups
+	s.auditSuperUser(ctx, actorID, types.AuditActionSuperUserRoleChanged, before, superUser)
+	return superUser, nil
+}
+
+// ForcePasswordReset emails a superuser a password reset link and blocks their login until they use it
+func (s *SuperUserService) ForcePasswordReset(ctx context.Context, actorID, superUserID uuid.UUID) error {
+	superUser, err := s.findManagedSuperUser(ctx, actorID, superUserID)
+	if err != nil {
+		return err
+	}
+
+	before := superUserAuditSnapshot(superUser)
+	resetToken := utils.GenerateResetToken()
+	sessionsValidAfter := revokeSessionsNow()
+	if err := s.repo.ForcePasswordReset(ctx, superUser.ID, resetToken, time.Now().Add(configs.TokenExpiryDuration), sessionsValidAfter); err != nil {
+		return newerrors.Wrap(err, "failed to update superuser")
+	}
+	superUser.PasswordResetRequired = true
+	superUser.SessionsValidAfter = sessionsValidAfter
+	s.auditSuperUser(ctx, actorID, types.AuditActionSuperUserPasswordResetForced, before, superUser)
+	return s.sendPasswordResetEmail(superUser, resetToken)
+}
+
+// ResendVerificationEmail sends a superuser who has not verified their account a new verification link,
+// replacing the OTP of the previous one
+func (s *SuperUserService) ResendVerificationEmail(ctx context.Context, actorID, superUserID uuid.UUID) error {
+	superUser, err := s.findManagedSuperUser(ctx, actorID, superUserID)
+	if err != nil {
+		return err
+	}
+	if superUser.IsOTPVerified {
+		return newerrors.NewValidationError("account is already verified")
+	}
+
+	otp := uuid.New().String()
+	superUser.OTP = &otp
+	superUser.OTPExpiry = time.Now().Add(15 * time.Minute)
+	if err := s.repo.UpdateSuperUser(ctx, superUser); err != nil {
+		return newerrors.Wrap(err, "failed to update superuser")
+	}
+	recordAudit(ctx, s.auditService, actorID, types.AuditActionSuperUserVerificationResent, types.AuditTargetSuperUser, superUser.ID, nil)
+	return s.sendVerificationEmail(superUser, otp)
+}
+
+// DeleteSuperUser soft-deletes a superuser: the account is deactivated and hidden from listings but kept
+// for the record. Admins cannot delete their own account.
+func (s *SuperUserService) DeleteSuperUser(ctx context.Context, actorID, superUserID uuid.UUID) error {
+	if actorID == superUserID {
+		return newerrors.NewForbiddenError("you cannot delete your own account")
+	}
+	superUser, err := s.findManagedSuperUser(ctx, actorID, superUserID)
+	if err != nil {
+		return err
+	}
+
+	before := superUserAuditSnapshot(superUser)
+	deletedAt := time.Now()
+	if err := s.repo.SoftDeleteSuperUser(ctx, superUser.ID, deletedAt); err != nil {
+		return newerrors.Wrap(err, "failed to delete superuser")
+	}
+	superUser.IsActive = false
+	superUser.DeletedAt = &deletedAt
+	superUser.SessionsValidAfter = deletedAt
+	s.auditSuperUser(ctx, actorID, types.AuditActionSuperUserDeleted, before, superUser)
+	return nil
+}
+
+// findManagedSuperUser loads a superuser an admin is about to change. Deleted superusers cannot be changed,
+// and only superusers may change the accounts of other superusers.
+func (s *SuperUserService) findManagedSuperUser(ctx context.Context, actorID, superUserID uuid.UUID) (*types.SuperUserType, error) {
+	superUser, err := s.FindSuperUserByID(ctx, superUserID)
+	if err != nil {
+		return nil, err
+	}
+	if superUser.DeletedAt != nil {
+		return nil, newerrors.NewValidationError("superuser is deleted")
+	}
+	if superUser.Role == superUserRole && actorID != superUserID {
+		if err := s.requireSuperUserActor(ctx, actorID); err != nil {
+			return nil, err
+		}
+	}
+	return superUser, nil
+}
+
+// requireSuperUserActor checks that the admin acting has the SuperUser role
+func (s *SuperUserService) requireSuperUserActor(ctx context.Context, actorID uuid.UUID) error {
+	actor, err := s.FindSuperUserByID(ctx, actorID)
+	if err != nil {
+		return err
+	}
+	if actor.Role != superUserRole {
+		return newerrors.NewForbiddenError("only superusers can manage superuser accounts")
+	}
+	return nil
+}
+
+// auditSuperUser records an action on a superuser with the fields it changed since the before snapshot
+func (s *SuperUserService) auditSuperUser(ctx context.Context, actorID uuid.UUID, action string, before map[string]json.RawMessage, superUser *types.SuperUserType) {
+	changes := utils.AuditDiff(before, superUserAuditSnapshot(superUser))
+	recordAudit(ctx, s.auditService, actorID, action, types.AuditTargetSuperUser, superUser.ID, changes)
+}
+
+// superUserAuditSnapshot captures what administrators see of a superuser, leaving secrets such as tokens out
+// of the audit log. Some repositories hand out the stored superuser, so snapshots are taken before updates.
+func superUserAuditSnapshot(superUser *types.SuperUserType) map[string]json.RawMessage {
+	return utils.AuditSnapshot(utils.TransformToSuperUserAdminResponse(superUser))
+}
+
+// sendPasswordResetEmail emails a superuser the link that resets their password with resetToken
+func (s *SuperUserService) sendPasswordResetEmail(superUser *types.SuperUserType, resetToken string) error {
+	resetLink := fmt.Sprintf("%s/superuser/password-reset/%s", configs.BaseURL, resetToken)
 
 	// Log the reset link for debugging
 	log.Printf("Sending password reset token to %s: %s\n", superUser.Email, resetLink)
@@ -204,35 +698,92 @@ func (s *SuperUserService) SendPasswordResetEmailWithUsernameOrEmail(ctx context
 	if err != nil {
 		return newerrors.Wrap(err, "failed to send reset email")
 	}
-
-	// Store the reset token and expiry in the repository
-	return s.repo.UpdateResetToken(ctx, superUser.ID, resetToken, resetTokenExpiry)
+	return nil
 }
 
-// ResetPassword resets the password of a superuser using a token.
-func (s *SuperUserService) ResetPassword(ctx context.Context, token, newPassword string) error {
-	superUser, err := s.repo.FindSuperUserByResetToken(ctx, token)
+// sendVerificationEmail emails a superuser the link that verifies their account with otp
+func (s *SuperUserService) sendVerificationEmail(superUser *types.SuperUserType, otp string) error {
+	// Generate verification link with OTP using the BaseURL from configuration
+	verificationLink := fmt.Sprintf("%s/superusers/verify?otp=%s", configs.BaseURL, otp)
+
+	// Load and render the email template
+	emailBody, err := htmltemplates.LoadAndRenderTemplate("welcome_verification_email.html", map[string]interface{}{
+		"FullName":         superUser.FullName,
+		"VerificationLink": verificationLink,
+		"OTP":              otp,
+	})
 	if err != nil {
-		return newerrors.NewValidationError("invalid or expired reset token")
+		return newerrors.Wrap(err, "failed to render email template")
 	}
 
-	// Check if the reset token has expired
-	if time.Now().After(superUser.ResetTokenExpiry) {
-		return newerrors.NewValidationError("reset token has expired")
+	err = s.emailService.SendEmail([]string{superUser.Email}, "Welcome to EventureGo - Verify Your Account", emailBody, true)
+	if err != nil {
+		return newerrors.Wrap(err, "failed to send verification email")
 	}
+	return nil
+}
 
-	// Hash the new password
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+// checkPasswordPolicy checks a new password against the password policy: its length and character classes,
+// the latest passwords of superUser and the breached password list. superUser is nil for an account being
+// registered, which has no passwords to reuse.
+func checkPasswordPolicy(superUser *types.SuperUserType, password string) error {
+	if err := utils.CheckPasswordRequirements(password); err != nil {
+		return newerrors.NewValidationError(err.Error())
+	}
+
+	if superUser != nil && configs.PasswordHistorySize > 0 {
+		previous := append([]string{superUser.HashedPassword}, superUser.PasswordHistory...)
+		for _, hashedPassword := range previous[:min(len(previous), configs.PasswordHistorySize)] {
+			if bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password)) == nil {
+				if configs.PasswordHistorySize == 1 {
+					return newerrors.NewValidationError("password must differ from your current password")
+				}
+				return newerrors.NewValidationError(fmt.Sprintf("password must differ from your last %d passwords", configs.PasswordHistorySize))
+			}
+		}
+	}
+
+	breached, err := utils.IsBreachedPassword(password)
 	if err != nil {
-		return newerrors.Wrap(err, "failed to hash new password")
+		return newerrors.Wrap(err, "failed to check the breached password list")
+	}
+	if breached {
+		return newerrors.NewValidationError("password appears in a list of breached passwords; choose another one")
 	}
+	return nil
+}
 
-	// Update superuser's password and timestamp
-	superUser.HashedPassword = string(hashedPassword)
-	superUser.UpdatedAt = time.Now()
-	superUser.ResetToken = nil
-	superUser.ResetTokenExpiry = time.Time{} // Clear the expiry
+// setPassword gives superUser the new hashedPassword, keeping the hash it replaces in the password history
+// for as long as the password policy needs it
+func setPassword(superUser *types.SuperUserType, hashedPassword string) {
+	if keep := configs.PasswordHistorySize - 1; keep > 0 {
+		history := append([]string{superUser.HashedPassword}, superUser.PasswordHistory...)
+		superUser.PasswordHistory = history[:min(len(history), keep)]
+	} else {
+		superUser.PasswordHistory = nil
+	}
+	superUser.HashedPassword = hashedPassword
+	superUser.PasswordChangedAt = time.Now()
+}
 
-	// Update the superuser record
-	return s.repo.UpdateSuperUser(ctx, superUser)
+// passwordChangedAt is when superUser chose their current password; accounts created before passwords were
+// dated count from their creation
+func passwordChangedAt(superUser *types.SuperUserType) time.Time {
+	if superUser.PasswordChangedAt.IsZero() {
+		return superUser.CreatedAt
+	}
+	return superUser.PasswordChangedAt
+}
+
+// superUserRole is the role above Admin; only superusers may grant it or manage superuser accounts
+const superUserRole = "SuperUser"
+
+// isAllowedRole reports whether role is one of the configured roles
+func isAllowedRole(role string) bool {
+	for _, allowedRole := range configs.AllowedRoles {
+		if role == allowedRole {
+			return true
+		}
+	}
+	return false
 }
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/lordofthemind/EventureGo/internals/types"
 	"github.com/lordofthemind/EventureGo/internals/utils"
 )
@@ -13,4 +14,18 @@ type SuperUserServiceInterface interface {
 	ResetPassword(ctx context.Context, token, newPassword string) error
 	SendPasswordResetEmailWithUsernameOrEmail(ctx context.Context, email string, username string) error
 	VerifySuperUserOTP(ctx context.Context, otp string) (*types.SuperUserType, error)
+	FindSuperUserByID(ctx context.Context, superUserID uuid.UUID) (*types.SuperUserType, error)
+	ChangePassword(ctx context.Context, superUserID uuid.UUID, req *utils.ChangePasswordRequest) (*utils.LoginSuperuserResponse, error)
+	UpdateProfile(ctx context.Context, superUserID uuid.UUID, req *utils.UpdateProfileRequest) (*utils.RegisterSuperuserResponse, error)
+	RequestEmailChange(ctx context.Context, superUserID uuid.UUID, req *utils.ChangeEmailRequest) error
+	ConfirmEmailChange(ctx context.Context, token string) (*utils.RegisterSuperuserResponse, error)
+	UndoEmailChange(ctx context.Context, token string) error
+	PurgeExpiredOTPs(ctx context.Context) (int64, error)
+	PurgeExpiredResetTokens(ctx context.Context) (int64, error)
+	ListSuperUsers(ctx context.Context, query *utils.SuperUserListQuery) (*utils.SuperUserListResponse, error)
+	SetSuperUserActive(ctx context.Context, actorID, superUserID uuid.UUID, active bool) (*types.SuperUserType, error)
+	ChangeSuperUserRole(ctx context.Context, actorID, superUserID uuid.UUID, role string, permissionGroups []string) (*types.SuperUserType, error)
+	ForcePasswordReset(ctx context.Context, actorID, superUserID uuid.UUID) error
+	ResendVerificationEmail(ctx context.Context, actorID, superUserID uuid.UUID) error
+	DeleteSuperUser(ctx context.Context, actorID, superUserID uuid.UUID) error
 }
@@ -0,0 +1,320 @@
+package services
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/htmltemplates"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+	"github.com/lordofthemind/mygopher/gophersmtp"
+)
+
+type TicketService struct {
+	repository             repositories.TicketRepositoryInterface
+	guestRepository        repositories.GuestRepositoryInterface
+	eventRepository        repositories.EventRepositoryInterface
+	organizationRepository repositories.OrganizationRepositoryInterface
+	superUserRepository    repositories.SuperUserRepositoryInterface
+	eventGrantRepository   repositories.EventGrantRepositoryInterface
+	emailService           gophersmtp.GopherSmtpInterface
+	eventBus               EventBusServiceInterface
+	auditService           AuditServiceInterface
+}
+
+func NewTicketService(
+	repository repositories.TicketRepositoryInterface,
+	guestRepository repositories.GuestRepositoryInterface,
+	eventRepository repositories.EventRepositoryInterface,
+	organizationRepository repositories.OrganizationRepositoryInterface,
+	superUserRepository repositories.SuperUserRepositoryInterface,
+	eventGrantRepository repositories.EventGrantRepositoryInterface,
+	emailService gophersmtp.GopherSmtpInterface,
+	eventBus EventBusServiceInterface,
+	auditService AuditServiceInterface,
+) TicketServiceInterface {
+	return &TicketService{
+		repository:             repository,
+		guestRepository:        guestRepository,
+		eventRepository:        eventRepository,
+		organizationRepository: organizationRepository,
+		superUserRepository:    superUserRepository,
+		eventGrantRepository:   eventGrantRepository,
+		emailService:           emailService,
+		eventBus:               eventBus,
+		auditService:           auditService,
+	}
+}
+
+func (t *TicketService) IssueGuestTicketService(ctx context.Context, event *types.EventType, guest *types.GuestType) (*types.TicketType, error) {
+	ticket, err := t.repository.FindValidTicketByGuestID(ctx, guest.ID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load ticket")
+	}
+	if ticket != nil {
+		return ticket, nil
+	}
+
+	ticket, err = t.repository.CreateTicket(ctx, types.NewTicket(event.ID, guest.ID))
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to issue ticket")
+	}
+
+	code := utils.SignTicketCode(configs.TicketSigningKey, ticketClaims(ticket))
+	emailBody, err := htmltemplates.LoadAndRenderTemplate("guest_ticket_email.html", map[string]interface{}{
+		"FullName":   guest.FullName,
+		"EventTitle": event.Title,
+		"StartTime":  utils.FormatEventTime(event.StartTime, event.Timezone),
+		"EndTime":    utils.FormatEventTime(event.EndTime, event.Timezone),
+		"Location":   event.Location,
+		"TicketURL":  fmt.Sprintf("%s/tickets/%s/qr.png", configs.BaseURL, code),
+	})
+	if err != nil {
+		return ticket, newerrors.Wrap(err, "failed to render ticket email template")
+	}
+	if err := t.emailService.SendEmail([]string{guest.Email}, fmt.Sprintf("Your ticket: %s", event.Title), emailBody, true); err != nil {
+		return ticket, newerrors.Wrap(err, "failed to email ticket")
+	}
+	return ticket, nil
+}
+
+func (t *TicketService) CancelGuestTicketService(ctx context.Context, guestID uuid.UUID, reason string) error {
+	ticket, err := t.repository.FindValidTicketByGuestID(ctx, guestID)
+	if err != nil {
+		return newerrors.Wrap(err, "failed to load ticket")
+	}
+	if ticket == nil {
+		return nil
+	}
+	if err := t.repository.RevokeTicket(ctx, ticket.ID, reason); err != nil && !errors.Is(err, repositories.ErrTicketNotValid) {
+		return newerrors.Wrap(err, "failed to revoke ticket")
+	}
+	return nil
+}
+
+func (t *TicketService) RevokeGuestTicketService(ctx context.Context, organizerID, eventID, guestID uuid.UUID, reason string) (*types.TicketType, error) {
+	event, err := t.eventRepository.FindEventByID(ctx, eventID)
+	if err != nil || event == nil {
+		return nil, newerrors.NewValidationError("event not found")
+	}
+	if !hasEventAccess(ctx, t.organizationRepository, t.eventGrantRepository, organizerID, event, types.OrganizationRoleEditor, types.EventGrantRoleEditor) {
+		return nil, newerrors.NewForbiddenError("only the organizer or an editor can revoke tickets for this event")
+	}
+
+	ticket, err := t.repository.FindValidTicketByGuestID(ctx, guestID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load ticket")
+	}
+	if ticket == nil || ticket.EventID != eventID {
+		return nil, newerrors.NewValidationError("guest has no valid ticket for this event")
+	}
+
+	if reason == "" {
+		reason = "revoked by organizer"
+	}
+	before := utils.AuditSnapshot(ticket)
+	if err := t.repository.RevokeTicket(ctx, ticket.ID, reason); err != nil {
+		if errors.Is(err, repositories.ErrTicketNotValid) {
+			return nil, newerrors.NewConflictError("ticket was used or revoked in the meantime")
+		}
+		return nil, newerrors.Wrap(err, "failed to revoke ticket")
+	}
+	revoked, err := t.repository.FindTicketByID(ctx, ticket.ID)
+	if err != nil {
+		return nil, err
+	}
+	recordAudit(ctx, t.auditService, organizerID, types.AuditActionTicketRevoked, types.AuditTargetTicket, revoked.ID,
+		utils.AuditDiff(before, utils.AuditSnapshot(revoked)))
+	return revoked, nil
+}
+
+func (t *TicketService) CheckInGuestService(ctx context.Context, staffID, eventID uuid.UUID, scan *utils.CheckInScanDTO) (*utils.CheckInResult, error) {
+	ctx, event, err := t.checkInEvent(ctx, staffID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return t.checkIn(ctx, staffID, event, scan, time.Now())
+}
+
+func (t *TicketService) SyncCheckInsService(ctx context.Context, staffID, eventID uuid.UUID, scans []*utils.CheckInScanDTO) ([]*utils.CheckInResult, error) {
+	ctx, event, err := t.checkInEvent(ctx, staffID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	results := make([]*utils.CheckInResult, 0, len(scans))
+	for _, scan := range scans {
+		result, err := t.checkIn(ctx, staffID, event, scan, now)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (t *TicketService) CheckInManifestService(ctx context.Context, staffID, eventID uuid.UUID) (*utils.CheckInManifestResponse, error) {
+	ctx, event, err := t.checkInEvent(ctx, staffID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	tickets, err := t.repository.FindTicketsByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load tickets")
+	}
+	guests, err := t.guestRepository.FindGuestsByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load guests")
+	}
+	names := make(map[uuid.UUID]string, len(guests))
+	for _, guest := range guests {
+		names[guest.ID] = guest.FullName
+	}
+
+	manifest := &utils.CheckInManifestResponse{
+		EventID:     event.ID,
+		Algorithm:   "Ed25519",
+		PublicKey:   base64.StdEncoding.EncodeToString(ticketPublicKey()),
+		GeneratedAt: time.Now(),
+		Tickets:     make([]*utils.CheckInManifestTicket, 0, len(tickets)),
+	}
+	for _, ticket := range tickets {
+		manifest.Tickets = append(manifest.Tickets, &utils.CheckInManifestTicket{
+			TicketID:    ticket.ID,
+			GuestID:     ticket.GuestID,
+			FullName:    names[ticket.GuestID],
+			Status:      ticket.Status,
+			CheckedInAt: ticket.CheckedInAt,
+		})
+	}
+	return manifest, nil
+}
+
+func (t *TicketService) TicketQRCodeService(code string) ([]byte, error) {
+	if _, err := utils.ParseTicketCode(ticketPublicKey(), code); err != nil {
+		return nil, newerrors.NewValidationError(err.Error())
+	}
+	png, err := utils.TicketQRCodePNG(code)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to render ticket QR code")
+	}
+	return png, nil
+}
+
+// checkInEvent loads an event and makes sure staffID may check guests in at it; the returned context is the one
+// to load the event's tickets and guests with
+func (t *TicketService) checkInEvent(ctx context.Context, staffID, eventID uuid.UUID) (context.Context, *types.EventType, error) {
+	return findEventForStaff(ctx, t.eventRepository, t.superUserRepository, t.organizationRepository, t.eventGrantRepository, staffID, eventID,
+		types.OrganizationRoleEditor, "you are not allowed to check guests in at this event")
+}
+
+// checkIn admits the guest of one scanned ticket. Live scans are stamped with now; offline scans keep the time
+// they were made, as long as that is not in the future or older than the configured maximum.
+func (t *TicketService) checkIn(ctx context.Context, staffID uuid.UUID, event *types.EventType, scan *utils.CheckInScanDTO, now time.Time) (*utils.CheckInResult, error) {
+	claims, err := utils.ParseTicketCode(ticketPublicKey(), scan.Code)
+	if err != nil {
+		return invalidCheckIn("ticket code is not genuine"), nil
+	}
+	if claims.EventID != event.ID {
+		return invalidCheckIn("ticket is for another event"), nil
+	}
+	if event.CurrentStatus() == types.EventStatusCancelled {
+		return invalidCheckIn("event is cancelled"), nil
+	}
+
+	ticket, err := t.repository.FindTicketByID(ctx, claims.TicketID)
+	if err != nil || ticket.GuestID != claims.GuestID {
+		return invalidCheckIn("ticket does not exist"), nil
+	}
+
+	result := &utils.CheckInResult{TicketID: &ticket.ID, GuestID: &ticket.GuestID}
+	guest, err := t.guestRepository.FindGuestByID(ctx, ticket.GuestID)
+	if err == nil {
+		result.FullName = guest.FullName
+	}
+
+	checkedInAt := now
+	if scan.ScannedAt != nil && scan.ScannedAt.Before(now) {
+		if configs.OfflineScanMaxAge > 0 && now.Sub(*scan.ScannedAt) > configs.OfflineScanMaxAge {
+			result.Status, result.Error = utils.CheckInStatusInvalid, "offline scan is too old to sync"
+			return result, nil
+		}
+		checkedInAt = *scan.ScannedAt
+	}
+
+	previousStatus := ticket.Status
+	err = t.repository.CheckInTicket(ctx, ticket.ID, staffID, checkedInAt)
+	if errors.Is(err, repositories.ErrTicketNotValid) {
+		// Someone else won the race, so report the state the ticket ended up in
+		if ticket, err = t.repository.FindTicketByID(ctx, ticket.ID); err != nil {
+			return nil, newerrors.Wrap(err, "failed to load ticket")
+		}
+		return rejectedCheckIn(result, ticket), nil
+	}
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to check in ticket")
+	}
+
+	if guest != nil {
+		guest.CheckedInAt = &checkedInAt
+		guest.CheckedInBy = &staffID
+		if err := t.guestRepository.UpdateGuest(ctx, guest); err != nil {
+			log.Printf("Failed to record check-in of guest %s: %v", guest.ID, err)
+		}
+	}
+	t.eventBus.Publish(types.NewDomainEvent(types.DomainEventGuestCheckedIn, event.ID, map[string]interface{}{
+		"guest_id":      ticket.GuestID,
+		"ticket_id":     ticket.ID,
+		"checked_in_by": staffID,
+		"checked_in_at": checkedInAt,
+	}))
+	recordAudit(ctx, t.auditService, staffID, types.AuditActionTicketCheckedIn, types.AuditTargetTicket, ticket.ID, map[string]*types.AuditChangeType{
+		"status":        types.NewAuditChange(previousStatus, types.TicketStatusUsed),
+		"checked_in_at": types.NewAuditChange(nil, checkedInAt),
+		"checked_in_by": types.NewAuditChange(nil, staffID),
+	})
+	result.Status, result.CheckedInAt = utils.CheckInStatusCheckedIn, &checkedInAt
+	return result, nil
+}
+
+// rejectedCheckIn explains why a ticket that is no longer valid cannot be used
+func rejectedCheckIn(result *utils.CheckInResult, ticket *types.TicketType) *utils.CheckInResult {
+	if ticket.Status == types.TicketStatusRevoked {
+		result.Status, result.Error = utils.CheckInStatusRevoked, "ticket was revoked"
+		if ticket.RevokedReason != "" {
+			result.Error += ": " + ticket.RevokedReason
+		}
+		return result
+	}
+	result.Status, result.Error = utils.CheckInStatusAlreadyCheckedIn, "ticket was already used"
+	result.CheckedInAt = ticket.CheckedInAt
+	return result
+}
+
+func invalidCheckIn(reason string) *utils.CheckInResult {
+	return &utils.CheckInResult{Status: utils.CheckInStatusInvalid, Error: reason}
+}
+
+func ticketClaims(ticket *types.TicketType) utils.TicketClaims {
+	return utils.TicketClaims{
+		TicketID: ticket.ID,
+		EventID:  ticket.EventID,
+		GuestID:  ticket.GuestID,
+		IssuedAt: ticket.IssuedAt,
+	}
+}
+
+func ticketPublicKey() ed25519.PublicKey {
+	return configs.TicketSigningKey.Public().(ed25519.PublicKey)
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// TicketServiceInterface defines the methods for issuing guest tickets and checking guests in with them
+type TicketServiceInterface interface {
+	// IssueGuestTicketService gives a guest a signed ticket and emails it as a QR code link.
+	// A guest who already holds a valid ticket keeps it and is not emailed again. The ticket is returned
+	// even when only the email failed.
+	IssueGuestTicketService(ctx context.Context, event *types.EventType, guest *types.GuestType) (*types.TicketType, error)
+
+	// CancelGuestTicketService revokes the valid ticket of a guest, if any, e.g. because the guest declined
+	CancelGuestTicketService(ctx context.Context, guestID uuid.UUID, reason string) error
+
+	// RevokeGuestTicketService lets the organizer revoke the valid ticket of a guest
+	RevokeGuestTicketService(ctx context.Context, organizerID, eventID, guestID uuid.UUID, reason string) (*types.TicketType, error)
+
+	// CheckInGuestService verifies a scanned ticket code and admits its guest once. Rejected scans are
+	// reported in the result rather than as errors.
+	CheckInGuestService(ctx context.Context, staffID, eventID uuid.UUID, scan *utils.CheckInScanDTO) (*utils.CheckInResult, error)
+
+	// SyncCheckInsService replays scans a check-in app made while offline, in order, with one result per scan
+	SyncCheckInsService(ctx context.Context, staffID, eventID uuid.UUID, scans []*utils.CheckInScanDTO) ([]*utils.CheckInResult, error)
+
+	// CheckInManifestService returns the public key and ticket states a check-in app needs to keep working offline
+	CheckInManifestService(ctx context.Context, staffID, eventID uuid.UUID) (*utils.CheckInManifestResponse, error)
+
+	// TicketQRCodeService renders a genuine ticket code as a PNG QR image
+	TicketQRCodeService(code string) ([]byte, error)
+}
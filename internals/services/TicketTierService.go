@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+type TicketTierService struct {
+	repository             repositories.TicketTierRepositoryInterface
+	eventRepository        repositories.EventRepositoryInterface
+	organizationRepository repositories.OrganizationRepositoryInterface
+	eventGrantRepository   repositories.EventGrantRepositoryInterface
+	auditService           AuditServiceInterface
+}
+
+func NewTicketTierService(
+	repository repositories.TicketTierRepositoryInterface,
+	eventRepository repositories.EventRepositoryInterface,
+	organizationRepository repositories.OrganizationRepositoryInterface,
+	eventGrantRepository repositories.EventGrantRepositoryInterface,
+	auditService AuditServiceInterface,
+) TicketTierServiceInterface {
+	return &TicketTierService{
+		repository:             repository,
+		eventRepository:        eventRepository,
+		organizationRepository: organizationRepository,
+		eventGrantRepository:   eventGrantRepository,
+		auditService:           auditService,
+	}
+}
+
+func (t *TicketTierService) CreateTicketTierService(ctx context.Context, organizerID, eventID uuid.UUID, tierDTO *utils.TicketTierDTO) (*types.TicketTierType, error) {
+	if _, err := t.findOrganizerEvent(ctx, organizerID, eventID); err != nil {
+		return nil, err
+	}
+
+	tier := types.NewTicketTier(eventID, tierDTO.Name, tierDTO.Description, tierDTO.PriceCents, tierDTO.Currency,
+		tierDTO.Quantity, tierDTO.SalesStartAt, tierDTO.SalesEndAt)
+	createdTier, err := t.repository.CreateTicketTier(ctx, tier)
+	if err != nil {
+		return nil, err
+	}
+	t.auditTier(ctx, organizerID, types.AuditActionTicketTierCreated, nil, createdTier, createdTier.ID)
+	return createdTier, nil
+}
+
+func (t *TicketTierService) FindTicketTiersService(ctx context.Context, userID, eventID uuid.UUID) ([]*types.TicketTierType, error) {
+	event, err := t.eventRepository.FindEventByID(ctx, eventID)
+	if err != nil || event == nil {
+		return nil, newerrors.NewValidationError("event not found")
+	}
+	if event.CurrentStatus() == types.EventStatusDraft &&
+		!hasEventAccess(ctx, t.organizationRepository, t.eventGrantRepository, userID, event, types.OrganizationRoleViewer, types.EventGrantRoleCheckIn) {
+		return nil, newerrors.NewValidationError("event not found")
+	}
+	return t.repository.FindTicketTiersByEventID(ctx, eventID)
+}
+
+func (t *TicketTierService) UpdateTicketTierService(ctx context.Context, organizerID, eventID, tierID uuid.UUID, tierDTO *utils.TicketTierDTO) (*types.TicketTierType, error) {
+	tier, err := t.findOrganizerTier(ctx, organizerID, eventID, tierID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := utils.AuditSnapshot(tier)
+	tier.Name = tierDTO.Name
+	tier.Description = tierDTO.Description
+	tier.PriceCents = tierDTO.PriceCents
+	tier.Currency = tierDTO.Currency
+	tier.Quantity = tierDTO.Quantity
+	tier.SalesStartAt = tierDTO.SalesStartAt
+	tier.SalesEndAt = tierDTO.SalesEndAt
+	tier.UpdatedAt = time.Now()
+
+	if err := t.repository.UpdateTicketTier(ctx, tier); err != nil {
+		if errors.Is(err, repositories.ErrTicketTierSoldOut) {
+			return nil, newerrors.NewConflictError("quantity cannot be lower than the tickets already sold and held")
+		}
+		return nil, newerrors.Wrap(err, "failed to update ticket tier")
+	}
+	// Reload for the current sold and held counts
+	updatedTier, err := t.repository.FindTicketTierByID(ctx, tierID)
+	if err != nil {
+		return nil, err
+	}
+	t.auditTier(ctx, organizerID, types.AuditActionTicketTierUpdated, before, updatedTier, tierID)
+	return updatedTier, nil
+}
+
+func (t *TicketTierService) DeleteTicketTierService(ctx context.Context, organizerID, eventID, tierID uuid.UUID) error {
+	tier, err := t.findOrganizerTier(ctx, organizerID, eventID, tierID)
+	if err != nil {
+		return err
+	}
+	before := utils.AuditSnapshot(tier)
+	if err := t.repository.DeleteTicketTierByID(ctx, tierID); err != nil {
+		if errors.Is(err, repositories.ErrTicketTierInUse) {
+			return newerrors.NewConflictError("ticket tier has orders; set its quantity to the tickets sold to stop selling it")
+		}
+		return newerrors.Wrap(err, "failed to delete ticket tier")
+	}
+	t.auditTier(ctx, organizerID, types.AuditActionTicketTierDeleted, before, nil, tierID)
+	return nil
+}
+
+// auditTier records an action on a ticket tier with the fields it changed since the before snapshot; tier is
+// nil once the tier is deleted
+func (t *TicketTierService) auditTier(ctx context.Context, actorID uuid.UUID, action string, before map[string]json.RawMessage, tier *types.TicketTierType, tierID uuid.UUID) {
+	changes := utils.AuditDiff(before, utils.AuditSnapshot(tier))
+	recordAudit(ctx, t.auditService, actorID, action, types.AuditTargetTicketTier, tierID, changes)
+}
+
+// findOrganizerEvent loads an event whose tiers the user may manage
+func (t *TicketTierService) findOrganizerEvent(ctx context.Context, organizerID, eventID uuid.UUID) (*types.EventType, error) {
+	event, err := t.eventRepository.FindEventByID(ctx, eventID)
+	if err != nil || event == nil {
+		return nil, newerrors.NewValidationError("event not found")
+	}
+	if !hasEventAccess(ctx, t.organizationRepository, t.eventGrantRepository, organizerID, event, types.OrganizationRoleEditor, types.EventGrantRoleEditor) {
+		return nil, newerrors.NewForbiddenError("only the organizer or an editor can manage the ticket tiers of this event")
+	}
+	return event, nil
+}
+
+// findOrganizerTier loads a tier of an event its organizer is managing
+func (t *TicketTierService) findOrganizerTier(ctx context.Context, organizerID, eventID, tierID uuid.UUID) (*types.TicketTierType, error) {
+	if _, err := t.findOrganizerEvent(ctx, organizerID, eventID); err != nil {
+		return nil, err
+	}
+	tier, err := t.repository.FindTicketTierByID(ctx, tierID)
+	if err != nil || tier == nil || tier.EventID != eventID {
+		return nil, newerrors.NewValidationError("ticket tier not found")
+	}
+	return tier, nil
+}
@@ -0,0 +1,26 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// TicketTierServiceInterface defines the methods for managing the ticket tiers of events
+type TicketTierServiceInterface interface {
+	// CreateTicketTierService adds a ticket tier to an event of the organizer
+	CreateTicketTierService(ctx context.Context, organizerID, eventID uuid.UUID, tierDTO *utils.TicketTierDTO) (*types.TicketTierType, error)
+
+	// FindTicketTiersService lists the ticket tiers of an event, cheapest first. Tiers of draft events are only
+	// shown to their organizer.
+	FindTicketTiersService(ctx context.Context, userID, eventID uuid.UUID) ([]*types.TicketTierType, error)
+
+	// UpdateTicketTierService replaces the details of a tier. The quantity cannot drop below the tickets already
+	// sold and held; orders placed earlier keep the price they were placed at.
+	UpdateTicketTierService(ctx context.Context, organizerID, eventID, tierID uuid.UUID, tierDTO *utils.TicketTierDTO) (*types.TicketTierType, error)
+
+	// DeleteTicketTierService removes a tier nobody has ordered from
+	DeleteTicketTierService(ctx context.Context, organizerID, eventID, tierID uuid.UUID) error
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+type VenueService struct {
+	repository      repositories.VenueRepositoryInterface
+	eventRepository repositories.EventRepositoryInterface
+	auditService    AuditServiceInterface
+}
+
+func NewVenueService(repository repositories.VenueRepositoryInterface, eventRepository repositories.EventRepositoryInterface, auditService AuditServiceInterface) VenueServiceInterface {
+	return &VenueService{
+		repository:      repository,
+		eventRepository: eventRepository,
+		auditService:    auditService,
+	}
+}
+
+func (v *VenueService) CreateVenueService(ctx context.Context, createdByID uuid.UUID, venueDTO *utils.VenueDTO) (*types.VenueType, error) {
+	venue := types.NewVenue(
+		venueDTO.Name,
+		venueDTO.AddressLine,
+		venueDTO.City,
+		venueDTO.State,
+		venueDTO.PostalCode,
+		venueDTO.Country,
+		venueDTO.Timezone,
+		venueDTO.Latitude,
+		venueDTO.Longitude,
+		venueDTO.Capacity,
+		createdByID,
+	)
+
+	createdVenue, err := v.repository.CreateVenue(ctx, venue)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to create venue")
+	}
+	recordAudit(ctx, v.auditService, createdByID, types.AuditActionVenueCreated, types.AuditTargetVenue, createdVenue.ID,
+		utils.AuditDiff(nil, utils.AuditSnapshot(createdVenue)))
+
+	return createdVenue, nil
+}
+
+func (v *VenueService) FindVenueByIDService(ctx context.Context, venueID uuid.UUID) (*types.VenueType, error) {
+	venue, err := v.repository.FindVenueByID(ctx, venueID)
+	if err != nil {
+		return nil, newerrors.NewValidationError("venue not found")
+	}
+	return venue, nil
+}
+
+func (v *VenueService) FindVenuesNearService(ctx context.Context, latitude, longitude, radiusKm float64) ([]*types.VenueType, error) {
+	if radiusKm <= 0 {
+		radiusKm = configs.DefaultSearchRadiusKm
+	}
+	return v.repository.FindVenuesWithinRadius(ctx, latitude, longitude, utils.KilometersToMeters(radiusKm))
+}
+
+func (v *VenueService) FindVenuesWithinBoundingBoxService(ctx context.Context, minLatitude, minLongitude, maxLatitude, maxLongitude float64) ([]*types.VenueType, error) {
+	return v.repository.FindVenuesWithinBoundingBox(ctx, minLatitude, minLongitude, maxLatitude, maxLongitude)
+}
+
+func (v *VenueService) FindVenueFreeSlotsService(ctx context.Context, venueID uuid.UUID, date string, minDuration time.Duration) (*utils.VenueFreeSlotsResponse, error) {
+	venue, err := v.FindVenueByIDService(ctx, venueID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The day is taken in the venue's timezone, so it may be 23 or 25 hours long around DST changes
+	location := utils.LoadEventLocation(venue.Timezone)
+	dayStart, err := time.ParseInLocation(utils.FreeSlotDateLayout, date, location)
+	if err != nil {
+		return nil, newerrors.NewValidationError("date must be formatted as YYYY-MM-DD")
+	}
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	before, after := configs.EventBufferBefore, configs.EventBufferAfter
+	// Bookings of every tenant take the venue
+	events, err := v.eventRepository.FindOverlappingEvents(repositories.WithoutTenantScope(ctx), &venueID, nil, dayStart.Add(-after), dayEnd.Add(before))
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load venue bookings")
+	}
+
+	busy := []utils.TimeSlot{}
+	for _, event := range events {
+		for _, occurrence := range utils.ExpandEventOccurrences(event, dayStart.Add(-after), dayEnd.Add(before), 0) {
+			busy = append(busy, utils.TimeSlot{StartTime: occurrence.StartTime, EndTime: occurrence.EndTime}.WithBuffer(before, after))
+		}
+	}
+
+	freeSlots := utils.FreeTimeSlots(dayStart, dayEnd, busy, minDuration)
+	for i := range freeSlots {
+		freeSlots[i].StartTime = freeSlots[i].StartTime.In(location)
+		freeSlots[i].EndTime = freeSlots[i].EndTime.In(location)
+	}
+
+	return &utils.VenueFreeSlotsResponse{
+		VenueID:   venue.ID,
+		Date:      date,
+		Timezone:  location.String(),
+		FreeSlots: freeSlots,
+	}, nil
+}
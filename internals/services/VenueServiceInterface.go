@@ -0,0 +1,28 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// VenueServiceInterface defines the methods required for managing venues
+type VenueServiceInterface interface {
+	// CreateVenueService handles the creation of a new venue
+	CreateVenueService(ctx context.Context, createdByID uuid.UUID, venue *utils.VenueDTO) (*types.VenueType, error)
+
+	// FindVenueByIDService retrieves a venue by its unique identifier
+	FindVenueByIDService(ctx context.Context, venueID uuid.UUID) (*types.VenueType, error)
+
+	// FindVenuesNearService retrieves venues within radiusKm of a point, nearest first
+	FindVenuesNearService(ctx context.Context, latitude, longitude, radiusKm float64) ([]*types.VenueType, error)
+
+	// FindVenuesWithinBoundingBoxService retrieves venues inside a bounding box
+	FindVenuesWithinBoundingBoxService(ctx context.Context, minLatitude, minLongitude, maxLatitude, maxLongitude float64) ([]*types.VenueType, error)
+
+	// FindVenueFreeSlotsService lists the gaps between bookings at a venue on a day (YYYY-MM-DD in the venue's timezone)
+	FindVenueFreeSlotsService(ctx context.Context, venueID uuid.UUID, date string, minDuration time.Duration) (*utils.VenueFreeSlotsResponse, error)
+}
@@ -0,0 +1,446 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/newerrors"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// webhookDeliveryBatchSize caps how many due deliveries one run of the delivery job attempts
+const webhookDeliveryBatchSize = 50
+
+// webhookLeaseMargin is added to the request timeout while an attempt holds a delivery, so a slow attempt
+// is never retried by another instance while it is still running
+const webhookLeaseMargin = 30 * time.Second
+
+// webhookDispatchTimeout bounds recording the deliveries of one domain event
+const webhookDispatchTimeout = 10 * time.Second
+
+// webhookDispatchQueueSize is how many published domain events may wait for their deliveries to be recorded
+// before dispatching falls back to one goroutine per event
+const webhookDispatchQueueSize = 256
+
+// webhookUserAgent identifies deliveries to the receiving endpoints
+const webhookUserAgent = "EventureGo-Webhooks/1.0"
+
+type WebhookService struct {
+	repository             repositories.WebhookRepositoryInterface
+	eventRepository        repositories.EventRepositoryInterface
+	organizationRepository repositories.OrganizationRepositoryInterface
+	eventGrantRepository   repositories.EventGrantRepositoryInterface
+	httpClient             *http.Client
+	auditService           AuditServiceInterface
+	dispatchQueue          chan *types.DomainEventType
+}
+
+func NewWebhookService(
+	repository repositories.WebhookRepositoryInterface,
+	eventRepository repositories.EventRepositoryInterface,
+	organizationRepository repositories.OrganizationRepositoryInterface,
+	eventGrantRepository repositories.EventGrantRepositoryInterface,
+	auditService AuditServiceInterface,
+) WebhookServiceInterface {
+	webhookService := &WebhookService{
+		repository:             repository,
+		eventRepository:        eventRepository,
+		organizationRepository: organizationRepository,
+		eventGrantRepository:   eventGrantRepository,
+		httpClient:             newWebhookHTTPClient(),
+		auditService:           auditService,
+		dispatchQueue:          make(chan *types.DomainEventType, webhookDispatchQueueSize),
+	}
+	go webhookService.dispatchQueuedEvents()
+	return webhookService
+}
+
+func (w *WebhookService) CreateWebhookService(ctx context.Context, organizerID uuid.UUID, webhookDTO *utils.WebhookDTO) (*types.WebhookType, error) {
+	secret, err := utils.GenerateWebhookSecret()
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to generate webhook secret")
+	}
+
+	webhook := types.NewWebhook(organizerID, webhookDTO.URL, webhookDTO.EventTypes, secret, webhookDTO.Description)
+	createdWebhook, err := w.repository.CreateWebhook(ctx, webhook)
+	if err != nil {
+		return nil, err
+	}
+	// The secret is left out of webhook JSON, so it never reaches the audit log
+	w.auditWebhook(ctx, organizerID, types.AuditActionWebhookCreated, nil, createdWebhook, createdWebhook.ID)
+	return createdWebhook, nil
+}
+
+func (w *WebhookService) FindWebhooksService(ctx context.Context, organizerID uuid.UUID) ([]*types.WebhookType, error) {
+	return w.repository.FindWebhooksByOrganizerID(ctx, organizerID)
+}
+
+func (w *WebhookService) FindWebhookService(ctx context.Context, organizerID, webhookID uuid.UUID) (*types.WebhookType, error) {
+	webhook, err := w.repository.FindWebhookByID(ctx, webhookID)
+	if err != nil {
+		return nil, newerrors.NewValidationError("webhook not found")
+	}
+	if webhook.OrganizerID != organizerID {
+		return nil, newerrors.NewForbiddenError("only the organizer who registered this webhook can manage it")
+	}
+	return webhook, nil
+}
+
+func (w *WebhookService) UpdateWebhookService(ctx context.Context, organizerID, webhookID uuid.UUID, updateDTO *utils.UpdateWebhookDTO) (*types.WebhookType, error) {
+	webhook, err := w.FindWebhookService(ctx, organizerID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := utils.AuditSnapshot(webhook)
+	if updateDTO.URL != nil {
+		webhook.URL = *updateDTO.URL
+	}
+	if updateDTO.EventTypes != nil {
+		webhook.EventTypes = updateDTO.EventTypes
+	}
+	if updateDTO.Description != nil {
+		webhook.Description = *updateDTO.Description
+	}
+	if updateDTO.IsActive != nil {
+		webhook.IsActive = *updateDTO.IsActive
+	}
+	webhook.UpdatedAt = time.Now()
+
+	if err := w.repository.UpdateWebhook(ctx, webhook); err != nil {
+		return nil, newerrors.Wrap(err, "failed to update webhook")
+	}
+	w.auditWebhook(ctx, organizerID, types.AuditActionWebhookUpdated, before, webhook, webhook.ID)
+	return webhook, nil
+}
+
+func (w *WebhookService) DeleteWebhookService(ctx context.Context, organizerID, webhookID uuid.UUID) error {
+	webhook, err := w.FindWebhookService(ctx, organizerID, webhookID)
+	if err != nil {
+		return err
+	}
+	before := utils.AuditSnapshot(webhook)
+	if err := w.repository.DeleteWebhookByID(ctx, webhookID); err != nil {
+		return newerrors.Wrap(err, "failed to delete webhook")
+	}
+	w.auditWebhook(ctx, organizerID, types.AuditActionWebhookDeleted, before, nil, webhookID)
+	return nil
+}
+
+func (w *WebhookService) FindWebhookDeliveriesService(ctx context.Context, organizerID, webhookID uuid.UUID, limit int) ([]*types.WebhookDeliveryType, error) {
+	if _, err := w.FindWebhookService(ctx, organizerID, webhookID); err != nil {
+		return nil, err
+	}
+	return w.repository.FindWebhookDeliveriesByWebhookID(ctx, webhookID, limit)
+}
+
+func (w *WebhookService) RedeliverWebhookService(ctx context.Context, organizerID, webhookID, deliveryID uuid.UUID) (*types.WebhookDeliveryType, error) {
+	webhook, err := w.FindWebhookService(ctx, organizerID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if !webhook.IsActive {
+		return nil, newerrors.NewValidationError("activate the webhook before redelivering to it")
+	}
+	original, err := w.repository.FindWebhookDeliveryByID(ctx, deliveryID)
+	if err != nil || original.WebhookID != webhook.ID {
+		return nil, newerrors.NewValidationError("webhook delivery not found")
+	}
+
+	// The payload stays the same, so receivers can tell a redelivery apart by its delivery ID only
+	delivery := types.NewWebhookDelivery(webhook.ID, original.DomainEventID, original.EventType, original.Payload)
+	delivery.RedeliveryOf = &original.ID
+	if _, err := w.repository.CreateWebhookDelivery(ctx, delivery); err != nil {
+		return nil, newerrors.Wrap(err, "failed to record webhook delivery")
+	}
+	recordAudit(ctx, w.auditService, organizerID, types.AuditActionWebhookRedelivered, types.AuditTargetWebhook, webhook.ID, map[string]*types.AuditChangeType{
+		"delivery_id":   types.NewAuditChange(nil, delivery.ID),
+		"redelivery_of": types.NewAuditChange(nil, original.ID),
+	})
+	if err := w.attemptDelivery(ctx, delivery); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (w *WebhookService) DispatchDomainEvent(event *types.DomainEventType) {
+	if !types.IsWebhookEventType(event.Type) {
+		return
+	}
+	// The event bus calls subscribers while the publishing request waits, so the deliveries are recorded later
+	select {
+	case w.dispatchQueue <- event:
+	default:
+		// A full queue must neither block the publisher nor lose the event
+		go w.dispatch(event)
+	}
+}
+
+// dispatchQueuedEvents records the deliveries of queued domain events one at a time, in the order they were published
+func (w *WebhookService) dispatchQueuedEvents() {
+	for event := range w.dispatchQueue {
+		w.dispatch(event)
+	}
+}
+
+// dispatch records the deliveries of a domain event and attempts them in the background
+func (w *WebhookService) dispatch(event *types.DomainEventType) {
+	ctx, cancel := context.WithTimeout(context.Background(), webhookDispatchTimeout)
+	defer cancel()
+
+	deliveries, err := w.recordDeliveries(ctx, event)
+	if err != nil {
+		log.Printf("Failed to record webhook deliveries of %s for event %s: %v", event.Type, event.EventID, err)
+		return
+	}
+
+	// Endpoints are called in the background; whatever fails here is picked up again by the delivery job
+	for _, delivery := range deliveries {
+		go func(delivery *types.WebhookDeliveryType) {
+			if err := w.attemptDelivery(context.Background(), delivery); err != nil {
+				log.Printf("Failed to attempt webhook delivery %s: %v", delivery.ID, err)
+			}
+		}(delivery)
+	}
+}
+
+func (w *WebhookService) DeliverDueWebhooksService(ctx context.Context) (int, error) {
+	deliveries, err := w.repository.FindDueWebhookDeliveries(ctx, time.Now(), webhookDeliveryBatchSize)
+	if err != nil {
+		return 0, newerrors.Wrap(err, "failed to load due webhook deliveries")
+	}
+
+	// One delivery that cannot be attempted does not hold up the others
+	delivered := 0
+	var firstErr error
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return delivered, ctx.Err()
+		}
+		if err := w.attemptDelivery(ctx, delivery); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if delivery.Status == types.WebhookDeliveryStatusSucceeded {
+			delivered++
+		}
+	}
+	return delivered, firstErr
+}
+
+// recordDeliveries stores a pending delivery of the domain event for every webhook subscribed to it
+func (w *WebhookService) recordDeliveries(ctx context.Context, event *types.DomainEventType) ([]*types.WebhookDeliveryType, error) {
+	eventRecord, err := w.eventRepository.FindEventByID(ctx, event.EventID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load event")
+	}
+	if eventRecord == nil {
+		// Deleted since the domain event was published; nobody runs it any more
+		return nil, nil
+	}
+	webhooks, err := w.eventWebhooks(ctx, eventRecord, event.Type)
+	if err != nil || len(webhooks) == 0 {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to encode webhook payload")
+	}
+
+	deliveries := make([]*types.WebhookDeliveryType, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		delivery := types.NewWebhookDelivery(webhook.ID, event.ID, event.Type, string(payload))
+		if _, err := w.repository.CreateWebhookDelivery(ctx, delivery); err != nil {
+			return deliveries, newerrors.Wrap(err, "failed to record webhook delivery")
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, nil
+}
+
+// eventWebhooks returns the active webhooks subscribed to eventType of everyone who runs the event: its organizer,
+// the owners and admins of its organization and its co-organizers
+func (w *WebhookService) eventWebhooks(ctx context.Context, event *types.EventType, eventType string) ([]*types.WebhookType, error) {
+	candidates := []uuid.UUID{event.OrganizerID}
+	if event.OrganizationID != nil {
+		members, err := w.organizationRepository.FindOrganizationMembers(ctx, *event.OrganizationID)
+		if err != nil {
+			return nil, newerrors.Wrap(err, "failed to load organization members")
+		}
+		for _, member := range members {
+			candidates = append(candidates, member.SuperUserID)
+		}
+	}
+	grants, err := w.eventGrantRepository.FindEventGrantsByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, newerrors.Wrap(err, "failed to load event grants")
+	}
+	for _, grant := range grants {
+		candidates = append(candidates, grant.SuperUserID)
+	}
+
+	var webhooks []*types.WebhookType
+	seen := make(map[uuid.UUID]bool, len(candidates))
+	for _, userID := range candidates {
+		if seen[userID] {
+			continue
+		}
+		seen[userID] = true
+		if !hasEventAccess(ctx, w.organizationRepository, w.eventGrantRepository, userID, event, types.OrganizationRoleAdmin, types.EventGrantRoleCoOrganizer) {
+			continue
+		}
+		userWebhooks, err := w.repository.FindActiveWebhooksByEventType(ctx, userID, eventType)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, userWebhooks...)
+	}
+	return webhooks, nil
+}
+
+// attemptDelivery sends a due delivery once and stores the outcome on it. Deliveries that are not due, or that
+// another attempt already holds, are left alone.
+func (w *WebhookService) attemptDelivery(ctx context.Context, delivery *types.WebhookDeliveryType) error {
+	now := time.Now()
+	acquired, err := w.repository.AcquireWebhookDelivery(ctx, delivery.ID, now, now.Add(configs.WebhookTimeout+webhookLeaseMargin))
+	if err != nil {
+		return newerrors.Wrap(err, "failed to acquire webhook delivery")
+	}
+	if !acquired {
+		return nil
+	}
+
+	webhook, err := w.repository.FindWebhookByID(ctx, delivery.WebhookID)
+	if err != nil {
+		return newerrors.Wrap(err, "failed to load webhook")
+	}
+
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.ResponseStatus, delivery.ResponseBody, delivery.LastError = 0, "", ""
+	if webhook.IsActive {
+		w.send(ctx, webhook, delivery)
+	} else {
+		delivery.LastError = "webhook is disabled"
+	}
+
+	switch {
+	case delivery.LastError == "":
+		delivery.Status, delivery.NextAttemptAt = types.WebhookDeliveryStatusSucceeded, nil
+	case !webhook.IsActive || delivery.Attempts >= configs.WebhookMaxAttempts:
+		delivery.Status, delivery.NextAttemptAt = types.WebhookDeliveryStatusFailed, nil
+	default:
+		nextAttemptAt := time.Now().Add(webhookRetryDelay(delivery.Attempts))
+		delivery.Status, delivery.NextAttemptAt = types.WebhookDeliveryStatusPending, &nextAttemptAt
+	}
+
+	// Store the outcome even when the caller gave up meanwhile, so the delivery is not sent twice
+	if err := w.repository.UpdateWebhookDelivery(context.Background(), delivery); err != nil {
+		return newerrors.Wrap(err, "failed to store webhook delivery outcome")
+	}
+	return nil
+}
+
+// send posts the signed payload of a delivery to the webhook and records the response on the delivery.
+// Anything but a 2xx answer leaves LastError set.
+func (w *WebhookService) send(ctx context.Context, webhook *types.WebhookType, delivery *types.WebhookDeliveryType) {
+	payload := []byte(delivery.Payload)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		delivery.LastError = err.Error()
+		return
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", webhookUserAgent)
+	request.Header.Set(utils.WebhookEventHeader, delivery.EventType)
+	request.Header.Set(utils.WebhookDeliveryHeader, delivery.ID.String())
+	request.Header.Set(utils.WebhookSignatureHeader, utils.SignWebhookPayload(webhook.Secret, time.Now(), payload))
+
+	response, err := w.httpClient.Do(request)
+	if err != nil {
+		delivery.LastError = err.Error()
+		return
+	}
+	defer response.Body.Close()
+
+	delivery.ResponseStatus = response.StatusCode
+	if response.StatusCode >= 300 && response.StatusCode <= 399 {
+		// The body of a redirect is not the receiver's answer, so it is not kept
+		delivery.LastError = fmt.Sprintf("endpoint answered with redirect status %d, redirects are not followed", response.StatusCode)
+		return
+	}
+	body, _ := io.ReadAll(io.LimitReader(response.Body, utils.MaxWebhookResponseBody))
+	delivery.ResponseBody = string(body)
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		delivery.LastError = fmt.Sprintf("endpoint answered with status %d", response.StatusCode)
+	}
+}
+
+// errWebhookTargetNotAllowed is returned when a webhook URL resolves to an address of the server's own network
+var errWebhookTargetNotAllowed = errors.New("webhook target address is not allowed")
+
+// newWebhookHTTPClient returns the client deliveries are sent with. The address check runs on every connection,
+// after DNS resolution, so a host name that later resolves to an internal address is refused as well. Redirects are
+// not followed, and no proxy is used, since either would connect somewhere the check never saw.
+func newWebhookHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: configs.WebhookTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || (!utils.IsPublicWebhookIP(ip) && !configs.WebhookAllowPrivate) {
+				return errWebhookTargetNotAllowed
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   configs.WebhookTimeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// auditWebhook records an action on a webhook with the fields it changed since the before snapshot; webhook is
+// nil once the webhook is deleted
+func (w *WebhookService) auditWebhook(ctx context.Context, actorID uuid.UUID, action string, before map[string]json.RawMessage, webhook *types.WebhookType, webhookID uuid.UUID) {
+	changes := utils.AuditDiff(before, utils.AuditSnapshot(webhook))
+	recordAudit(ctx, w.auditService, actorID, action, types.AuditTargetWebhook, webhookID, changes)
+}
+
+// webhookRetryDelay returns the wait before the next attempt of a delivery that failed attempts times,
+// doubling from the configured backoff up to the configured maximum
+func webhookRetryDelay(attempts int) time.Duration {
+	delay := configs.WebhookRetryBackoff
+	for i := 1; i < attempts && delay < configs.WebhookMaxRetryBackoff; i++ {
+		delay *= 2
+	}
+	if configs.WebhookMaxRetryBackoff > 0 && delay > configs.WebhookMaxRetryBackoff {
+		delay = configs.WebhookMaxRetryBackoff
+	}
+	return delay
+}
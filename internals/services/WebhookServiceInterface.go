@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// WebhookServiceInterface defines the methods for managing webhooks and delivering domain events to them
+type WebhookServiceInterface interface {
+	// CreateWebhookService registers a webhook of an organizer with a newly generated signing secret
+	CreateWebhookService(ctx context.Context, organizerID uuid.UUID, webhookDTO *utils.WebhookDTO) (*types.WebhookType, error)
+
+	// FindWebhooksService retrieves every webhook of an organizer
+	FindWebhooksService(ctx context.Context, organizerID uuid.UUID) ([]*types.WebhookType, error)
+
+	// FindWebhookService retrieves one webhook of an organizer
+	FindWebhookService(ctx context.Context, organizerID, webhookID uuid.UUID) (*types.WebhookType, error)
+
+	// UpdateWebhookService changes the URL, event types, description or active flag of a webhook
+	UpdateWebhookService(ctx context.Context, organizerID, webhookID uuid.UUID, updateDTO *utils.UpdateWebhookDTO) (*types.WebhookType, error)
+
+	// DeleteWebhookService removes a webhook together with its delivery log
+	DeleteWebhookService(ctx context.Context, organizerID, webhookID uuid.UUID) error
+
+	// FindWebhookDeliveriesService retrieves the latest deliveries of a webhook, newest first
+	FindWebhookDeliveriesService(ctx context.Context, organizerID, webhookID uuid.UUID, limit int) ([]*types.WebhookDeliveryType, error)
+
+	// RedeliverWebhookService sends the payload of an earlier delivery again as a new delivery and returns it
+	// after its first attempt. A failed redelivery is retried like any other delivery.
+	RedeliverWebhookService(ctx context.Context, organizerID, webhookID, deliveryID uuid.UUID) (*types.WebhookDeliveryType, error)
+
+	// DispatchDomainEvent queues the domain event and returns at once; in the background a delivery is recorded for
+	// every active webhook of the event's organizers that subscribes to it, then attempted. It is meant to be
+	// subscribed to the event bus.
+	DispatchDomainEvent(event *types.DomainEventType)
+
+	// DeliverDueWebhooksService attempts the pending deliveries whose retry is due and returns how many succeeded
+	DeliverDueWebhooksService(ctx context.Context) (int, error)
+}
@@ -0,0 +1,209 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kinds of target an audit record can be about
+const (
+	AuditTargetSuperUser        = "superuser"
+	AuditTargetEvent            = "event"
+	AuditTargetVenue            = "venue"
+	AuditTargetGuest            = "guest"
+	AuditTargetTicket           = "ticket"
+	AuditTargetTicketTier       = "ticket_tier"
+	AuditTargetOrder            = "order"
+	AuditTargetPromoCode        = "promo_code"
+	AuditTargetRegistrationForm = "registration_form"
+	AuditTargetWebhook          = "webhook"
+	AuditTargetOrganization     = "organization"
+)
+
+// Actions recorded in the audit log, named "<target>.<what happened>"
+const (
+	AuditActionSuperUserRegistered           = "superuser.registered"
+	AuditActionSuperUserVerified             = "superuser.verified"
+	AuditActionSuperUserPasswordReset        = "superuser.password_reset"
+	AuditActionSuperUserActivated            = "superuser.activated"
+	AuditActionSuperUserDeactivated          = "superuser.deactivated"
+	AuditActionSuperUserRoleChanged          = "superuser.role_changed"
+	AuditActionSuperUserPasswordResetForced  = "superuser.password_reset_forced"
+	AuditActionSuperUserVerificationResent   = "superuser.verification_resent"
+	AuditActionSuperUserDeleted              = "superuser.deleted"
+	AuditActionSuperUserPasswordChanged      = "superuser.password_changed"
+	AuditActionSuperUserProfileUpdated       = "superuser.profile_updated"
+	AuditActionSuperUserEmailChangeRequested = "superuser.email_change_requested"
+	AuditActionSuperUserEmailChanged         = "superuser.email_changed"
+	AuditActionSuperUserEmailChangeUndone    = "superuser.email_change_undone"
+
+	AuditActionEventCreated       = "event.created"
+	AuditActionEventStatusChanged = "event.status_changed"
+	AuditActionEventSlugChanged   = "event.slug_changed"
+	AuditActionEventRescheduled   = "event.rescheduled"
+	AuditActionEventCompleted     = "event.completed"
+	AuditActionEventAccessGranted = "event.access_granted"
+	AuditActionEventAccessChanged = "event.access_changed"
+	AuditActionEventAccessRevoked = "event.access_revoked"
+	AuditActionEventTransferred   = "event.transferred"
+
+	AuditActionVenueCreated = "venue.created"
+
+	AuditActionGuestRSVPUpdated           = "guest.rsvp_updated"
+	AuditActionGuestsImported             = "guest.imported"
+	AuditActionGuestRegistered            = "guest.registered"
+	AuditActionGuestRegistrationConfirmed = "guest.registration_confirmed"
+
+	AuditActionTicketRevoked   = "ticket.revoked"
+	AuditActionTicketCheckedIn = "ticket.checked_in"
+
+	AuditActionTicketTierCreated = "ticket_tier.created"
+	AuditActionTicketTierUpdated = "ticket_tier.updated"
+	AuditActionTicketTierDeleted = "ticket_tier.deleted"
+
+	AuditActionOrderCreated   = "order.created"
+	AuditActionOrderConfirmed = "order.confirmed"
+	AuditActionOrderCancelled = "order.cancelled"
+	AuditActionOrderRefunded  = "order.refunded"
+	AuditActionOrderExpired   = "order.expired"
+
+	AuditActionPromoCodeCreated = "promo_code.created"
+	AuditActionPromoCodeUpdated = "promo_code.updated"
+	AuditActionPromoCodeDeleted = "promo_code.deleted"
+
+	AuditActionRegistrationFormSaved   = "registration_form.saved"
+	AuditActionRegistrationFormDeleted = "registration_form.deleted"
+
+	AuditActionWebhookCreated     = "webhook.created"
+	AuditActionWebhookUpdated     = "webhook.updated"
+	AuditActionWebhookDeleted     = "webhook.deleted"
+	AuditActionWebhookRedelivered = "webhook.redelivered"
+
+	AuditActionOrganizationCreated            = "organization.created"
+	AuditActionOrganizationMemberRoleChanged  = "organization.member_role_changed"
+	AuditActionOrganizationMemberRemoved      = "organization.member_removed"
+	AuditActionOrganizationMemberInvited      = "organization.member_invited"
+	AuditActionOrganizationInvitationRevoked  = "organization.invitation_revoked"
+	AuditActionOrganizationInvitationAccepted = "organization.invitation_accepted"
+)
+
+// AuditChangeType is the JSON value of one field before and after an audited action; From is null for
+// fields of a created target and To is null for fields of a deleted one
+type AuditChangeType struct {
+	From json.RawMessage `bson:"from" json:"from"`
+	To   json.RawMessage `bson:"to" json:"to"`
+}
+
+// NewAuditChange records that a field changed from one value to another
+func NewAuditChange(from, to interface{}) *AuditChangeType {
+	return &AuditChangeType{From: auditJSON(from), To: auditJSON(to)}
+}
+
+// AuditRecordType records who did what to which target, and from which request. Records form a hash chain:
+// each one holds the hash of the record before it, so changing or removing a stored record breaks the chain
+// from that record on.
+type AuditRecordType struct {
+	ID         uuid.UUID                   `bson:"_id" json:"id" gorm:"type:uuid;primaryKey"`
+	Sequence   int64                       `bson:"sequence" json:"sequence" gorm:"not null;uniqueIndex"`     // Position in the chain, starting at 1
+	ActorID    uuid.UUID                   `bson:"actor_id" json:"actor_id" gorm:"type:uuid;not null;index"` // uuid.Nil for the system and anonymous visitors
+	Action     string                      `bson:"action" json:"action" gorm:"not null;index"`
+	TargetType string                      `bson:"target_type" json:"target_type" gorm:"not null;index:idx_audit_target"`
+	TargetID   uuid.UUID                   `bson:"target_id" json:"target_id" gorm:"type:uuid;not null;index:idx_audit_target"`
+	Changes    map[string]*AuditChangeType `bson:"changes,omitempty" json:"changes,omitempty" gorm:"serializer:json;type:jsonb"`
+	RequestID  string                      `bson:"request_id,omitempty" json:"request_id,omitempty"`
+	ClientIP   string                      `bson:"client_ip,omitempty" json:"client_ip,omitempty"`
+	CreatedAt  time.Time                   `bson:"created_at" json:"created_at" gorm:"not null;index"`
+	PrevHash   string                      `bson:"prev_hash" json:"prev_hash"` // Hash of the record before; empty for the first record
+	Hash       string                      `bson:"hash" json:"hash" gorm:"not null"`
+}
+
+// NewAuditRecord creates an audit record of an action taken now
+func NewAuditRecord(actorID uuid.UUID, action, targetType string, targetID uuid.UUID, changes map[string]*AuditChangeType) *AuditRecordType {
+	return &AuditRecordType{
+		ID:         uuid.New(),
+		ActorID:    actorID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Changes:    changes,
+		// Every backend keeps at least milliseconds, so the stored time hashes the same as the appended one
+		CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+	}
+}
+
+// ChainTo makes the record the successor of previous, or the first record of the chain when previous is nil,
+// and seals it with its hash
+func (r *AuditRecordType) ChainTo(previous *AuditRecordType) {
+	r.Sequence = 1
+	r.PrevHash = ""
+	if previous != nil {
+		r.Sequence = previous.Sequence + 1
+		r.PrevHash = previous.Hash
+	}
+	r.Hash = r.ComputeHash()
+}
+
+// ComputeHash returns the SHA-256 hash of every field of the record but Hash, hex-encoded. Values are hashed in
+// a canonical form, so a record read back from any backend hashes the same as when it was appended.
+func (r *AuditRecordType) ComputeHash() string {
+	changes := make(map[string][2]interface{}, len(r.Changes))
+	for field, change := range r.Changes {
+		if change == nil {
+			change = &AuditChangeType{}
+		}
+		changes[field] = [2]interface{}{canonicalAuditValue(change.From), canonicalAuditValue(change.To)}
+	}
+	payload, _ := json.Marshal(struct {
+		Sequence   int64                     `json:"sequence"`
+		PrevHash   string                    `json:"prev_hash"`
+		ID         string                    `json:"id"`
+		ActorID    string                    `json:"actor_id"`
+		Action     string                    `json:"action"`
+		TargetType string                    `json:"target_type"`
+		TargetID   string                    `json:"target_id"`
+		Changes    map[string][2]interface{} `json:"changes"`
+		RequestID  string                    `json:"request_id"`
+		ClientIP   string                    `json:"client_ip"`
+		CreatedAt  string                    `json:"created_at"`
+	}{
+		Sequence:   r.Sequence,
+		PrevHash:   r.PrevHash,
+		ID:         r.ID.String(),
+		ActorID:    r.ActorID.String(),
+		Action:     r.Action,
+		TargetType: r.TargetType,
+		TargetID:   r.TargetID.String(),
+		Changes:    changes,
+		RequestID:  r.RequestID,
+		ClientIP:   r.ClientIP,
+		CreatedAt:  r.CreatedAt.UTC().Truncate(time.Millisecond).Format(time.RFC3339Nano),
+	})
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:])
+}
+
+// auditJSON encodes a field value for an audit change
+func auditJSON(value interface{}) json.RawMessage {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return json.RawMessage("null")
+	}
+	return encoded
+}
+
+// canonicalAuditValue decodes a JSON value so it encodes again with sorted keys and no whitespace, whatever
+// formatting the backend stored it with
+func canonicalAuditValue(raw json.RawMessage) interface{} {
+	if len(raw) == 0 {
+		return nil
+	}
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return string(raw)
+	}
+	return value
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kinds of domain events published by the services
+const (
+	DomainEventEventCreated     = "event.created"
+	DomainEventEventUpdated     = "event.updated"
+	DomainEventEventCancelled   = "event.cancelled"
+	DomainEventGuestRSVPChanged = "guest.rsvp_changed"
+	DomainEventGuestCheckedIn   = "guest.checked_in"
+	DomainEventGuestsImported   = "guest.imported"
+)
+
+// DomainEventType describes something that happened to an event or its guests, for in-process subscribers
+// such as live dashboards and webhooks. EventID is the event it concerns.
+type DomainEventType struct {
+	ID         uuid.UUID              `json:"id"`
+	Type       string                 `json:"type"`
+	EventID    uuid.UUID              `json:"event_id"`
+	OccurredAt time.Time              `json:"occurred_at"`
+	Data       map[string]interface{} `json:"data,omitempty"`
+}
+
+// NewDomainEvent creates a new instance of DomainEventType that happened now
+func NewDomainEvent(eventType string, eventID uuid.UUID, data map[string]interface{}) *DomainEventType {
+	return &DomainEventType{
+		ID:         uuid.New(),
+		Type:       eventType,
+		EventID:    eventID,
+		OccurredAt: time.Now(),
+		Data:       data,
+	}
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Access an organizer can delegate on a single event, from most to least privileged
+const (
+	EventGrantRoleCoOrganizer = "CoOrganizer" // Everything the organizer may do, including granting editor and check-in access
+	EventGrantRoleEditor      = "Editor"      // Changes the event and manages its guest list
+	EventGrantRoleCheckIn     = "CheckIn"     // Checks guests in and follows attendance
+)
+
+// eventGrantRoleRanks orders the event grant roles; a higher rank includes everything a lower one may do
+var eventGrantRoleRanks = map[string]int{
+	EventGrantRoleCheckIn:     1,
+	EventGrantRoleEditor:      2,
+	EventGrantRoleCoOrganizer: 3,
+}
+
+// IsEventGrantRole reports whether role is one of the known event grant roles
+func IsEventGrantRole(role string) bool {
+	_, ok := eventGrantRoleRanks[role]
+	return ok
+}
+
+// EventGrantRoleAtLeast reports whether role grants at least what minimum does
+func EventGrantRoleAtLeast(role, minimum string) bool {
+	rank, ok := eventGrantRoleRanks[role]
+	return ok && rank >= eventGrantRoleRanks[minimum]
+}
+
+// EventGrantType gives a user other than the organizer access to one event; a user holds at most one grant per event
+type EventGrantType struct {
+	ID          uuid.UUID `bson:"_id,omitempty" json:"id,omitempty" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	EventID     uuid.UUID `bson:"event_id" json:"event_id" gorm:"type:uuid;not null;uniqueIndex:idx_event_grant"`
+	SuperUserID uuid.UUID `bson:"super_user_id" json:"super_user_id" gorm:"type:uuid;not null;uniqueIndex:idx_event_grant;index"`
+	Role        string    `bson:"role" json:"role" gorm:"not null"`
+	GrantedByID uuid.UUID `bson:"granted_by_id" json:"granted_by_id" gorm:"type:uuid;not null"`
+	CreatedAt   time.Time `bson:"created_at" json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `bson:"updated_at" json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// NewEventGrant creates a new instance of EventGrantType
+func NewEventGrant(eventID, superUserID uuid.UUID, role string, grantedByID uuid.UUID) *EventGrantType {
+	return &EventGrantType{
+		ID:          uuid.New(),
+		EventID:     eventID,
+		SuperUserID: superUserID,
+		Role:        role,
+		GrantedByID: grantedByID,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
@@ -6,35 +6,129 @@ import (
 	"github.com/google/uuid"
 )
 
-// EventType defines the structure for an event
+// Supported recurrence frequencies, mirroring the iCalendar RRULE FREQ values
+const (
+	RecurrenceDaily   = "DAILY"
+	RecurrenceWeekly  = "WEEKLY"
+	RecurrenceMonthly = "MONTHLY"
+	RecurrenceYearly  = "YEARLY"
+)
+
+// Lifecycle states of an event
+const (
+	EventStatusDraft     = "Draft"     // Being prepared by the organizer, hidden from everyone else
+	EventStatusPublished = "Published" // Scheduled and visible in public listings
+	EventStatusPostponed = "Postponed" // On hold until a new date is set
+	EventStatusCancelled = "Cancelled" // Called off, with a reason; final
+	EventStatusCompleted = "Completed" // Took place; final
+)
+
+// eventStatusTransitions lists the states each state may move to
+var eventStatusTransitions = map[string][]string{
+	EventStatusDraft:     {EventStatusPublished, EventStatusCancelled},
+	EventStatusPublished: {EventStatusPostponed, EventStatusCancelled, EventStatusCompleted},
+	EventStatusPostponed: {EventStatusPublished, EventStatusCancelled},
+	EventStatusCancelled: {},
+	EventStatusCompleted: {},
+}
+
+// IsEventStatus reports whether status is one of the known lifecycle states
+func IsEventStatus(status string) bool {
+	_, ok := eventStatusTransitions[status]
+	return ok
+}
+
+// CanTransitionEventStatus reports whether an event may move from one lifecycle state to another
+func CanTransitionEventStatus(from, to string) bool {
+	for _, allowed := range eventStatusTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// EventStatusChangeType records a single lifecycle transition and who made it
+type EventStatusChangeType struct {
+	From        string    `bson:"from" json:"from"`
+	To          string    `bson:"to" json:"to"`
+	Reason      string    `bson:"reason,omitempty" json:"reason,omitempty"`
+	ChangedByID uuid.UUID `bson:"changed_by_id" json:"changed_by_id"`
+	ChangedAt   time.Time `bson:"changed_at" json:"changed_at"`
+}
+
+// NewEventStatusChange creates a new instance of EventStatusChangeType stamped with the current time
+func NewEventStatusChange(from, to, reason string, changedByID uuid.UUID) *EventStatusChangeType {
+	return &EventStatusChangeType{
+		From:        from,
+		To:          to,
+		Reason:      reason,
+		ChangedByID: changedByID,
+		ChangedAt:   time.Now().UTC(),
+	}
+}
+
+// EventRecurrenceType describes how an event repeats; occurrences keep the wall-clock time of the first one in the event's timezone
+type EventRecurrenceType struct {
+	Frequency string     `bson:"frequency" json:"frequency"`
+	Interval  int        `bson:"interval" json:"interval"`
+	Count     int        `bson:"count,omitempty" json:"count,omitempty"`
+	Until     *time.Time `bson:"until,omitempty" json:"until,omitempty"`
+}
+
+// EventType defines the structure for an event; StartTime and EndTime are always stored in UTC
 type EventType struct {
-	ID          uuid.UUID   `bson:"_id,omitempty" json:"id,omitempty" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
-	Title       string      `bson:"title" json:"title" validate:"required,min=3,max=100" gorm:"not null"`
-	Description string      `bson:"description" json:"description" gorm:"type:text"`
-	StartTime   time.Time   `bson:"start_time" json:"start_time" gorm:"not null"`
-	EndTime     time.Time   `bson:"end_time" json:"end_time" gorm:"not null"`
-	Location    string      `bson:"location" json:"location" gorm:"not null"`
-	OrganizerID uuid.UUID   `bson:"organizer_id" json:"organizer_id" gorm:"type:uuid;not null"`
-	Guests      []GuestType `bson:"guests" json:"guests" gorm:"foreignKey:EventID"`
-	CreatedAt   time.Time   `bson:"created_at" json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt   time.Time   `bson:"updated_at" json:"updated_at" gorm:"autoUpdateTime"`
-	IsActive    bool        `bson:"is_active" json:"is_active" gorm:"default:true"`
-	Tags        []string    `bson:"tags" json:"tags" gorm:"type:text[]"`
-}
-
-// NewEvent creates a new instance of EventType
-func NewEvent(title, description, location string, startTime, endTime time.Time, organizerID uuid.UUID, tags []string) *EventType {
+	ID                 uuid.UUID               `bson:"_id,omitempty" json:"id,omitempty" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	Title              string                  `bson:"title" json:"title" validate:"required,min=3,max=100" gorm:"not null"`
+	Description        string                  `bson:"description" json:"description" gorm:"type:text"`
+	StartTime          time.Time               `bson:"start_time" json:"start_time" gorm:"not null"`
+	EndTime            time.Time               `bson:"end_time" json:"end_time" gorm:"not null"`
+	Timezone           string                  `bson:"timezone" json:"timezone" validate:"required,timezone" gorm:"not null;default:'UTC'"`
+	Recurrence         *EventRecurrenceType    `bson:"recurrence,omitempty" json:"recurrence,omitempty" gorm:"serializer:json;type:jsonb"`
+	Location           string                  `bson:"location" json:"location" gorm:"not null"`
+	VenueID            *uuid.UUID              `bson:"venue_id,omitempty" json:"venue_id,omitempty" gorm:"type:uuid;index"`
+	OrganizerID        uuid.UUID               `bson:"organizer_id" json:"organizer_id" gorm:"type:uuid;not null"`
+	OrganizationID     *uuid.UUID              `bson:"organization_id,omitempty" json:"organization_id,omitempty" gorm:"type:uuid;index"` // Tenant owning the event; nil for personal events of the organizer
+	Guests             []GuestType             `bson:"guests" json:"guests" gorm:"foreignKey:EventID"`
+	CreatedAt          time.Time               `bson:"created_at" json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt          time.Time               `bson:"updated_at" json:"updated_at" gorm:"autoUpdateTime"`
+	IsActive           bool                    `bson:"is_active" json:"is_active" gorm:"default:true"`
+	Tags               []string                `bson:"tags" json:"tags" gorm:"type:text[]"`
+	Status             string                  `bson:"status" json:"status" gorm:"not null;default:'Published';index"`
+	CancellationReason string                  `bson:"cancellation_reason,omitempty" json:"cancellation_reason,omitempty" gorm:"type:text"`
+	StatusHistory      []EventStatusChangeType `bson:"status_history" json:"status_history" gorm:"serializer:json;type:jsonb"`
+	Sequence           int                     `bson:"sequence" json:"sequence" gorm:"not null;default:0"` // iCalendar SEQUENCE, bumped whenever sent invitations change
+	Slug               string                  `bson:"slug,omitempty" json:"slug,omitempty" gorm:"index"`  // Shareable name of the public event page, e.g. "summer-party-x7k2m9"
+}
+
+// CurrentStatus returns the lifecycle state of the event; events stored before lifecycle states existed count as published
+func (e *EventType) CurrentStatus() string {
+	if e.Status == "" {
+		return EventStatusPublished
+	}
+	return e.Status
+}
+
+// NewEvent creates a new draft instance of EventType, normalizing the start and end times to UTC
+func NewEvent(title, description, location, timezone string, venueID *uuid.UUID, startTime, endTime time.Time, organizerID uuid.UUID, tags []string, recurrence *EventRecurrenceType) *EventType {
 	return &EventType{
 		ID:          uuid.New(),
 		Title:       title,
 		Description: description,
-		StartTime:   startTime,
-		EndTime:     endTime,
+		StartTime:   startTime.UTC(),
+		EndTime:     endTime.UTC(),
+		Timezone:    timezone,
+		Recurrence:  recurrence,
 		Location:    location,
+		VenueID:     venueID,
 		OrganizerID: organizerID,
 		Tags:        tags,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 		IsActive:    true,
+		Status:      EventStatusDraft,
+		StatusHistory: []EventStatusChangeType{
+			*NewEventStatusChange("", EventStatusDraft, "", organizerID),
+		},
 	}
 }
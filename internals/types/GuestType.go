@@ -6,12 +6,61 @@ import (
 	"github.com/google/uuid"
 )
 
+// RSVP answers a guest can give
+const (
+	RSVPStatusPending   = "Pending"
+	RSVPStatusAccepted  = "Accepted"
+	RSVPStatusTentative = "Tentative"
+	RSVPStatusDeclined  = "Declined"
+	// RSVPStatusWaitlisted is set instead of Accepted while the venue is full
+	RSVPStatusWaitlisted = "Waitlisted"
+)
+
+// IsRSVPStatus reports whether status is one of the known RSVP answers
+func IsRSVPStatus(status string) bool {
+	switch status {
+	case RSVPStatusPending, RSVPStatusAccepted, RSVPStatusTentative, RSVPStatusDeclined, RSVPStatusWaitlisted:
+		return true
+	}
+	return false
+}
+
 // GuestType extends BaseUserType for guests
 type GuestType struct {
 	BaseUserType
 	RSVPStatus string    `bson:"rsvp_status" json:"rsvp_status" gorm:"type:text"`
 	EventID    uuid.UUID `bson:"event_id" json:"event_id" gorm:"not null"`
 	InvitedAt  time.Time `bson:"invited_at" json:"invited_at" gorm:"autoCreateTime"`
+	// OrganizationID is the tenant of the guest's event, copied onto the guest so guest queries can be scoped without it
+	OrganizationID *uuid.UUID `bson:"organization_id,omitempty" json:"organization_id,omitempty" gorm:"type:uuid;index"`
+	// CheckedInAt is set when the guest arrives at the event
+	CheckedInAt *time.Time `bson:"checked_in_at,omitempty" json:"checked_in_at,omitempty"`
+	// CheckedInBy is the staff user who scanned the guest's ticket
+	CheckedInBy *uuid.UUID `bson:"checked_in_by,omitempty" json:"checked_in_by,omitempty" gorm:"type:uuid"`
+	// CustomFields holds extra guest list columns such as company or dietary needs, keyed by column header
+	CustomFields map[string]string `bson:"custom_fields,omitempty" json:"custom_fields,omitempty" gorm:"serializer:json;type:jsonb"`
+	// RegistrationAnswers holds the answers to the event's registration form, keyed by field key.
+	// Every answer is a list so multi-select fields fit; other fields have a single value.
+	RegistrationAnswers map[string][]string `bson:"registration_answers,omitempty" json:"registration_answers,omitempty" gorm:"serializer:json;type:jsonb"`
+	// PendingRegistration is set while a self-registration waits for the guest to confirm their email address
+	PendingRegistration *GuestRegistrationType `bson:"pending_registration" json:"pending_registration,omitempty" gorm:"serializer:json;type:jsonb"`
+}
+
+// GuestRegistrationType is a self-registration from the public event page that has not been confirmed yet. Its name
+// and answers only replace those of the guest once the confirmation link is opened, so nobody can change another
+// person's registration just by entering their email address. Only a hash of the emailed token is stored.
+type GuestRegistrationType struct {
+	TokenHash   string              `bson:"token_hash" json:"-"`
+	FullName    string              `bson:"full_name" json:"full_name"`
+	Answers     map[string][]string `bson:"answers,omitempty" json:"answers,omitempty"`
+	RequestedAt time.Time           `bson:"requested_at" json:"requested_at"`
+	ExpiresAt   time.Time           `bson:"expires_at" json:"expires_at"`
+}
+
+// AttachToEvent puts the guest on an event's guest list, in the event's tenant
+func (g *GuestType) AttachToEvent(event *EventType) {
+	g.EventID = event.ID
+	g.OrganizationID = event.OrganizationID
 }
 
 // NewGuest creates a new Guest instance
@@ -25,6 +74,6 @@ func NewGuest(email, fullName string) *GuestType {
 			UpdatedAt: time.Now(),
 			IsActive:  true,
 		},
-		RSVPStatus: "Pending",
+		RSVPStatus: RSVPStatusPending,
 	}
 }
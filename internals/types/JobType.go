@@ -0,0 +1,109 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kinds of background jobs; each kind has one handler registered with the job scheduler
+const (
+	JobKindSendReminders           = "reminders.send"
+	JobKindPurgeExpiredOTPs        = "superusers.purge_expired_otps"
+	JobKindPurgeExpiredResetTokens = "superusers.purge_expired_reset_tokens"
+	JobKindPurgeLoginChallenges    = "superusers.purge_expired_login_challenges"
+	JobKindCompleteEndedEvents     = "events.complete_ended"
+	JobKindDeliverWebhooks         = "webhooks.deliver_due"
+	JobKindExpireOrderHolds        = "orders.expire_holds"
+)
+
+// Outcomes of a single job run
+const (
+	JobRunStatusRunning   = "Running"
+	JobRunStatusSucceeded = "Succeeded"
+	JobRunStatusFailed    = "Failed"
+)
+
+// JobType is a persisted background job. Cron jobs carry a Schedule and run forever; one-off jobs have no Schedule
+// and are deactivated once they succeed or run out of attempts. LockedBy and LockedUntil form a lease that lets
+// exactly one server instance run a due job at a time.
+type JobType struct {
+	ID          uuid.UUID         `bson:"_id,omitempty" json:"id,omitempty" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	Name        string            `bson:"name" json:"name" gorm:"unique;not null"`      // Unique name, e.g. the kind for built-in cron jobs
+	Kind        string            `bson:"kind" json:"kind" gorm:"not null"`             // Selects the handler that runs the job
+	Schedule    string            `bson:"schedule,omitempty" json:"schedule,omitempty"` // Cron expression or descriptor such as "@every 1m"
+	Payload     map[string]string `bson:"payload,omitempty" json:"payload,omitempty" gorm:"serializer:json;type:jsonb"`
+	NextRunAt   time.Time         `bson:"next_run_at" json:"next_run_at" gorm:"not null;index"`
+	Attempts    int               `bson:"attempts" json:"attempts" gorm:"default:0"`         // Consecutive failed attempts of the current run
+	MaxAttempts int               `bson:"max_attempts" json:"max_attempts" gorm:"default:1"` // Attempts before a run is given up
+	IsActive    bool              `bson:"is_active" json:"is_active" gorm:"default:true"`
+	LockedBy    string            `bson:"locked_by,omitempty" json:"locked_by,omitempty"`
+	LockedUntil *time.Time        `bson:"locked_until,omitempty" json:"locked_until,omitempty"`
+	LastRunAt   *time.Time        `bson:"last_run_at,omitempty" json:"last_run_at,omitempty"`
+	LastError   string            `bson:"last_error,omitempty" json:"last_error,omitempty"`
+	CreatedAt   time.Time         `bson:"created_at" json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time         `bson:"updated_at" json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// JobRunType records one attempt at running a job
+type JobRunType struct {
+	ID         uuid.UUID  `bson:"_id,omitempty" json:"id,omitempty" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	JobID      uuid.UUID  `bson:"job_id" json:"job_id" gorm:"type:uuid;not null;index"`
+	JobName    string     `bson:"job_name" json:"job_name" gorm:"not null;index"`
+	Kind       string     `bson:"kind" json:"kind" gorm:"not null"`
+	Attempt    int        `bson:"attempt" json:"attempt"`
+	Status     string     `bson:"status" json:"status" gorm:"not null"`
+	Error      string     `bson:"error,omitempty" json:"error,omitempty"`
+	InstanceID string     `bson:"instance_id" json:"instance_id"` // Server instance that ran the attempt
+	StartedAt  time.Time  `bson:"started_at" json:"started_at" gorm:"not null;index"`
+	FinishedAt *time.Time `bson:"finished_at,omitempty" json:"finished_at,omitempty"`
+}
+
+// NewCronJob creates a new instance of JobType that runs on a cron schedule, first at nextRunAt
+func NewCronJob(name, kind, schedule string, nextRunAt time.Time, maxAttempts int) *JobType {
+	job := newJob(name, kind, nextRunAt, maxAttempts)
+	job.Schedule = schedule
+	return job
+}
+
+// NewOneOffJob creates a new instance of JobType that runs once at runAt
+func NewOneOffJob(name, kind string, runAt time.Time, payload map[string]string, maxAttempts int) *JobType {
+	job := newJob(name, kind, runAt, maxAttempts)
+	job.Payload = payload
+	return job
+}
+
+func newJob(name, kind string, nextRunAt time.Time, maxAttempts int) *JobType {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	return &JobType{
+		ID:          uuid.New(),
+		Name:        name,
+		Kind:        kind,
+		NextRunAt:   nextRunAt.UTC(),
+		MaxAttempts: maxAttempts,
+		IsActive:    true,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// IsRecurring reports whether the job runs on a cron schedule rather than once
+func (j *JobType) IsRecurring() bool {
+	return j.Schedule != ""
+}
+
+// NewJobRun creates a new running instance of JobRunType for the next attempt at the job
+func NewJobRun(job *JobType, instanceID string) *JobRunType {
+	return &JobRunType{
+		ID:         uuid.New(),
+		JobID:      job.ID,
+		JobName:    job.Name,
+		Kind:       job.Kind,
+		Attempt:    job.Attempts + 1,
+		Status:     JobRunStatusRunning,
+		InstanceID: instanceID,
+		StartedAt:  time.Now().UTC(),
+	}
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LoginChallengeType is a passwordless login in progress: the superuser was emailed a link and a 6-digit code,
+// either of which logs them in once, from the browser holding the nonce cookie. Only hashes of the link token,
+// the code and the nonce are stored.
+type LoginChallengeType struct {
+	ID          uuid.UUID  `bson:"_id,omitempty" json:"id,omitempty" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	SuperUserID uuid.UUID  `bson:"super_user_id" json:"super_user_id" gorm:"type:uuid;not null;index"`
+	TokenHash   string     `bson:"token_hash" json:"-" gorm:"not null;uniqueIndex"`
+	CodeHash    string     `bson:"code_hash" json:"-" gorm:"not null"`
+	NonceHash   string     `bson:"nonce_hash" json:"-" gorm:"not null;uniqueIndex"`
+	Attempts    int        `bson:"attempts" json:"attempts" gorm:"not null;default:0"` // Wrong codes entered so far
+	ExpiresAt   time.Time  `bson:"expires_at" json:"expires_at" gorm:"not null"`
+	UsedAt      *time.Time `bson:"used_at,omitempty" json:"used_at,omitempty"`
+	CreatedAt   time.Time  `bson:"created_at" json:"created_at" gorm:"autoCreateTime;index"`
+}
+
+// NewLoginChallenge creates a new unused instance of LoginChallengeType
+func NewLoginChallenge(superUserID uuid.UUID, tokenHash, codeHash, nonceHash string, expiresAt time.Time) *LoginChallengeType {
+	return &LoginChallengeType{
+		ID:          uuid.New(),
+		SuperUserID: superUserID,
+		TokenHash:   tokenHash,
+		CodeHash:    codeHash,
+		NonceHash:   nonceHash,
+		ExpiresAt:   expiresAt,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// IsUsable reports whether the challenge can still log someone in at the given time
+func (l *LoginChallengeType) IsUsable(now time.Time, maxAttempts int) bool {
+	return l.UsedAt == nil && now.Before(l.ExpiresAt) && l.Attempts < maxAttempts
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Lifecycle states of an order
+const (
+	OrderStatusPending   = "Pending"   // Tickets are held while the buyer pays
+	OrderStatusConfirmed = "Confirmed" // Paid; every attendee is a guest with a ticket
+	OrderStatusCancelled = "Cancelled" // Abandoned by the buyer or rejected by the payment provider before payment
+	OrderStatusExpired   = "Expired"   // Not paid before the hold ran out
+	OrderStatusRefunded  = "Refunded"  // Refunded by the organizer; the tickets went back on sale
+)
+
+// OrderAttendeeType is a person an order buys a ticket for. GuestID is set once the order is confirmed.
+type OrderAttendeeType struct {
+	FullName string     `bson:"full_name" json:"full_name"`
+	Email    string     `bson:"email" json:"email"`
+	GuestID  *uuid.UUID `bson:"guest_id,omitempty" json:"guest_id,omitempty"`
+}
+
+// OrderType is a purchase of tickets of one tier, one per attendee. Prices are copied from the tier when the
+// order is placed, so later price changes do not affect it.
+type OrderType struct {
+	ID              uuid.UUID           `bson:"_id,omitempty" json:"id,omitempty" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	EventID         uuid.UUID           `bson:"event_id" json:"event_id" gorm:"type:uuid;not null;index"`
+	TicketTierID    uuid.UUID           `bson:"ticket_tier_id" json:"ticket_tier_id" gorm:"type:uuid;not null;index"`
+	BuyerID         uuid.UUID           `bson:"buyer_id" json:"buyer_id" gorm:"type:uuid;not null;index"`
+	Attendees       []OrderAttendeeType `bson:"attendees" json:"attendees" gorm:"serializer:json;type:jsonb;not null"`
+	Quantity        int                 `bson:"quantity" json:"quantity" gorm:"not null"`
+	UnitPriceCents  int64               `bson:"unit_price_cents" json:"unit_price_cents" gorm:"not null"`
+	DiscountCents   int64               `bson:"discount_cents,omitempty" json:"discount_cents,omitempty" gorm:"not null;default:0"`
+	TotalCents      int64               `bson:"total_cents" json:"total_cents" gorm:"not null"` // After the discount
+	Currency        string              `bson:"currency" json:"currency" gorm:"type:char(3);not null"`
+	PromoCodeID     *uuid.UUID          `bson:"promo_code_id,omitempty" json:"promo_code_id,omitempty" gorm:"type:uuid;index"`
+	PromoCode       string              `bson:"promo_code,omitempty" json:"promo_code,omitempty"`
+	Status          string              `bson:"status" json:"status" gorm:"not null;index"`
+	PaymentProvider string              `bson:"payment_provider,omitempty" json:"payment_provider,omitempty"` // Empty for free orders
+	PaymentID       string              `bson:"payment_id,omitempty" json:"payment_id,omitempty"`
+	CheckoutURL     string              `bson:"checkout_url,omitempty" json:"checkout_url,omitempty"` // Where the buyer completes the payment
+	ExpiresAt       time.Time           `bson:"expires_at" json:"expires_at" gorm:"not null;index"`   // End of the hold of a pending order
+	ConfirmedAt     *time.Time          `bson:"confirmed_at,omitempty" json:"confirmed_at,omitempty"`
+	ClosedAt        *time.Time          `bson:"closed_at,omitempty" json:"closed_at,omitempty"` // When the order was cancelled, expired or refunded
+	CloseReason     string              `bson:"close_reason,omitempty" json:"close_reason,omitempty" gorm:"type:text"`
+	CreatedAt       time.Time           `bson:"created_at" json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt       time.Time           `bson:"updated_at" json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// NewOrder creates a new pending instance of OrderType for the attendees, holding its tickets until expiresAt
+func NewOrder(tier *TicketTierType, buyerID uuid.UUID, attendees []OrderAttendeeType, expiresAt time.Time) *OrderType {
+	return &OrderType{
+		ID:             uuid.New(),
+		EventID:        tier.EventID,
+		TicketTierID:   tier.ID,
+		BuyerID:        buyerID,
+		Attendees:      attendees,
+		Quantity:       len(attendees),
+		UnitPriceCents: tier.PriceCents,
+		TotalCents:     tier.PriceCents * int64(len(attendees)),
+		Currency:       tier.Currency,
+		Status:         OrderStatusPending,
+		ExpiresAt:      expiresAt,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+}
+
+// ApplyPromoCode takes the discount of a promo code off the total of the order
+func (o *OrderType) ApplyPromoCode(promoCode *PromoCodeType, discountCents int64) {
+	o.PromoCodeID = &promoCode.ID
+	o.PromoCode = promoCode.Code
+	o.DiscountCents = discountCents
+	o.TotalCents = o.UnitPriceCents*int64(o.Quantity) - discountCents
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Roles a member can have in an organization, from most to least privileged
+const (
+	OrganizationRoleOwner  = "Owner"  // Manages the organization, including its other owners
+	OrganizationRoleAdmin  = "Admin"  // Manages members and invitations below owner, and every event
+	OrganizationRoleEditor = "Editor" // Creates and manages the organization's events and guest lists
+	OrganizationRoleViewer = "Viewer" // Sees the organization's events and guest lists without changing them
+)
+
+// organizationRoleRanks orders the organization roles; a higher rank includes everything a lower one may do
+var organizationRoleRanks = map[string]int{
+	OrganizationRoleViewer: 1,
+	OrganizationRoleEditor: 2,
+	OrganizationRoleAdmin:  3,
+	OrganizationRoleOwner:  4,
+}
+
+// IsOrganizationRole reports whether role is one of the known organization roles
+func IsOrganizationRole(role string) bool {
+	_, ok := organizationRoleRanks[role]
+	return ok
+}
+
+// OrganizationRoleAtLeast reports whether role grants at least what minimum does
+func OrganizationRoleAtLeast(role, minimum string) bool {
+	rank, ok := organizationRoleRanks[role]
+	return ok && rank >= organizationRoleRanks[minimum]
+}
+
+// OrganizationType is a team that owns events together; what each member may do depends on their role
+type OrganizationType struct {
+	ID          uuid.UUID `bson:"_id,omitempty" json:"id,omitempty" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	Name        string    `bson:"name" json:"name" gorm:"not null"`
+	CreatedByID uuid.UUID `bson:"created_by_id" json:"created_by_id" gorm:"type:uuid;not null"`
+	CreatedAt   time.Time `bson:"created_at" json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `bson:"updated_at" json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// NewOrganization creates a new instance of OrganizationType
+func NewOrganization(name string, createdByID uuid.UUID) *OrganizationType {
+	return &OrganizationType{
+		ID:          uuid.New(),
+		Name:        name,
+		CreatedByID: createdByID,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// OrganizationMemberType gives a user a role in an organization; a user is a member of an organization at most once
+type OrganizationMemberType struct {
+	ID             uuid.UUID `bson:"_id,omitempty" json:"id,omitempty" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	OrganizationID uuid.UUID `bson:"organization_id" json:"organization_id" gorm:"type:uuid;not null;uniqueIndex:idx_organization_member"`
+	SuperUserID    uuid.UUID `bson:"super_user_id" json:"super_user_id" gorm:"type:uuid;not null;uniqueIndex:idx_organization_member;index"`
+	Role           string    `bson:"role" json:"role" gorm:"not null"`
+	CreatedAt      time.Time `bson:"created_at" json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `bson:"updated_at" json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// NewOrganizationMember creates a new instance of OrganizationMemberType
+func NewOrganizationMember(organizationID, superUserID uuid.UUID, role string) *OrganizationMemberType {
+	return &OrganizationMemberType{
+		ID:             uuid.New(),
+		OrganizationID: organizationID,
+		SuperUserID:    superUserID,
+		Role:           role,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+}
+
+// OrganizationInvitationType asks whoever owns an email address to join an organization with a role.
+// Only a hash of the emailed token is stored; the invitation is spent once accepted.
+type OrganizationInvitationType struct {
+	ID             uuid.UUID  `bson:"_id,omitempty" json:"id,omitempty" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	OrganizationID uuid.UUID  `bson:"organization_id" json:"organization_id" gorm:"type:uuid;not null;index"`
+	Email          string     `bson:"email" json:"email" gorm:"not null"`
+	Role           string     `bson:"role" json:"role" gorm:"not null"`
+	TokenHash      string     `bson:"token_hash" json:"-" gorm:"not null;uniqueIndex"`
+	InvitedByID    uuid.UUID  `bson:"invited_by_id" json:"invited_by_id" gorm:"type:uuid;not null"`
+	ExpiresAt      time.Time  `bson:"expires_at" json:"expires_at" gorm:"not null"`
+	AcceptedAt     *time.Time `bson:"accepted_at,omitempty" json:"accepted_at,omitempty"`
+	AcceptedByID   *uuid.UUID `bson:"accepted_by_id,omitempty" json:"accepted_by_id,omitempty" gorm:"type:uuid"`
+	CreatedAt      time.Time  `bson:"created_at" json:"created_at" gorm:"autoCreateTime"`
+}
+
+// NewOrganizationInvitation creates a new pending instance of OrganizationInvitationType
+func NewOrganizationInvitation(organizationID uuid.UUID, email, role, tokenHash string, invitedByID uuid.UUID, expiresAt time.Time) *OrganizationInvitationType {
+	return &OrganizationInvitationType{
+		ID:             uuid.New(),
+		OrganizationID: organizationID,
+		Email:          email,
+		Role:           role,
+		TokenHash:      tokenHash,
+		InvitedByID:    invitedByID,
+		ExpiresAt:      expiresAt,
+		CreatedAt:      time.Now(),
+	}
+}
+
+// IsPending reports whether the invitation can still be accepted at the given time
+func (i *OrganizationInvitationType) IsPending(now time.Time) bool {
+	return i.AcceptedAt == nil && now.Before(i.ExpiresAt)
+}
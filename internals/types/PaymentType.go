@@ -0,0 +1,18 @@
+package types
+
+// States of a payment at the payment provider
+const (
+	PaymentStatusPending   = "pending"   // The buyer has not completed the payment yet
+	PaymentStatusSucceeded = "succeeded" // The money was captured
+	PaymentStatusFailed    = "failed"    // Declined, abandoned or cancelled
+	PaymentStatusRefunded  = "refunded"  // Captured and returned to the buyer
+)
+
+// PaymentType is the view of a payment that payment providers report back. It is not stored; orders keep the ID.
+type PaymentType struct {
+	ID          string `json:"id"`
+	Status      string `json:"status"`
+	AmountCents int64  `json:"amount_cents"`
+	Currency    string `json:"currency"`
+	CheckoutURL string `json:"checkout_url,omitempty"` // Where the buyer completes the payment, if the provider needs a redirect
+}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kinds of discount a promo code gives
+const (
+	PromoDiscountPercentage = "percentage" // PercentOff percent off the order
+	PromoDiscountFixed      = "fixed"      // AmountOffCents off the order, in Currency
+)
+
+// PromoCodeType is a discount code of an event, entered by buyers when they place an order. Redemptions counts the
+// orders currently using the code; it only changes through the redemption methods of the repository, so concurrent
+// checkouts cannot redeem a limited code more often than allowed.
+type PromoCodeType struct {
+	ID                    uuid.UUID   `bson:"_id,omitempty" json:"id,omitempty" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	EventID               uuid.UUID   `bson:"event_id" json:"event_id" gorm:"type:uuid;not null;uniqueIndex:idx_promo_codes_event_code"`
+	Code                  string      `bson:"code" json:"code" gorm:"not null;uniqueIndex:idx_promo_codes_event_code"` // Upper case, unique per event
+	DiscountType          string      `bson:"discount_type" json:"discount_type" gorm:"not null"`
+	PercentOff            int         `bson:"percent_off,omitempty" json:"percent_off,omitempty"`
+	AmountOffCents        int64       `bson:"amount_off_cents,omitempty" json:"amount_off_cents,omitempty"`
+	Currency              string      `bson:"currency,omitempty" json:"currency,omitempty" gorm:"type:char(3)"`                   // Currency of a fixed discount
+	MaxRedemptions        int         `bson:"max_redemptions" json:"max_redemptions" gorm:"not null;default:0"`                   // 0 for unlimited
+	MaxRedemptionsPerUser int         `bson:"max_redemptions_per_user" json:"max_redemptions_per_user" gorm:"not null;default:0"` // 0 for unlimited
+	Redemptions           int         `bson:"redemptions" json:"redemptions" gorm:"not null;default:0"`
+	ValidFrom             *time.Time  `bson:"valid_from,omitempty" json:"valid_from,omitempty"`
+	ValidUntil            *time.Time  `bson:"valid_until,omitempty" json:"valid_until,omitempty"`
+	TicketTierIDs         []uuid.UUID `bson:"ticket_tier_ids,omitempty" json:"ticket_tier_ids,omitempty" gorm:"serializer:json;type:jsonb"` // Empty for every tier
+	IsActive              bool        `bson:"is_active" json:"is_active" gorm:"default:true"`
+	CreatedAt             time.Time   `bson:"created_at" json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt             time.Time   `bson:"updated_at" json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// NewPromoCode creates a new active instance of PromoCodeType that has not been redeemed yet
+func NewPromoCode(eventID uuid.UUID, code, discountType string) *PromoCodeType {
+	return &PromoCodeType{
+		ID:           uuid.New(),
+		EventID:      eventID,
+		Code:         code,
+		DiscountType: discountType,
+		IsActive:     true,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
+
+// AppliesToTier reports whether the code may be used for tickets of a tier
+func (p *PromoCodeType) AppliesToTier(tierID uuid.UUID) bool {
+	if len(p.TicketTierIDs) == 0 {
+		return true
+	}
+	for _, id := range p.TicketTierIDs {
+		if id == tierID {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidAt reports whether now is inside the validity window of the code
+func (p *PromoCodeType) ValidAt(now time.Time) bool {
+	if p.ValidFrom != nil && now.Before(*p.ValidFrom) {
+		return false
+	}
+	if p.ValidUntil != nil && !now.Before(*p.ValidUntil) {
+		return false
+	}
+	return true
+}
+
+// DiscountFor returns how much the code takes off a subtotal, never more than the subtotal itself
+func (p *PromoCodeType) DiscountFor(subtotalCents int64) int64 {
+	var discount int64
+	switch p.DiscountType {
+	case PromoDiscountPercentage:
+		discount = subtotalCents * int64(p.PercentOff) / 100
+	case PromoDiscountFixed:
+		discount = p.AmountOffCents
+	}
+	return min(discount, subtotalCents)
+}
+
+// PromoRedemptionType records that an order uses a promo code. It exists while the order is pending or went through;
+// cancelled and expired orders give their redemption back.
+type PromoRedemptionType struct {
+	ID            uuid.UUID `bson:"_id,omitempty" json:"id,omitempty" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	PromoCodeID   uuid.UUID `bson:"promo_code_id" json:"promo_code_id" gorm:"type:uuid;not null;index"`
+	EventID       uuid.UUID `bson:"event_id" json:"event_id" gorm:"type:uuid;not null;index"`
+	OrderID       uuid.UUID `bson:"order_id" json:"order_id" gorm:"type:uuid;not null;uniqueIndex"`
+	UserID        uuid.UUID `bson:"user_id" json:"user_id" gorm:"type:uuid;not null;index"`
+	DiscountCents int64     `bson:"discount_cents" json:"discount_cents" gorm:"not null"`
+	CreatedAt     time.Time `bson:"created_at" json:"created_at" gorm:"autoCreateTime"`
+}
+
+// NewPromoRedemption creates a new instance of PromoRedemptionType for an order of a user
+func NewPromoRedemption(promoCode *PromoCodeType, orderID, userID uuid.UUID, discountCents int64) *PromoRedemptionType {
+	return &PromoRedemptionType{
+		ID:            uuid.New(),
+		PromoCodeID:   promoCode.ID,
+		EventID:       promoCode.EventID,
+		OrderID:       orderID,
+		UserID:        userID,
+		DiscountCents: discountCents,
+		CreatedAt:     time.Now(),
+	}
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kinds of question a registration form can ask
+const (
+	RegistrationFieldText        = "text"
+	RegistrationFieldSelect      = "select"
+	RegistrationFieldMultiSelect = "multi_select"
+	RegistrationFieldCheckbox    = "checkbox"
+	RegistrationFieldNumber      = "number"
+	RegistrationFieldDate        = "date" // Answered as YYYY-MM-DD
+)
+
+// IsRegistrationFieldType reports whether fieldType is one of the known kinds of question
+func IsRegistrationFieldType(fieldType string) bool {
+	switch fieldType {
+	case RegistrationFieldText, RegistrationFieldSelect, RegistrationFieldMultiSelect,
+		RegistrationFieldCheckbox, RegistrationFieldNumber, RegistrationFieldDate:
+		return true
+	}
+	return false
+}
+
+// RegistrationFieldType is one question of a registration form. Guest answers are stored under Key, so it should
+// stay the same when the label is reworded. Which rules apply depends on Type: lengths and Pattern limit text
+// answers, Min and Max the value of numbers or the number of choices of a multi-select, and MinDate and MaxDate
+// the range of dates. A required checkbox has to be ticked, as for consent.
+type RegistrationFieldType struct {
+	Key       string   `bson:"key" json:"key"`
+	Label     string   `bson:"label" json:"label"`
+	Type      string   `bson:"type" json:"type"`
+	Required  bool     `bson:"required" json:"required"`
+	HelpText  string   `bson:"help_text,omitempty" json:"help_text,omitempty"`
+	Options   []string `bson:"options,omitempty" json:"options,omitempty"` // Choices of select and multi-select fields
+	MinLength int      `bson:"min_length,omitempty" json:"min_length,omitempty"`
+	MaxLength int      `bson:"max_length,omitempty" json:"max_length,omitempty"`
+	Pattern   string   `bson:"pattern,omitempty" json:"pattern,omitempty"` // Regular expression a text answer must match
+	Min       *float64 `bson:"min,omitempty" json:"min,omitempty"`
+	Max       *float64 `bson:"max,omitempty" json:"max,omitempty"`
+	MinDate   string   `bson:"min_date,omitempty" json:"min_date,omitempty"`
+	MaxDate   string   `bson:"max_date,omitempty" json:"max_date,omitempty"`
+}
+
+// HasOption reports whether value is one of the choices of a select or multi-select field
+func (f *RegistrationFieldType) HasOption(value string) bool {
+	for _, option := range f.Options {
+		if option == value {
+			return true
+		}
+	}
+	return false
+}
+
+// RegistrationFormType holds the questions an event asks its guests on top of their name and email.
+// An event has at most one form; the fields keep the order they are shown and exported in.
+type RegistrationFormType struct {
+	ID        uuid.UUID               `bson:"_id,omitempty" json:"id,omitempty" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	EventID   uuid.UUID               `bson:"event_id" json:"event_id" gorm:"type:uuid;not null;uniqueIndex"`
+	Fields    []RegistrationFieldType `bson:"fields" json:"fields" gorm:"serializer:json;type:jsonb;not null"`
+	CreatedAt time.Time               `bson:"created_at" json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time               `bson:"updated_at" json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// NewRegistrationForm creates a new instance of RegistrationFormType for an event
+func NewRegistrationForm(eventID uuid.UUID, fields []RegistrationFieldType) *RegistrationFormType {
+	return &RegistrationFormType{
+		ID:        uuid.New(),
+		EventID:   eventID,
+		Fields:    fields,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// Field returns the field stored under key, or nil when the form has no such field
+func (f *RegistrationFormType) Field(key string) *RegistrationFieldType {
+	for i := range f.Fields {
+		if f.Fields[i].Key == key {
+			return &f.Fields[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Delivery states of a reminder
+const (
+	ReminderStatusPending = "Pending" // Waiting for SendAt
+	ReminderStatusSent    = "Sent"    // Every guest has been emailed
+	ReminderStatusSkipped = "Skipped" // The event was no longer published when the reminder came due
+)
+
+// ReminderType is one planned reminder email for one occurrence of an event, sent OffsetMinutes before it starts.
+// GuestsNotified records every guest already emailed, so a restart halfway through never emails a guest twice.
+type ReminderType struct {
+	ID              uuid.UUID   `bson:"_id,omitempty" json:"id,omitempty" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	EventID         uuid.UUID   `bson:"event_id" json:"event_id" gorm:"type:uuid;not null;index"`
+	OccurrenceStart time.Time   `bson:"occurrence_start" json:"occurrence_start" gorm:"not null"`
+	OffsetMinutes   int         `bson:"offset_minutes" json:"offset_minutes" gorm:"not null"`
+	SendAt          time.Time   `bson:"send_at" json:"send_at" gorm:"not null;index"`
+	Status          string      `bson:"status" json:"status" gorm:"not null;index"`
+	GuestsNotified  []uuid.UUID `bson:"guests_notified" json:"guests_notified" gorm:"serializer:json;type:jsonb"`
+	SentAt          *time.Time  `bson:"sent_at,omitempty" json:"sent_at,omitempty"`
+	CreatedAt       time.Time   `bson:"created_at" json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt       time.Time   `bson:"updated_at" json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// NewReminder creates a new pending instance of ReminderType for the occurrence starting at occurrenceStart
+func NewReminder(eventID uuid.UUID, occurrenceStart time.Time, offset time.Duration) *ReminderType {
+	return &ReminderType{
+		ID:              uuid.New(),
+		EventID:         eventID,
+		OccurrenceStart: occurrenceStart.UTC(),
+		OffsetMinutes:   int(offset / time.Minute),
+		SendAt:          occurrenceStart.Add(-offset).UTC(),
+		Status:          ReminderStatusPending,
+		GuestsNotified:  []uuid.UUID{},
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+}
+
+// HasNotified reports whether the guest has already been emailed for this reminder
+func (r *ReminderType) HasNotified(guestID uuid.UUID) bool {
+	for _, notified := range r.GuestsNotified {
+		if notified == guestID {
+			return true
+		}
+	}
+	return false
+}
@@ -21,6 +21,25 @@ type SuperUserType struct {
 	OTP              *string   `bson:"otp,omitempty" json:"-" gorm:"type:text"`
 	OTPExpiry        time.Time `bson:"otp_expiry,omitempty" json:"-"`
 	IsOTPVerified    bool      `bson:"is_otp_verified" json:"is_otp_verified" gorm:"default:false"`
+	// PasswordResetRequired is set by an admin; the superuser cannot log in until they reset their password
+	PasswordResetRequired bool `bson:"password_reset_required" json:"password_reset_required" gorm:"default:false"`
+	// DeletedAt is set when the superuser is soft-deleted; the account stays for the record but can no longer be used
+	DeletedAt *time.Time `bson:"deleted_at,omitempty" json:"deleted_at,omitempty" gorm:"index"`
+	// PasswordChangedAt is when the current password was chosen; the password policy expires it after a while
+	PasswordChangedAt time.Time `bson:"password_changed_at,omitempty" json:"-"`
+	// PasswordHistory holds the hashes of the passwords before the current one, newest first, so they are not reused
+	PasswordHistory []string `bson:"password_history,omitempty" json:"-" gorm:"type:text[]"`
+	// SessionsValidAfter revokes sessions: auth tokens issued before it are rejected, e.g. after a password change
+	SessionsValidAfter time.Time `bson:"sessions_valid_after,omitempty" json:"-"`
+	// PendingEmail is the address an email change waits to verify with the hashed EmailChangeToken
+	PendingEmail      *string   `bson:"pending_email,omitempty" json:"-" gorm:"type:text"`
+	EmailChangeToken  *string   `bson:"email_change_token,omitempty" json:"-" gorm:"type:text;index"`
+	EmailChangeExpiry time.Time `bson:"email_change_expiry,omitempty" json:"-"`
+	// PreviousEmail is the address the last email change replaced; its owner can undo the change with the
+	// hashed EmailUndoToken until EmailUndoExpiry
+	PreviousEmail   *string   `bson:"previous_email,omitempty" json:"-" gorm:"type:text"`
+	EmailUndoToken  *string   `bson:"email_undo_token,omitempty" json:"-" gorm:"type:text;index"`
+	EmailUndoExpiry time.Time `bson:"email_undo_expiry,omitempty" json:"-"`
 }
 
 // NewSuperUser creates a new SuperUser instance
@@ -34,11 +53,12 @@ func NewSuperUser(email, fullName, username, hashedPassword, role, otp string) *
 			UpdatedAt: time.Now(),
 			IsActive:  true,
 		},
-		Role:           validateRole(role),
-		Username:       username,
-		HashedPassword: hashedPassword,
-		OTP:            &otp,
-		OTPExpiry:      time.Now().Add(15 * time.Minute), // Example OTP expiry
+		Role:              validateRole(role),
+		Username:          username,
+		HashedPassword:    hashedPassword,
+		PasswordChangedAt: time.Now(),
+		OTP:               &otp,
+		OTPExpiry:         time.Now().Add(15 * time.Minute), // Example OTP expiry
 	}
 }
 
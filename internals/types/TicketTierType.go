@@ -0,0 +1,62 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TicketTierType is a kind of ticket sold for an event, such as "Early Bird" or "VIP". Prices are in the minor unit
+// of Currency (cents). Sold counts tickets of confirmed orders and Held those of orders still waiting for payment;
+// both only change through the inventory methods of the repository, so concurrent checkouts cannot oversell a tier.
+type TicketTierType struct {
+	ID           uuid.UUID  `bson:"_id,omitempty" json:"id,omitempty" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	EventID      uuid.UUID  `bson:"event_id" json:"event_id" gorm:"type:uuid;not null;index"`
+	Name         string     `bson:"name" json:"name" gorm:"not null"`
+	Description  string     `bson:"description,omitempty" json:"description,omitempty" gorm:"type:text"`
+	PriceCents   int64      `bson:"price_cents" json:"price_cents" gorm:"not null;default:0"`
+	Currency     string     `bson:"currency" json:"currency" gorm:"type:char(3);not null"`
+	Quantity     int        `bson:"quantity" json:"quantity" gorm:"not null"`
+	Sold         int        `bson:"sold" json:"sold" gorm:"not null;default:0"`
+	Held         int        `bson:"held" json:"held" gorm:"not null;default:0"`
+	SalesStartAt *time.Time `bson:"sales_start_at,omitempty" json:"sales_start_at,omitempty"` // Sales open right away when unset
+	SalesEndAt   *time.Time `bson:"sales_end_at,omitempty" json:"sales_end_at,omitempty"`     // Sales run until the event when unset
+	CreatedAt    time.Time  `bson:"created_at" json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time  `bson:"updated_at" json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// NewTicketTier creates a new instance of TicketTierType with nothing sold yet
+func NewTicketTier(eventID uuid.UUID, name, description string, priceCents int64, currency string, quantity int, salesStartAt, salesEndAt *time.Time) *TicketTierType {
+	return &TicketTierType{
+		ID:           uuid.New(),
+		EventID:      eventID,
+		Name:         name,
+		Description:  description,
+		PriceCents:   priceCents,
+		Currency:     currency,
+		Quantity:     quantity,
+		SalesStartAt: salesStartAt,
+		SalesEndAt:   salesEndAt,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
+
+// Available returns how many tickets of the tier can still be ordered
+func (t *TicketTierType) Available() int {
+	if available := t.Quantity - t.Sold - t.Held; available > 0 {
+		return available
+	}
+	return 0
+}
+
+// OnSale reports whether now is inside the sale window of the tier
+func (t *TicketTierType) OnSale(now time.Time) bool {
+	if t.SalesStartAt != nil && now.Before(*t.SalesStartAt) {
+		return false
+	}
+	if t.SalesEndAt != nil && !now.Before(*t.SalesEndAt) {
+		return false
+	}
+	return true
+}
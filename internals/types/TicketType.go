@@ -0,0 +1,44 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Lifecycle states of a ticket
+const (
+	TicketStatusValid   = "Valid"   // Can be used to check in
+	TicketStatusUsed    = "Used"    // The guest has checked in with it
+	TicketStatusRevoked = "Revoked" // Cancelled by the organizer or because the guest declined
+)
+
+// TicketType is the admission ticket of a guest. The signed code the guest shows at the door only carries
+// the ticket, event and guest IDs, so whether it was used or revoked is always decided by this record.
+type TicketType struct {
+	ID            uuid.UUID  `bson:"_id,omitempty" json:"id,omitempty" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	EventID       uuid.UUID  `bson:"event_id" json:"event_id" gorm:"type:uuid;not null;index"`
+	GuestID       uuid.UUID  `bson:"guest_id" json:"guest_id" gorm:"type:uuid;not null;index"`
+	Status        string     `bson:"status" json:"status" gorm:"not null;index"`
+	IssuedAt      time.Time  `bson:"issued_at" json:"issued_at" gorm:"not null"`
+	CheckedInAt   *time.Time `bson:"checked_in_at,omitempty" json:"checked_in_at,omitempty"`
+	CheckedInBy   *uuid.UUID `bson:"checked_in_by,omitempty" json:"checked_in_by,omitempty" gorm:"type:uuid"`
+	RevokedAt     *time.Time `bson:"revoked_at,omitempty" json:"revoked_at,omitempty"`
+	RevokedReason string     `bson:"revoked_reason,omitempty" json:"revoked_reason,omitempty" gorm:"type:text"`
+	CreatedAt     time.Time  `bson:"created_at" json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time  `bson:"updated_at" json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// NewTicket creates a new valid instance of TicketType for a guest of an event
+func NewTicket(eventID, guestID uuid.UUID) *TicketType {
+	now := time.Now()
+	return &TicketType{
+		ID:        uuid.New(),
+		EventID:   eventID,
+		GuestID:   guestID,
+		Status:    TicketStatusValid,
+		IssuedAt:  now.UTC().Truncate(time.Second),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GeoPointType is a GeoJSON point, stored alongside venues so MongoDB can build a 2dsphere index
+type GeoPointType struct {
+	Type        string    `bson:"type" json:"type"`
+	Coordinates []float64 `bson:"coordinates" json:"coordinates"` // [longitude, latitude] as required by GeoJSON
+}
+
+// VenueType defines the structure for a physical place where events are held
+type VenueType struct {
+	ID          uuid.UUID    `bson:"_id,omitempty" json:"id,omitempty" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	Name        string       `bson:"name" json:"name" validate:"required,min=3,max=100" gorm:"not null"`
+	AddressLine string       `bson:"address_line" json:"address_line" gorm:"type:text"`
+	City        string       `bson:"city" json:"city" gorm:"index"`
+	State       string       `bson:"state" json:"state"`
+	PostalCode  string       `bson:"postal_code" json:"postal_code"`
+	Country     string       `bson:"country" json:"country"`
+	Latitude    float64      `bson:"latitude" json:"latitude" validate:"latitude" gorm:"not null;index"`
+	Longitude   float64      `bson:"longitude" json:"longitude" validate:"longitude" gorm:"not null;index"`
+	GeoLocation GeoPointType `bson:"geo_location" json:"-" gorm:"-"`
+	Timezone    string       `bson:"timezone" json:"timezone" validate:"required,timezone" gorm:"not null"`
+	Capacity    int          `bson:"capacity" json:"capacity" validate:"gte=0" gorm:"default:0"`
+	CreatedByID uuid.UUID    `bson:"created_by_id" json:"created_by_id" gorm:"type:uuid;not null"`
+	CreatedAt   time.Time    `bson:"created_at" json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time    `bson:"updated_at" json:"updated_at" gorm:"autoUpdateTime"`
+	IsActive    bool         `bson:"is_active" json:"is_active" gorm:"default:true"`
+}
+
+// NewVenue creates a new instance of VenueType
+func NewVenue(name, addressLine, city, state, postalCode, country, timezone string, latitude, longitude float64, capacity int, createdByID uuid.UUID) *VenueType {
+	return &VenueType{
+		ID:          uuid.New(),
+		Name:        name,
+		AddressLine: addressLine,
+		City:        city,
+		State:       state,
+		PostalCode:  postalCode,
+		Country:     country,
+		Latitude:    latitude,
+		Longitude:   longitude,
+		GeoLocation: NewGeoPoint(latitude, longitude),
+		Timezone:    timezone,
+		Capacity:    capacity,
+		CreatedByID: createdByID,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+		IsActive:    true,
+	}
+}
+
+// NewGeoPoint builds a GeoJSON point from a latitude and longitude pair
+func NewGeoPoint(latitude, longitude float64) GeoPointType {
+	return GeoPointType{
+		Type:        "Point",
+		Coordinates: []float64{longitude, latitude},
+	}
+}
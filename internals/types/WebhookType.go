@@ -0,0 +1,98 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookEventTypes lists the domain events organizers can subscribe webhooks to
+var WebhookEventTypes = []string{
+	DomainEventEventCreated,
+	DomainEventEventUpdated,
+	DomainEventEventCancelled,
+	DomainEventGuestRSVPChanged,
+	DomainEventGuestCheckedIn,
+}
+
+// IsWebhookEventType reports whether webhooks can subscribe to the given domain event
+func IsWebhookEventType(eventType string) bool {
+	for _, candidate := range WebhookEventTypes {
+		if candidate == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Outcomes of a webhook delivery
+const (
+	WebhookDeliveryStatusPending   = "Pending"   // Waiting for its first attempt or a retry
+	WebhookDeliveryStatusSucceeded = "Succeeded" // The endpoint answered with a 2xx status
+	WebhookDeliveryStatusFailed    = "Failed"    // Every attempt failed, or the webhook was disabled meanwhile
+)
+
+// WebhookType is an endpoint an organizer registered to be told about the domain events of their events.
+// Secret signs every delivery and is only shown to the organizer when the webhook is created.
+type WebhookType struct {
+	ID          uuid.UUID `bson:"_id,omitempty" json:"id,omitempty" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	OrganizerID uuid.UUID `bson:"organizer_id" json:"organizer_id" gorm:"type:uuid;not null;index"`
+	URL         string    `bson:"url" json:"url" gorm:"not null"`
+	EventTypes  []string  `bson:"event_types" json:"event_types" gorm:"serializer:json;type:jsonb;not null"`
+	Secret      string    `bson:"secret" json:"-" gorm:"not null"`
+	Description string    `bson:"description,omitempty" json:"description,omitempty"`
+	IsActive    bool      `bson:"is_active" json:"is_active" gorm:"default:true"`
+	CreatedAt   time.Time `bson:"created_at" json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `bson:"updated_at" json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// NewWebhook creates a new active instance of WebhookType
+func NewWebhook(organizerID uuid.UUID, url string, eventTypes []string, secret, description string) *WebhookType {
+	return &WebhookType{
+		ID:          uuid.New(),
+		OrganizerID: organizerID,
+		URL:         url,
+		EventTypes:  eventTypes,
+		Secret:      secret,
+		Description: description,
+		IsActive:    true,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// WebhookDeliveryType is one domain event sent, or still to be sent, to one webhook. It doubles as the delivery log:
+// every attempt updates the outcome, and NextAttemptAt is set while the delivery is pending.
+type WebhookDeliveryType struct {
+	ID             uuid.UUID  `bson:"_id,omitempty" json:"id,omitempty" gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	WebhookID      uuid.UUID  `bson:"webhook_id" json:"webhook_id" gorm:"type:uuid;not null;index"`
+	DomainEventID  uuid.UUID  `bson:"domain_event_id" json:"domain_event_id" gorm:"type:uuid;not null"`
+	EventType      string     `bson:"event_type" json:"event_type" gorm:"not null"`
+	Payload        string     `bson:"payload" json:"payload" gorm:"type:text;not null"` // JSON body sent to the endpoint
+	Status         string     `bson:"status" json:"status" gorm:"not null;index"`
+	Attempts       int        `bson:"attempts" json:"attempts" gorm:"default:0"`
+	NextAttemptAt  *time.Time `bson:"next_attempt_at,omitempty" json:"next_attempt_at,omitempty" gorm:"index"`
+	LastAttemptAt  *time.Time `bson:"last_attempt_at,omitempty" json:"last_attempt_at,omitempty"`
+	ResponseStatus int        `bson:"response_status,omitempty" json:"response_status,omitempty"`
+	ResponseBody   string     `bson:"response_body,omitempty" json:"response_body,omitempty" gorm:"type:text"` // Start of the last response
+	LastError      string     `bson:"last_error,omitempty" json:"last_error,omitempty" gorm:"type:text"`
+	RedeliveryOf   *uuid.UUID `bson:"redelivery_of,omitempty" json:"redelivery_of,omitempty" gorm:"type:uuid"` // Delivery this one manually repeats
+	CreatedAt      time.Time  `bson:"created_at" json:"created_at" gorm:"autoCreateTime;index"`
+	UpdatedAt      time.Time  `bson:"updated_at" json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// NewWebhookDelivery creates a new pending instance of WebhookDeliveryType that is due right away
+func NewWebhookDelivery(webhookID, domainEventID uuid.UUID, eventType, payload string) *WebhookDeliveryType {
+	now := time.Now()
+	return &WebhookDeliveryType{
+		ID:            uuid.New(),
+		WebhookID:     webhookID,
+		DomainEventID: domainEventID,
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        WebhookDeliveryStatusPending,
+		NextAttemptAt: &now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
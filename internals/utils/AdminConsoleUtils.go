@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// AdminLogInRequest is the login form of the admin console
+type AdminLogInRequest struct {
+	Login    string `form:"login" binding:"required"`          // Email or username of the superuser
+	Password string `form:"password" binding:"required,min=8"` // Password for authentication
+}
+
+// TransformToLogInSuperuserRequest turns the console login form into the request the login service takes
+func TransformToLogInSuperuserRequest(req AdminLogInRequest) *LogInSuperuserRequest {
+	login := strings.TrimSpace(req.Login)
+	if strings.Contains(login, "@") {
+		return &LogInSuperuserRequest{Email: login, Password: req.Password}
+	}
+	return &LogInSuperuserRequest{Username: login, Password: req.Password}
+}
+
+// AdminEventSearchQuery holds the query parameters of the admin event listing
+type AdminEventSearchQuery struct {
+	Search string `form:"q"` // Part of an event title; empty lists every event
+}
+
+// AdminEventListPage is the data of the admin event listing
+type AdminEventListPage struct {
+	Events []*types.EventType `json:"events"` // Events matching the search, latest start first
+	Search string             `json:"search"` // Search the listing was filtered by
+}
+
+// AdminEventPage is the data of the admin page of an event and its guest list
+type AdminEventPage struct {
+	Event  *types.EventType   `json:"event"`  // The event shown
+	Guests []*types.GuestType `json:"guests"` // Guests of the event, ordered by email
+}
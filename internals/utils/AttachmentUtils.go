@@ -0,0 +1,23 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// WriteTempAttachment writes content to fileName inside a fresh temporary directory so emails show a friendly file name.
+// The email service reads attachments when the email is queued, so cleanup can run right after sending.
+func WriteTempAttachment(fileName string, content []byte) (string, func(), error) {
+	dir, err := os.MkdirTemp("", "eventurego-attachment-*")
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+
+	path := filepath.Join(dir, filepath.Base(fileName))
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	return path, cleanup, nil
+}
@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// AttendanceStreamEvent is the SSE event name of attendance updates
+const AttendanceStreamEvent = "attendance"
+
+// attendanceRSVPStatuses fixes the order statuses are hashed in, and makes every status show up with a count
+var attendanceRSVPStatuses = []string{
+	types.RSVPStatusPending,
+	types.RSVPStatusAccepted,
+	types.RSVPStatusTentative,
+	types.RSVPStatusDeclined,
+	types.RSVPStatusWaitlisted,
+}
+
+// AttendanceStats is the live attendance of an event
+type AttendanceStats struct {
+	EventID     uuid.UUID        `json:"event_id"`
+	TotalGuests int64            `json:"total_guests"` // Everyone on the guest list
+	RSVPs       map[string]int64 `json:"rsvps"`        // Guests per RSVP status
+	CheckedIn   int64            `json:"checked_in"`   // Guests admitted at the door
+	Waitlist    int64            `json:"waitlist"`     // Guests waiting for a seat
+	Capacity    int              `json:"capacity"`     // Seats at the venue, 0 when unlimited
+	UpdatedAt   time.Time        `json:"updated_at"`   // When these numbers were counted
+}
+
+// NewAttendanceStats builds the stats from the per-status guest counts, filling in statuses nobody has
+func NewAttendanceStats(eventID uuid.UUID, rsvpCounts map[string]int64, checkedIn int64, capacity int) *AttendanceStats {
+	stats := &AttendanceStats{
+		EventID:   eventID,
+		RSVPs:     make(map[string]int64, len(attendanceRSVPStatuses)),
+		CheckedIn: checkedIn,
+		Waitlist:  rsvpCounts[types.RSVPStatusWaitlisted],
+		Capacity:  capacity,
+		UpdatedAt: time.Now(),
+	}
+	for _, status := range attendanceRSVPStatuses {
+		stats.RSVPs[status] = rsvpCounts[status]
+	}
+	for _, count := range rsvpCounts {
+		stats.TotalGuests += count
+	}
+	return stats
+}
+
+// Fingerprint identifies the numbers, ignoring UpdatedAt. It is used as the SSE event ID, so a client
+// reconnecting with the Last-Event-ID of unchanged numbers is not sent them again.
+func (s *AttendanceStats) Fingerprint() string {
+	hash := fnv.New64a()
+	fmt.Fprintf(hash, "%s|%d|%d|%d|%d", s.EventID, s.TotalGuests, s.CheckedIn, s.Waitlist, s.Capacity)
+	for _, status := range attendanceRSVPStatuses {
+		fmt.Fprintf(hash, "|%d", s.RSVPs[status])
+	}
+	return fmt.Sprintf("%016x", hash.Sum64())
+}
+
+// FormatSSEEvent renders one server-sent event. Data is split on newlines as the format requires.
+func FormatSSEEvent(id, event string, data []byte) string {
+	var builder strings.Builder
+	if id != "" {
+		builder.WriteString("id: " + id + "\n")
+	}
+	if event != "" {
+		builder.WriteString("event: " + event + "\n")
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		builder.WriteString("data: " + line + "\n")
+	}
+	builder.WriteString("\n")
+	return builder.String()
+}
+
+// FormatSSERetry tells the client how long to wait before reconnecting
+func FormatSSERetry(retry time.Duration) string {
+	return fmt.Sprintf("retry: %d\n\n", retry.Milliseconds())
+}
+
+// SSEHeartbeat is a comment line that keeps proxies from closing an idle stream
+const SSEHeartbeat = ": heartbeat\n\n"
@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/repositories"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// AuditPageSize is how many audit records a listing shows per page unless asked for another page size
+const AuditPageSize = 50
+
+// MaxAuditPageSize caps the page size of an audit log listing
+const MaxAuditPageSize = 200
+
+// auditIgnoredFields change on every update, so they are left out of audit diffs
+var auditIgnoredFields = map[string]bool{"updated_at": true}
+
+// AuditSnapshot captures the JSON fields of a target, e.g. before a service changes it. Fields the JSON encoding
+// leaves out, such as password hashes and secrets, are left out of the snapshot too. A nil target has no fields.
+func AuditSnapshot(target interface{}) map[string]json.RawMessage {
+	fields := map[string]json.RawMessage{}
+	encoded, err := json.Marshal(target)
+	if err != nil {
+		return fields
+	}
+	_ = json.Unmarshal(encoded, &fields)
+	return fields
+}
+
+// AuditDiff lists the fields that differ between two snapshots of a target. A field missing from one snapshot
+// counts as null there, so diffing against an empty snapshot lists every field of a created or deleted target.
+func AuditDiff(before, after map[string]json.RawMessage) map[string]*types.AuditChangeType {
+	changes := map[string]*types.AuditChangeType{}
+	addChange := func(field string, from, to json.RawMessage) {
+		if auditIgnoredFields[field] {
+			return
+		}
+		if from == nil {
+			from = json.RawMessage("null")
+		}
+		if to == nil {
+			to = json.RawMessage("null")
+		}
+		if bytes.Equal(from, to) {
+			return
+		}
+		changes[field] = &types.AuditChangeType{From: from, To: to}
+	}
+	for field, from := range before {
+		addChange(field, from, after[field])
+	}
+	for field, to := range after {
+		if _, seen := before[field]; !seen {
+			addChange(field, nil, to)
+		}
+	}
+	return changes
+}
+
+// AuditRecordQuery holds the query parameters of an audit log listing
+type AuditRecordQuery struct {
+	ActorID    string `form:"actor_id" query:"actor_id"`       // Only actions by this superuser
+	Action     string `form:"action" query:"action"`           // Only this action, e.g. "event.rescheduled"
+	TargetType string `form:"target_type" query:"target_type"` // Only targets of this type, e.g. "event"
+	TargetID   string `form:"target_id" query:"target_id"`     // Only this target
+	Since      string `form:"since" query:"since"`             // Only records created at or after this RFC 3339 time
+	Until      string `form:"until" query:"until"`             // Only records created before this RFC 3339 time
+	Page       int    `form:"page" query:"page"`               // 1-based page number; defaults to the first page
+	PageSize   int    `form:"page_size" query:"page_size"`     // Records per page; defaults to AuditPageSize
+}
+
+// TransformToAuditRecordFilter turns the filters of a validated listing query into a repository filter
+func TransformToAuditRecordFilter(query *AuditRecordQuery) repositories.AuditRecordFilter {
+	filter := repositories.AuditRecordFilter{
+		Action:     query.Action,
+		TargetType: query.TargetType,
+	}
+	if actorID, err := uuid.Parse(query.ActorID); err == nil {
+		filter.ActorID = &actorID
+	}
+	if targetID, err := uuid.Parse(query.TargetID); err == nil {
+		filter.TargetID = &targetID
+	}
+	if since, err := time.Parse(time.RFC3339, query.Since); err == nil {
+		filter.Since = &since
+	}
+	if until, err := time.Parse(time.RFC3339, query.Until); err == nil {
+		filter.Until = &until
+	}
+	return filter
+}
+
+// AuditRecordListResponse is one page of an audit log listing
+type AuditRecordListResponse struct {
+	Records  []*types.AuditRecordType `json:"records"`   // Records on this page, latest first
+	Query    *AuditRecordQuery        `json:"-"`         // Query the listing was made for, to link to other pages
+	Page     int                      `json:"page"`      // 1-based page number
+	PageSize int                      `json:"page_size"` // Maximum number of records per page
+	Total    int64                    `json:"total"`     // Number of records matching the query
+}
+
+// HasPreviousPage reports whether there is a page before this one
+func (l *AuditRecordListResponse) HasPreviousPage() bool {
+	return l.Page > 1
+}
+
+// HasNextPage reports whether more records match than are shown up to this page
+func (l *AuditRecordListResponse) HasNextPage() bool {
+	return int64(l.Page*l.PageSize) < l.Total
+}
+
+// PreviousPage returns the number of the page before this one
+func (l *AuditRecordListResponse) PreviousPage() int {
+	return l.Page - 1
+}
+
+// NextPage returns the number of the page after this one
+func (l *AuditRecordListResponse) NextPage() int {
+	return l.Page + 1
+}
+
+// AuditChainReport is the outcome of checking the audit log hash chain
+type AuditChainReport struct {
+	Valid    bool   `json:"valid"`               // Whether every record is intact and in place
+	Records  int64  `json:"records"`             // Number of records checked
+	LastHash string `json:"last_hash,omitempty"` // Hash of the last intact record; keep it to detect a truncated log later
+	// BrokenAtSequence is the sequence number where the chain first breaks, when it is not valid
+	BrokenAtSequence int64 `json:"broken_at_sequence,omitempty"`
+	// Problem describes how the chain breaks there
+	Problem string `json:"problem,omitempty"`
+}
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// GrantEventAccessRequest defines the structure for sharing an event with another user by email
+type GrantEventAccessRequest struct {
+	Email string `json:"email" binding:"required,email"`
+	Role  string `json:"role" binding:"required"` // CoOrganizer, Editor or CheckIn
+}
+
+// ChangeEventGrantRoleRequest defines the structure for changing the access of a user the event was shared with
+type ChangeEventGrantRoleRequest struct {
+	Role string `json:"role" binding:"required"`
+}
+
+// TransferEventRequest defines the structure for handing an event over to a new primary organizer
+type TransferEventRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// EventGrantDTO is the internal representation of a new grant
+type EventGrantDTO struct {
+	Email string
+	Role  string
+}
+
+// TransformToEventGrantDTO converts the incoming request to an EventGrantDTO, lower-casing the email
+func TransformToEventGrantDTO(grantReq GrantEventAccessRequest) *EventGrantDTO {
+	return &EventGrantDTO{
+		Email: strings.ToLower(strings.TrimSpace(grantReq.Email)),
+		Role:  grantReq.Role,
+	}
+}
+
+// EventGrantResponse defines the structure returned to clients for a user an event was shared with
+type EventGrantResponse struct {
+	SuperUserID uuid.UUID `json:"super_user_id"`
+	Username    string    `json:"username,omitempty"`
+	FullName    string    `json:"full_name,omitempty"`
+	Email       string    `json:"email,omitempty"`
+	Role        string    `json:"role"`
+	GrantedByID uuid.UUID `json:"granted_by_id"`
+	GrantedAt   time.Time `json:"granted_at"`
+}
+
+// TransformToEventGrantResponse converts a grant to EventGrantResponse; superUser may be nil when the account is gone
+func TransformToEventGrantResponse(grant *types.EventGrantType, superUser *types.SuperUserType) *EventGrantResponse {
+	response := &EventGrantResponse{
+		SuperUserID: grant.SuperUserID,
+		Role:        grant.Role,
+		GrantedByID: grant.GrantedByID,
+		GrantedAt:   grant.CreatedAt,
+	}
+	if superUser != nil {
+		response.Username = superUser.Username
+		response.FullName = superUser.FullName
+		response.Email = superUser.Email
+	}
+	return response
+}
@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"encoding/base32"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,66 +11,186 @@ import (
 
 // RegisterEventRequest defines the structure for registering a new event
 type RegisterEventRequest struct {
-	Title       string    `json:"title" validate:"required,min=3,max=100"`        // Required event title
-	Description string    `json:"description" validate:"max=1000"`                // Optional description with a limit
-	StartTime   time.Time `json:"start_time" validate:"required"`                 // Required start time
-	EndTime     time.Time `json:"end_time" validate:"required,gtfield=StartTime"` // Required end time must be greater than start time
-	Location    string    `json:"location" validate:"required"`                   // Required location
-	Tags        []string  `json:"tags" validate:"dive,required"`                  // Optional tags, can be empty
+	Title        string                     `json:"title" validate:"required,min=3,max=100"`              // Required event title
+	Description  string                     `json:"description" validate:"max=1000"`                      // Optional description with a limit
+	StartTime    time.Time                  `json:"start_time" validate:"required"`                       // Required start time, RFC 3339 with an offset
+	EndTime      time.Time                  `json:"end_time" validate:"required,gtfield=StartTime"`       // Required end time must be greater than start time
+	Timezone     string                     `json:"timezone" validate:"omitempty,timezone"`               // IANA timezone, defaults to the venue's timezone or UTC
+	Recurrence   *types.EventRecurrenceType `json:"recurrence"`                                           // Optional recurrence rule
+	Location     string                     `json:"location" validate:"required_without=VenueID"`         // Free-text location, required unless a venue is given
+	VenueID      *uuid.UUID                 `json:"venue_id"`                                             // Optional venue the event is held at
+	Tags         []string                   `json:"tags" validate:"dive,required"`                        // Optional tags, can be empty
+	ConflictMode string                     `json:"conflict_mode" validate:"omitempty,oneof=reject warn"` // "reject" or "warn" on double bookings, defaults to the configured mode
+	Publish      bool                       `json:"publish"`                                              // Publish immediately instead of saving a draft
 }
 
 // EventDTO is the internal representation of the event data
 type EventDTO struct {
-	Title       string
-	Description string
-	Location    string
-	StartTime   time.Time
-	EndTime     time.Time
-	Tags        []string
+	Title        string
+	Description  string
+	Location     string
+	VenueID      *uuid.UUID
+	StartTime    time.Time
+	EndTime      time.Time
+	Timezone     string
+	Recurrence   *types.EventRecurrenceType
+	Tags         []string
+	ConflictMode string
+	Publish      bool
 }
 
 // TransformToEventDTO converts the incoming request to an EventDTO for internal use
 func TransformToEventDTO(eventReq RegisterEventRequest) *EventDTO {
 	return &EventDTO{
-		Title:       eventReq.Title,
-		Description: eventReq.Description,
-		Location:    eventReq.Location,
-		StartTime:   eventReq.StartTime,
-		EndTime:     eventReq.EndTime,
-		Tags:        eventReq.Tags,
+		Title:        eventReq.Title,
+		Description:  eventReq.Description,
+		Location:     eventReq.Location,
+		VenueID:      eventReq.VenueID,
+		StartTime:    eventReq.StartTime,
+		EndTime:      eventReq.EndTime,
+		Timezone:     eventReq.Timezone,
+		Recurrence:   eventReq.Recurrence,
+		Tags:         eventReq.Tags,
+		ConflictMode: eventReq.ConflictMode,
+		Publish:      eventReq.Publish,
 	}
 }
 
 // RegisterEventResponse defines the structure for the response after registering a new event
 type RegisterEventResponse struct {
-	ID          uuid.UUID         `json:"id"`           // The unique identifier for the event
-	Title       string            `json:"title"`        // Event title
-	Description string            `json:"description"`  // Event description
-	StartTime   time.Time         `json:"start_time"`   // Start time of the event
-	EndTime     time.Time         `json:"end_time"`     // End time of the event
-	Location    string            `json:"location"`     // Location of the event
-	OrganizerID uuid.UUID         `json:"organizer_id"` // ID of the organizer
-	Guests      []types.GuestType `json:"guests"`       // List of guests (can be empty)
-	CreatedAt   time.Time         `json:"created_at"`   // Timestamp when the event was created
-	UpdatedAt   time.Time         `json:"updated_at"`   // Timestamp when the event was last updated
-	IsActive    bool              `json:"is_active"`    // Status of the event (active/inactive)
-	Tags        []string          `json:"tags"`         // Tags associated with the event
+	ID                 uuid.UUID                     `json:"id"`                            // The unique identifier for the event
+	Title              string                        `json:"title"`                         // Event title
+	Slug               string                        `json:"slug,omitempty"`                // Shareable name of the public event page
+	Description        string                        `json:"description"`                   // Event description
+	StartTime          time.Time                     `json:"start_time"`                    // Start time of the event, in the event's timezone
+	EndTime            time.Time                     `json:"end_time"`                      // End time of the event, in the event's timezone
+	Timezone           string                        `json:"timezone"`                      // IANA timezone of the event
+	Recurrence         *types.EventRecurrenceType    `json:"recurrence,omitempty"`          // Recurrence rule, if the event repeats
+	Location           string                        `json:"location"`                      // Location of the event
+	VenueID            *uuid.UUID                    `json:"venue_id"`                      // Venue the event is held at, if any
+	OrganizerID        uuid.UUID                     `json:"organizer_id"`                  // ID of the organizer
+	Guests             []types.GuestType             `json:"guests"`                        // List of guests (can be empty)
+	CreatedAt          time.Time                     `json:"created_at"`                    // Timestamp when the event was created
+	UpdatedAt          time.Time                     `json:"updated_at"`                    // Timestamp when the event was last updated
+	IsActive           bool                          `json:"is_active"`                     // Status of the event (active/inactive)
+	Tags               []string                      `json:"tags"`                          // Tags associated with the event
+	Status             string                        `json:"status"`                        // Lifecycle state: Draft, Published, Postponed, Cancelled or Completed
+	CancellationReason string                        `json:"cancellation_reason,omitempty"` // Why the event was cancelled
+	StatusHistory      []types.EventStatusChangeType `json:"status_history"`                // Lifecycle transitions with actor and time
+	Conflicts          []*EventConflictResponse      `json:"conflicts,omitempty"`           // Clashing events, only set when saved in "warn" conflict mode
 }
 
-// TransformToRegisterEventResponse converts the EventType to RegisterEventResponse
+// TransformToRegisterEventResponse converts the EventType to RegisterEventResponse, rendering times in the event's timezone
 func TransformToRegisterEventResponse(event *types.EventType) *RegisterEventResponse {
 	return &RegisterEventResponse{
-		ID:          event.ID,
-		Title:       event.Title,
-		Description: event.Description,
-		StartTime:   event.StartTime,
-		EndTime:     event.EndTime,
-		Location:    event.Location,
-		OrganizerID: event.OrganizerID,
-		Guests:      event.Guests, // Ensure this is handled properly (not nil)
-		CreatedAt:   event.CreatedAt,
-		UpdatedAt:   event.UpdatedAt,
-		IsActive:    event.IsActive,
-		Tags:        event.Tags,
+		ID:                 event.ID,
+		Title:              event.Title,
+		Slug:               event.Slug,
+		Description:        event.Description,
+		StartTime:          InEventTimezone(event.StartTime, event.Timezone),
+		EndTime:            InEventTimezone(event.EndTime, event.Timezone),
+		Timezone:           event.Timezone,
+		Recurrence:         event.Recurrence,
+		Location:           event.Location,
+		VenueID:            event.VenueID,
+		OrganizerID:        event.OrganizerID,
+		Guests:             event.Guests, // Ensure this is handled properly (not nil)
+		CreatedAt:          event.CreatedAt,
+		UpdatedAt:          event.UpdatedAt,
+		IsActive:           event.IsActive,
+		Tags:               event.Tags,
+		Status:             event.CurrentStatus(),
+		CancellationReason: event.CancellationReason,
+		StatusHistory:      event.StatusHistory,
+	}
+}
+
+// EventStatusChangeRequest defines the structure for moving an event to another lifecycle state
+type EventStatusChangeRequest struct {
+	Status                string `json:"status" form:"status" binding:"required"`              // Target state: Published, Postponed, Cancelled or Completed
+	Reason                string `json:"reason" form:"reason"`                                 // Required when cancelling, optional otherwise
+	SuppressNotifications bool   `json:"suppress_notifications" form:"suppress_notifications"` // Skip the guest emails
+}
+
+// EventStatusChangeDTO is the internal representation of a lifecycle change
+type EventStatusChangeDTO struct {
+	Status                string
+	Reason                string
+	SuppressNotifications bool
+}
+
+// TransformToEventStatusChangeDTO converts the incoming request to an EventStatusChangeDTO for internal use
+func TransformToEventStatusChangeDTO(req EventStatusChangeRequest) *EventStatusChangeDTO {
+	return &EventStatusChangeDTO{
+		Status:                req.Status,
+		Reason:                strings.TrimSpace(req.Reason),
+		SuppressNotifications: req.SuppressNotifications,
+	}
+}
+
+// ChangeEventSlugRequest defines the structure for choosing the shareable name of an event's public page
+type ChangeEventSlugRequest struct {
+	Slug string `json:"slug" binding:"required"` // Lower-case letters, digits and single dashes, e.g. "summer-party-2025"
+}
+
+// NormalizeEventSlug trims and lower-cases a slug chosen by an organizer
+func NormalizeEventSlug(slug string) string {
+	return strings.ToLower(strings.TrimSpace(slug))
+}
+
+// NewEventSlug derives a shareable slug from an event title, adding a random suffix so events with the same
+// title do not clash and slugs cannot be guessed from the title alone
+func NewEventSlug(title string) string {
+	var builder strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9'):
+			if dash && builder.Len() > 0 {
+				builder.WriteByte('-')
+			}
+			builder.WriteRune(r)
+			dash = false
+		default:
+			dash = true
+		}
+		if builder.Len() >= 60 {
+			break
+		}
+	}
+
+	random := uuid.New() // Version 4, so its bytes come from crypto/rand
+	encoded := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(random[:4]))
+	if builder.Len() == 0 {
+		return "event-" + encoded
+	}
+	return builder.String() + "-" + encoded
+}
+
+// NearbyEventResponse pairs an event with its venue and the distance from the search point
+type NearbyEventResponse struct {
+	Event      *RegisterEventResponse `json:"event"`       // The event itself
+	Venue      *VenueResponse         `json:"venue"`       // Venue the event is held at
+	DistanceKm float64                `json:"distance_km"` // Distance from the search point in kilometers
+}
+
+// UpcomingEventResponse pairs an event with its next occurrence
+type UpcomingEventResponse struct {
+	Event          *RegisterEventResponse `json:"event"`           // The event itself
+	NextOccurrence EventOccurrence        `json:"next_occurrence"` // Next occurrence, in the event's timezone
+}
+
+// OccurrencesQuery defines the query parameters for listing the occurrences of an event
+type OccurrencesQuery struct {
+	From  time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"` // Start of the window, defaults to now
+	To    time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`   // End of the window, defaults to 90 days after From
+	Limit int       `form:"limit"`                                        // Maximum number of occurrences, defaults to 100
+}
+
+// OccurrenceInEventTimezone renders an occurrence in the event's timezone
+func OccurrenceInEventTimezone(occurrence EventOccurrence, timezone string) EventOccurrence {
+	return EventOccurrence{
+		StartTime: InEventTimezone(occurrence.StartTime, timezone),
+		EndTime:   InEventTimezone(occurrence.EndTime, timezone),
 	}
 }
@@ -0,0 +1,29 @@
+package utils
+
+import "math"
+
+// EarthRadiusMeters is the mean Earth radius used for great-circle calculations
+const EarthRadiusMeters = 6371008.8
+
+// HaversineDistance returns the great-circle distance in meters between two coordinates.
+func HaversineDistance(lat1, lng1, lat2, lng2 float64) float64 {
+	toRadians := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRadians(lat2 - lat1)
+	dLng := toRadians(lng2 - lng1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * EarthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// IsWithinBoundingBox reports whether a coordinate lies inside the given box (edges inclusive).
+func IsWithinBoundingBox(lat, lng, minLat, minLng, maxLat, maxLng float64) bool {
+	return lat >= minLat && lat <= maxLat && lng >= minLng && lng <= maxLng
+}
+
+// KilometersToMeters converts a radius given in kilometers to meters.
+func KilometersToMeters(km float64) float64 {
+	return km * 1000
+}
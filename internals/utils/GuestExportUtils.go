@@ -0,0 +1,300 @@
+package utils
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/xuri/excelize/v2"
+)
+
+// Guest export formats
+const (
+	GuestExportFormatCSV  = "csv"
+	GuestExportFormatXLSX = "xlsx"
+	GuestExportFormatPDF  = "pdf" // Printable sign-in sheet
+)
+
+// GuestExportTimeLayout renders invitation and check-in times in export files, in the event's timezone
+const GuestExportTimeLayout = "2006-01-02 15:04"
+
+// csvFlushEvery is how many CSV rows are buffered before they are flushed to the client
+const csvFlushEvery = 500
+
+// guestExportColumns are the fixed columns of CSV and XLSX exports; GuestExportColumns follow them
+var guestExportColumns = []string{"Name", "Email", "RSVP Status", "Invited At", "Checked In At"}
+
+// GuestExportColumn is an extra column of CSV and XLSX exports, holding a registration form answer or a custom field
+type GuestExportColumn struct {
+	Title             string // Column header, and the custom field the column shows
+	RegistrationField string // Key of the form field the column shows; empty for custom field columns
+}
+
+// value renders the column for a guest. Multi-select answers are joined with commas, and a guest without an
+// answer falls back to a custom field of the same name, as imported guest lists carry.
+func (c GuestExportColumn) value(guest *types.GuestType) string {
+	if c.RegistrationField != "" {
+		if answer := guest.RegistrationAnswers[c.RegistrationField]; len(answer) > 0 {
+			return strings.Join(answer, ", ")
+		}
+	}
+	return guest.CustomFields[c.Title]
+}
+
+// guestExportHeader lists the fixed column headers followed by those of the extra columns
+func guestExportHeader(columns []GuestExportColumn) []string {
+	header := append([]string{}, guestExportColumns...)
+	for _, column := range columns {
+		header = append(header, column.Title)
+	}
+	return header
+}
+
+// GuestExportQuery defines the query parameters of the guest export endpoint
+type GuestExportQuery struct {
+	Format    string `form:"format"`     // csv (default), xlsx or pdf
+	Status    string `form:"status"`     // Only export guests with this RSVP status
+	CheckedIn *bool  `form:"checked_in"` // Only export guests who did (true) or did not (false) check in
+}
+
+// GuestExportDTO is the internal representation of a guest export request
+type GuestExportDTO struct {
+	Format     string
+	RSVPStatus string
+	CheckedIn  *bool
+}
+
+// TransformToGuestExportDTO converts the incoming query to a GuestExportDTO for internal use, defaulting to CSV
+func TransformToGuestExportDTO(query GuestExportQuery) *GuestExportDTO {
+	format := strings.ToLower(strings.TrimSpace(query.Format))
+	if format == "" {
+		format = GuestExportFormatCSV
+	}
+	return &GuestExportDTO{
+		Format:     format,
+		RSVPStatus: query.Status,
+		CheckedIn:  query.CheckedIn,
+	}
+}
+
+// GuestExportContentType returns the MIME type of an export format
+func GuestExportContentType(format string) string {
+	switch format {
+	case GuestExportFormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case GuestExportFormatPDF:
+		return "application/pdf"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+// GuestExportWriter writes a guest list one guest at a time, so exports never hold the whole list in memory
+type GuestExportWriter interface {
+	WriteGuest(guest *types.GuestType) error
+	// Close finishes the document and writes whatever is still buffered
+	Close() error
+}
+
+// NewGuestExportWriter creates the writer for a format. Form answers and custom fields become extra columns of
+// CSV and XLSX exports; the PDF sign-in sheet leaves them out to keep room for signatures.
+func NewGuestExportWriter(format string, w io.Writer, event *types.EventType, columns []GuestExportColumn) (GuestExportWriter, error) {
+	switch format {
+	case GuestExportFormatCSV:
+		return newCSVGuestExportWriter(w, event, columns)
+	case GuestExportFormatXLSX:
+		return newXLSXGuestExportWriter(w, event, columns)
+	case GuestExportFormatPDF:
+		return newPDFGuestExportWriter(w, event), nil
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+// guestExportRow renders the fixed and extra columns of a guest
+func guestExportRow(guest *types.GuestType, event *types.EventType, columns []GuestExportColumn) []string {
+	row := []string{
+		guest.FullName,
+		guest.Email,
+		guest.RSVPStatus,
+		formatGuestExportTime(&guest.InvitedAt, event.Timezone),
+		formatGuestExportTime(guest.CheckedInAt, event.Timezone),
+	}
+	for _, column := range columns {
+		row = append(row, column.value(guest))
+	}
+	return row
+}
+
+func formatGuestExportTime(t *time.Time, timezone string) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return InEventTimezone(*t, timezone).Format(GuestExportTimeLayout)
+}
+
+type csvGuestExportWriter struct {
+	writer  *csv.Writer
+	event   *types.EventType
+	columns []GuestExportColumn
+	rows    int
+}
+
+func newCSVGuestExportWriter(w io.Writer, event *types.EventType, columns []GuestExportColumn) (*csvGuestExportWriter, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(guestExportHeader(columns)); err != nil {
+		return nil, err
+	}
+	return &csvGuestExportWriter{writer: writer, event: event, columns: columns}, nil
+}
+
+func (e *csvGuestExportWriter) WriteGuest(guest *types.GuestType) error {
+	if err := e.writer.Write(guestExportRow(guest, e.event, e.columns)); err != nil {
+		return err
+	}
+	e.rows++
+	if e.rows%csvFlushEvery == 0 {
+		e.writer.Flush()
+		return e.writer.Error()
+	}
+	return nil
+}
+
+func (e *csvGuestExportWriter) Close() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+// xlsxGuestExportWriter uses the excelize stream writer, which spills rows to a temporary file instead of memory
+type xlsxGuestExportWriter struct {
+	w       io.Writer
+	file    *excelize.File
+	stream  *excelize.StreamWriter
+	event   *types.EventType
+	columns []GuestExportColumn
+	row     int
+}
+
+func newXLSXGuestExportWriter(w io.Writer, event *types.EventType, columns []GuestExportColumn) (*xlsxGuestExportWriter, error) {
+	file := excelize.NewFile()
+	stream, err := file.NewStreamWriter("Sheet1")
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	boldStyle, err := file.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	var header []interface{}
+	for _, title := range guestExportHeader(columns) {
+		header = append(header, excelize.Cell{StyleID: boldStyle, Value: title})
+	}
+	if err := stream.SetRow("A1", header, excelize.RowOpts{}); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &xlsxGuestExportWriter{w: w, file: file, stream: stream, event: event, columns: columns, row: 1}, nil
+}
+
+func (e *xlsxGuestExportWriter) WriteGuest(guest *types.GuestType) error {
+	e.row++
+	cell, err := excelize.CoordinatesToCellName(1, e.row)
+	if err != nil {
+		return err
+	}
+
+	values := guestExportRow(guest, e.event, e.columns)
+	row := make([]interface{}, len(values))
+	for i, value := range values {
+		row[i] = value
+	}
+	return e.stream.SetRow(cell, row)
+}
+
+func (e *xlsxGuestExportWriter) Close() error {
+	defer e.file.Close()
+	if err := e.stream.Flush(); err != nil {
+		return err
+	}
+	return e.file.Write(e.w)
+}
+
+// Column widths of the PDF sign-in sheet in millimetres, for number, name, email, RSVP and signature
+var signInSheetWidths = []float64{10, 50, 60, 22, 48}
+
+// pdfGuestExportWriter lays out a printable sign-in sheet with a signature box per guest
+type pdfGuestExportWriter struct {
+	w         io.Writer
+	pdf       *fpdf.Fpdf
+	translate func(string) string
+	row       int
+}
+
+func newPDFGuestExportWriter(w io.Writer, event *types.EventType) *pdfGuestExportWriter {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(15, 15, 15)
+	pdf.SetAutoPageBreak(true, 15)
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+
+	// Repeat the event details and the table header on every page
+	pdf.SetHeaderFunc(func() {
+		pdf.SetFont("Helvetica", "B", 14)
+		pdf.CellFormat(0, 8, translate(event.Title), "", 1, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "", 10)
+		details := FormatEventTime(event.StartTime, event.Timezone)
+		if event.Location != "" {
+			details += " - " + event.Location
+		}
+		pdf.CellFormat(0, 6, translate(details), "", 1, "L", false, 0, "")
+		pdf.Ln(3)
+
+		pdf.SetFont("Helvetica", "B", 10)
+		pdf.SetFillColor(230, 230, 230)
+		for i, title := range []string{"#", "Name", "Email", "RSVP", "Signature"} {
+			pdf.CellFormat(signInSheetWidths[i], 8, title, "1", 0, "L", true, 0, "")
+		}
+		pdf.Ln(-1)
+		pdf.SetFont("Helvetica", "", 10)
+	})
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-12)
+		pdf.SetFont("Helvetica", "I", 8)
+		pdf.CellFormat(0, 6, fmt.Sprintf("Page %d", pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+	pdf.AddPage()
+
+	return &pdfGuestExportWriter{w: w, pdf: pdf, translate: translate}
+}
+
+func (e *pdfGuestExportWriter) WriteGuest(guest *types.GuestType) error {
+	e.row++
+	cells := []string{strconv.Itoa(e.row), guest.FullName, guest.Email, guest.RSVPStatus, ""}
+	for i, value := range cells {
+		e.pdf.CellFormat(signInSheetWidths[i], 10, e.fit(value, signInSheetWidths[i]), "1", 0, "L", false, 0, "")
+	}
+	e.pdf.Ln(-1)
+	return e.pdf.Error()
+}
+
+// fit shortens a value with an ellipsis until it fits its column
+func (e *pdfGuestExportWriter) fit(value string, width float64) string {
+	value = e.translate(value)
+	for len(value) > 4 && e.pdf.GetStringWidth(value) > width-2 {
+		value = strings.TrimSuffix(value, "...")
+		value = value[:len(value)-1] + "..."
+	}
+	return value
+}
+
+func (e *pdfGuestExportWriter) Close() error {
+	return e.pdf.Output(e.w)
+}
@@ -0,0 +1,213 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/xuri/excelize/v2"
+)
+
+// Guest list file formats accepted by the import endpoint, by file extension
+const (
+	GuestImportFormatCSV  = ".csv"
+	GuestImportFormatXLSX = ".xlsx"
+)
+
+// Limits of a single guest list import
+const (
+	MaxGuestImportFileSize = 5 << 20 // 5 MiB
+	MaxGuestImportRows     = 10000
+)
+
+// Outcomes of a single guest list row
+const (
+	GuestImportRowImported  = "imported"  // The guest was added
+	GuestImportRowValid     = "valid"     // Dry run: the guest would be added
+	GuestImportRowDuplicate = "duplicate" // Already on the guest list or repeated in the file
+	GuestImportRowInvalid   = "invalid"   // The row failed validation
+	GuestImportRowFailed    = "failed"    // The row was valid but could not be saved
+)
+
+// Header names recognised as the email and name columns when the request does not name them, compared case-insensitively
+var (
+	guestImportEmailHeaders = []string{"email", "e-mail", "email address", "e-mail address", "mail"}
+	guestImportNameHeaders  = []string{"name", "full name", "full_name", "fullname", "guest name"}
+)
+
+// ImportGuestsRequest defines the multipart form fields sent along with a guest list file
+type ImportGuestsRequest struct {
+	DryRun      bool   `form:"dry_run"`      // Preview the report without adding any guest
+	EmailColumn string `form:"email_column"` // Header of the email column, detected when empty
+	NameColumn  string `form:"name_column"`  // Header of the name column, detected when empty
+}
+
+// ImportGuestsDTO is the internal representation of a guest list import
+type ImportGuestsDTO struct {
+	Rows        [][]string // Every row of the file, header first
+	DryRun      bool
+	EmailColumn string
+	NameColumn  string
+}
+
+// TransformToImportGuestsDTO converts the incoming form and the parsed file rows to an ImportGuestsDTO for internal use
+func TransformToImportGuestsDTO(req ImportGuestsRequest, rows [][]string) *ImportGuestsDTO {
+	return &ImportGuestsDTO{
+		Rows:        rows,
+		DryRun:      req.DryRun,
+		EmailColumn: strings.TrimSpace(req.EmailColumn),
+		NameColumn:  strings.TrimSpace(req.NameColumn),
+	}
+}
+
+// GuestImportRowResult reports what happened to one row of a guest list
+type GuestImportRowResult struct {
+	Row      int        `json:"row"` // Row number in the file, the header being row 1
+	Email    string     `json:"email"`
+	FullName string     `json:"full_name"`
+	Status   string     `json:"status"`
+	Error    string     `json:"error,omitempty"`
+	GuestID  *uuid.UUID `json:"guest_id,omitempty"`
+}
+
+// GuestImportReport summarises a guest list import row by row
+type GuestImportReport struct {
+	EventID      uuid.UUID               `json:"event_id"`
+	DryRun       bool                    `json:"dry_run"`
+	EmailColumn  string                  `json:"email_column"`  // Header used for emails
+	NameColumn   string                  `json:"name_column"`   // Header used for names, empty when there is none
+	CustomFields []string                `json:"custom_fields"` // Headers stored as custom fields
+	TotalRows    int                     `json:"total_rows"`
+	Imported     int                     `json:"imported"`
+	Valid        int                     `json:"valid"`
+	Duplicates   int                     `json:"duplicates"`
+	Invalid      int                     `json:"invalid"`
+	Failed       int                     `json:"failed"`
+	Rows         []*GuestImportRowResult `json:"rows"`
+}
+
+// GuestImportColumns locates the columns of a guest list by index
+type GuestImportColumns struct {
+	Email        int
+	Name         int            // -1 when the file has no name column
+	CustomFields map[int]string // Column index to header of every other non-empty header
+}
+
+// ReadGuestSpreadsheet reads every row of a CSV or XLSX guest list; only the first sheet of a workbook is read.
+// CSV files may start with a UTF-8 byte order mark and use commas, semicolons or tabs as separators.
+func ReadGuestSpreadsheet(fileName string, reader io.Reader) ([][]string, error) {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case GuestImportFormatCSV:
+		return readGuestCSV(reader)
+	case GuestImportFormatXLSX:
+		return readGuestXLSX(reader)
+	default:
+		return nil, errors.New("guest list must be a .csv or .xlsx file")
+	}
+}
+
+func readGuestCSV(reader io.Reader) ([][]string, error) {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+
+	csvReader := csv.NewReader(bytes.NewReader(content))
+	csvReader.Comma = detectCSVSeparator(content)
+	csvReader.FieldsPerRecord = -1
+	csvReader.LazyQuotes = true
+
+	rows, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV: %w", err)
+	}
+	return rows, nil
+}
+
+// detectCSVSeparator picks the most frequent of comma, semicolon and tab in the header line
+func detectCSVSeparator(content []byte) rune {
+	header := content
+	if end := bytes.IndexByte(content, '\n'); end >= 0 {
+		header = content[:end]
+	}
+
+	separator, best := ',', 0
+	for _, candidate := range []rune{',', ';', '\t'} {
+		if count := bytes.Count(header, []byte(string(candidate))); count > best {
+			separator, best = candidate, count
+		}
+	}
+	return separator
+}
+
+func readGuestXLSX(reader io.Reader) ([][]string, error) {
+	workbook, err := excelize.OpenReader(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read XLSX: %w", err)
+	}
+	defer workbook.Close()
+
+	sheets := workbook.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, errors.New("workbook has no sheets")
+	}
+	return workbook.GetRows(sheets[0])
+}
+
+// MapGuestImportColumns finds the email, name and custom field columns in the header row. Explicit column names
+// win over the recognised header names; every other non-empty header becomes a custom field.
+func MapGuestImportColumns(header []string, emailColumn, nameColumn string) (*GuestImportColumns, error) {
+	columns := &GuestImportColumns{Email: -1, Name: -1, CustomFields: map[int]string{}}
+
+	columns.Email = findGuestImportColumn(header, emailColumn, guestImportEmailHeaders)
+	if columns.Email < 0 {
+		if emailColumn != "" {
+			return nil, fmt.Errorf("email column %q not found in header", emailColumn)
+		}
+		return nil, errors.New("no email column found; name it \"email\" or set email_column")
+	}
+
+	columns.Name = findGuestImportColumn(header, nameColumn, guestImportNameHeaders)
+	if columns.Name < 0 && nameColumn != "" {
+		return nil, fmt.Errorf("name column %q not found in header", nameColumn)
+	}
+
+	for index, title := range header {
+		title = strings.TrimSpace(title)
+		if title != "" && index != columns.Email && index != columns.Name {
+			columns.CustomFields[index] = title
+		}
+	}
+	return columns, nil
+}
+
+// findGuestImportColumn returns the index of the explicit column, or else of the first recognised header, or -1
+func findGuestImportColumn(header []string, explicit string, recognised []string) int {
+	candidates := recognised
+	if explicit != "" {
+		candidates = []string{explicit}
+	}
+
+	for _, candidate := range candidates {
+		for index, title := range header {
+			if strings.EqualFold(strings.TrimSpace(title), candidate) {
+				return index
+			}
+		}
+	}
+	return -1
+}
+
+// GuestImportCell returns the trimmed cell at index, or "" when the row is shorter
+func GuestImportCell(row []string, index int) string {
+	if index < 0 || index >= len(row) {
+		return ""
+	}
+	return strings.TrimSpace(row[index])
+}
@@ -0,0 +1,19 @@
+package utils
+
+// UpdateRSVPRequest defines the structure for recording a guest's RSVP answer
+type UpdateRSVPRequest struct {
+	Status string `json:"status" binding:"required"` // Pending, Accepted, Tentative or Declined
+	// Answers to the event's registration form keyed by field key; when sent they replace the guest's earlier answers
+	Answers map[string]interface{} `json:"answers"`
+}
+
+// UpdateRSVPDTO is the internal representation of an RSVP update
+type UpdateRSVPDTO struct {
+	Status  string
+	Answers map[string]interface{} // Nil keeps the answers the guest already gave
+}
+
+// TransformToUpdateRSVPDTO converts the incoming request to an UpdateRSVPDTO for internal use
+func TransformToUpdateRSVPDTO(req UpdateRSVPRequest) *UpdateRSVPDTO {
+	return &UpdateRSVPDTO{Status: req.Status, Answers: req.Answers}
+}
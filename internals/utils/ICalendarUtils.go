@@ -0,0 +1,110 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// iCalendar METHOD values used by the application
+const (
+	ICSMethodPublish = "PUBLISH"
+	ICSMethodRequest = "REQUEST"
+	ICSMethodCancel  = "CANCEL"
+)
+
+const (
+	icsUTCLayout   = "20060102T150405Z"
+	icsLocalLayout = "20060102T150405"
+)
+
+// GenerateEventICS renders an event as an iCalendar (RFC 5545) document.
+// Start and end are written as local times with an IANA TZID so calendar clients show them in the event's timezone;
+// the event's Sequence is written as SEQUENCE so clients replace invitations they already imported.
+func GenerateEventICS(event *types.EventType, method string) string {
+	var lines []string
+	add := func(line string) { lines = append(lines, foldICSLine(line)) }
+
+	timezone := event.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	add("BEGIN:VCALENDAR")
+	add("VERSION:2.0")
+	add("PRODID:-//EventureGo//Events//EN")
+	add("CALSCALE:GREGORIAN")
+	add("METHOD:" + method)
+	add("BEGIN:VEVENT")
+	add(fmt.Sprintf("UID:%s@eventurego", event.ID))
+	add("DTSTAMP:" + time.Now().UTC().Format(icsUTCLayout))
+	add(fmt.Sprintf("SEQUENCE:%d", event.Sequence))
+	add(fmt.Sprintf("DTSTART;TZID=%s:%s", timezone, InEventTimezone(event.StartTime, timezone).Format(icsLocalLayout)))
+	add(fmt.Sprintf("DTEND;TZID=%s:%s", timezone, InEventTimezone(event.EndTime, timezone).Format(icsLocalLayout)))
+	if rrule := formatRRule(event.Recurrence); rrule != "" {
+		add("RRULE:" + rrule)
+	}
+	add("SUMMARY:" + escapeICSText(event.Title))
+	if event.Description != "" {
+		add("DESCRIPTION:" + escapeICSText(event.Description))
+	}
+	if event.Location != "" {
+		add("LOCATION:" + escapeICSText(event.Location))
+	}
+	if method == ICSMethodCancel || event.CurrentStatus() == types.EventStatusCancelled {
+		add("STATUS:CANCELLED")
+	} else {
+		add("STATUS:CONFIRMED")
+	}
+	add("END:VEVENT")
+	add("END:VCALENDAR")
+
+	return strings.Join(lines, "\r\n") + "\r\n"
+}
+
+// formatRRule converts an EventRecurrenceType into an RRULE value
+func formatRRule(rule *types.EventRecurrenceType) string {
+	if rule == nil {
+		return ""
+	}
+
+	parts := []string{"FREQ=" + rule.Frequency}
+	if rule.Interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", rule.Interval))
+	}
+	if rule.Count > 0 {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", rule.Count))
+	} else if rule.Until != nil {
+		parts = append(parts, "UNTIL="+rule.Until.UTC().Format(icsUTCLayout))
+	}
+	return strings.Join(parts, ";")
+}
+
+// escapeICSText escapes characters that have a special meaning in iCalendar TEXT values
+func escapeICSText(text string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+	return replacer.Replace(text)
+}
+
+// foldICSLine splits content lines longer than 75 octets as required by RFC 5545
+func foldICSLine(line string) string {
+	const limit = 75
+	if len(line) <= limit {
+		return line
+	}
+
+	var folded strings.Builder
+	current := 0
+	for _, r := range line {
+		size := len(string(r))
+		if current+size > limit {
+			folded.WriteString("\r\n ")
+			current = 1
+		}
+		folded.WriteRune(r)
+		current += size
+	}
+	return folded.String()
+}
@@ -0,0 +1,13 @@
+package utils
+
+// Bounds of the limit query parameter of the job runs endpoint
+const (
+	DefaultJobRunsLimit = 50
+	MaxJobRunsLimit     = 500
+)
+
+// JobRunsQuery defines the query parameters for listing job runs
+type JobRunsQuery struct {
+	Job   string `form:"job"`   // Only list the runs of the job with this name
+	Limit int    `form:"limit"` // Number of runs to return, newest first
+}
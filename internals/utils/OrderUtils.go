@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// OrderAttendeeRequest is a person a new order buys a ticket for
+type OrderAttendeeRequest struct {
+	FullName string `json:"full_name" binding:"required,max=100"`
+	Email    string `json:"email" binding:"required"`
+}
+
+// CreateOrderRequest defines the structure for ordering tickets of one tier, one per attendee
+type CreateOrderRequest struct {
+	TicketTierID uuid.UUID              `json:"ticket_tier_id" binding:"required"`
+	Attendees    []OrderAttendeeRequest `json:"attendees" binding:"required,min=1,dive"`
+	PromoCode    string                 `json:"promo_code" binding:"max=32"` // Optional discount code of the event
+}
+
+// RefundOrderRequest defines the structure for refunding a confirmed order
+type RefundOrderRequest struct {
+	Reason string `json:"reason" binding:"max=500"` // Optional note kept on the order
+}
+
+// CreateOrderDTO is the internal representation of a new order
+type CreateOrderDTO struct {
+	TicketTierID uuid.UUID
+	Attendees    []types.OrderAttendeeType
+	PromoCode    string
+}
+
+// TransformToCreateOrderDTO converts the incoming request to a CreateOrderDTO for internal use
+func TransformToCreateOrderDTO(orderReq CreateOrderRequest) *CreateOrderDTO {
+	attendees := make([]types.OrderAttendeeType, 0, len(orderReq.Attendees))
+	for _, attendee := range orderReq.Attendees {
+		attendees = append(attendees, types.OrderAttendeeType{
+			FullName: strings.TrimSpace(attendee.FullName),
+			Email:    strings.ToLower(strings.TrimSpace(attendee.Email)),
+		})
+	}
+	return &CreateOrderDTO{
+		TicketTierID: orderReq.TicketTierID,
+		Attendees:    attendees,
+		PromoCode:    NormalizePromoCode(orderReq.PromoCode),
+	}
+}
+
+// OrderResponse defines the structure returned to clients for an order
+type OrderResponse struct {
+	ID             uuid.UUID                 `json:"id"`
+	EventID        uuid.UUID                 `json:"event_id"`
+	TicketTierID   uuid.UUID                 `json:"ticket_tier_id"`
+	BuyerID        uuid.UUID                 `json:"buyer_id"`
+	Attendees      []types.OrderAttendeeType `json:"attendees"`
+	Quantity       int                       `json:"quantity"`
+	UnitPriceCents int64                     `json:"unit_price_cents"`
+	DiscountCents  int64                     `json:"discount_cents,omitempty"`
+	TotalCents     int64                     `json:"total_cents"`
+	Currency       string                    `json:"currency"`
+	PromoCode      string                    `json:"promo_code,omitempty"`
+	Status         string                    `json:"status"`
+	CheckoutURL    string                    `json:"checkout_url,omitempty"`
+	ExpiresAt      *time.Time                `json:"expires_at,omitempty"` // Only set while the order is pending
+	ConfirmedAt    *time.Time                `json:"confirmed_at,omitempty"`
+	ClosedAt       *time.Time                `json:"closed_at,omitempty"`
+	CloseReason    string                    `json:"close_reason,omitempty"`
+	CreatedAt      time.Time                 `json:"created_at"`
+}
+
+// TransformToOrderResponse converts the OrderType to OrderResponse
+func TransformToOrderResponse(order *types.OrderType) *OrderResponse {
+	response := &OrderResponse{
+		ID:             order.ID,
+		EventID:        order.EventID,
+		TicketTierID:   order.TicketTierID,
+		BuyerID:        order.BuyerID,
+		Attendees:      order.Attendees,
+		Quantity:       order.Quantity,
+		UnitPriceCents: order.UnitPriceCents,
+		DiscountCents:  order.DiscountCents,
+		TotalCents:     order.TotalCents,
+		Currency:       order.Currency,
+		PromoCode:      order.PromoCode,
+		Status:         order.Status,
+		ConfirmedAt:    order.ConfirmedAt,
+		ClosedAt:       order.ClosedAt,
+		CloseReason:    order.CloseReason,
+		CreatedAt:      order.CreatedAt,
+	}
+	if order.Status == types.OrderStatusPending {
+		response.CheckoutURL = order.CheckoutURL
+		response.ExpiresAt = &order.ExpiresAt
+	}
+	return response
+}
+
+// TransformToOrderResponses converts a list of orders to responses
+func TransformToOrderResponses(orders []*types.OrderType) []*OrderResponse {
+	responses := make([]*OrderResponse, 0, len(orders))
+	for _, order := range orders {
+		responses = append(responses, TransformToOrderResponse(order))
+	}
+	return responses
+}
@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// ActiveOrganizationCookieName is the cookie holding the organization a user is working in; without it
+// the user works on their personal events
+func ActiveOrganizationCookieName() string {
+	return "active_organization|_|" + configs.TokenBaseCookieName
+}
+
+// CreateOrganizationRequest defines the structure for creating an organization
+type CreateOrganizationRequest struct {
+	Name string `json:"name" binding:"required,max=100"`
+}
+
+// InviteOrganizationMemberRequest defines the structure for inviting someone to an organization by email
+type InviteOrganizationMemberRequest struct {
+	Email string `json:"email" binding:"required,email"`
+	Role  string `json:"role" binding:"required"` // Owner, Admin, Editor or Viewer
+}
+
+// ChangeOrganizationMemberRoleRequest defines the structure for changing the role of a member
+type ChangeOrganizationMemberRoleRequest struct {
+	Role string `json:"role" binding:"required"`
+}
+
+// AcceptOrganizationInvitationQuery defines the query parameters of the link in an invitation email
+type AcceptOrganizationInvitationQuery struct {
+	Token string `form:"token" binding:"required"`
+}
+
+// SwitchActiveOrganizationRequest defines the structure for choosing the organization to work in; a null
+// organization_id switches back to personal events
+type SwitchActiveOrganizationRequest struct {
+	OrganizationID *uuid.UUID `json:"organization_id"`
+}
+
+// OrganizationDTO is the internal representation of a new organization
+type OrganizationDTO struct {
+	Name string
+}
+
+// OrganizationInvitationDTO is the internal representation of a new invitation
+type OrganizationInvitationDTO struct {
+	Email string
+	Role  string
+}
+
+// TransformToOrganizationDTO converts the incoming request to an OrganizationDTO for internal use
+func TransformToOrganizationDTO(organizationReq CreateOrganizationRequest) *OrganizationDTO {
+	return &OrganizationDTO{
+		Name: strings.TrimSpace(organizationReq.Name),
+	}
+}
+
+// TransformToOrganizationInvitationDTO converts the incoming request to an OrganizationInvitationDTO, lower-casing the email
+func TransformToOrganizationInvitationDTO(invitationReq InviteOrganizationMemberRequest) *OrganizationInvitationDTO {
+	return &OrganizationInvitationDTO{
+		Email: strings.ToLower(strings.TrimSpace(invitationReq.Email)),
+		Role:  invitationReq.Role,
+	}
+}
+
+// OrganizationResponse defines the structure returned to clients for an organization they are a member of
+type OrganizationResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Role      string    `json:"role"` // Role of the requesting user
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// OrganizationMemberResponse defines the structure returned to clients for a member of an organization
+type OrganizationMemberResponse struct {
+	SuperUserID uuid.UUID `json:"super_user_id"`
+	Username    string    `json:"username,omitempty"`
+	FullName    string    `json:"full_name,omitempty"`
+	Email       string    `json:"email,omitempty"`
+	Role        string    `json:"role"`
+	JoinedAt    time.Time `json:"joined_at"`
+}
+
+// OrganizationDetailsResponse is an organization together with its members
+type OrganizationDetailsResponse struct {
+	*OrganizationResponse
+	Members []*OrganizationMemberResponse `json:"members"`
+}
+
+// OrganizationInvitationResponse defines the structure returned to clients for a pending invitation
+type OrganizationInvitationResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Email       string    `json:"email"`
+	Role        string    `json:"role"`
+	InvitedByID uuid.UUID `json:"invited_by_id"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TransformToOrganizationResponse converts the OrganizationType to OrganizationResponse for a member with the given role
+func TransformToOrganizationResponse(organization *types.OrganizationType, role string) *OrganizationResponse {
+	return &OrganizationResponse{
+		ID:        organization.ID,
+		Name:      organization.Name,
+		Role:      role,
+		CreatedAt: organization.CreatedAt,
+	}
+}
+
+// TransformToOrganizationMemberResponse converts a membership to OrganizationMemberResponse; superUser may be nil
+// when the account is gone
+func TransformToOrganizationMemberResponse(member *types.OrganizationMemberType, superUser *types.SuperUserType) *OrganizationMemberResponse {
+	response := &OrganizationMemberResponse{
+		SuperUserID: member.SuperUserID,
+		Role:        member.Role,
+		JoinedAt:    member.CreatedAt,
+	}
+	if superUser != nil {
+		response.Username = superUser.Username
+		response.FullName = superUser.FullName
+		response.Email = superUser.Email
+	}
+	return response
+}
+
+// TransformToOrganizationInvitationResponse converts the OrganizationInvitationType to OrganizationInvitationResponse
+func TransformToOrganizationInvitationResponse(invitation *types.OrganizationInvitationType) *OrganizationInvitationResponse {
+	return &OrganizationInvitationResponse{
+		ID:          invitation.ID,
+		Email:       invitation.Email,
+		Role:        invitation.Role,
+		InvitedByID: invitation.InvitedByID,
+		ExpiresAt:   invitation.ExpiresAt,
+		CreatedAt:   invitation.CreatedAt,
+	}
+}
+
+// TransformToOrganizationInvitationResponses converts a list of invitations to responses
+func TransformToOrganizationInvitationResponses(invitations []*types.OrganizationInvitationType) []*OrganizationInvitationResponse {
+	responses := make([]*OrganizationInvitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		responses = append(responses, TransformToOrganizationInvitationResponse(invitation))
+	}
+	return responses
+}
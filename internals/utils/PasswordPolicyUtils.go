@@ -0,0 +1,110 @@
+package utils
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/lordofthemind/EventureGo/configs"
+)
+
+// MinPasswordLength is the shortest password any policy allows
+const MinPasswordLength = 8
+
+// MaxPasswordBytes is the longest password bcrypt can hash
+const MaxPasswordBytes = 72
+
+// CheckPasswordRequirements checks the length and character classes the password policy asks for, naming
+// every requirement the password misses
+func CheckPasswordRequirements(password string) error {
+	minLength := max(configs.PasswordMinLength, MinPasswordLength)
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case !unicode.IsLetter(r) && !unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+
+	var missing []string
+	if utf8.RuneCountInString(password) < minLength {
+		missing = append(missing, fmt.Sprintf("be at least %d characters long", minLength))
+	}
+	if len(password) > MaxPasswordBytes {
+		missing = append(missing, fmt.Sprintf("be at most %d bytes long", MaxPasswordBytes))
+	}
+	if configs.PasswordRequireUpper && !hasUpper {
+		missing = append(missing, "contain an uppercase letter")
+	}
+	if configs.PasswordRequireLower && !hasLower {
+		missing = append(missing, "contain a lowercase letter")
+	}
+	if configs.PasswordRequireDigit && !hasDigit {
+		missing = append(missing, "contain a digit")
+	}
+	if configs.PasswordRequireSymbol && !hasSymbol {
+		missing = append(missing, "contain a symbol")
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	if len(missing) == 1 {
+		return fmt.Errorf("password must %s", missing[0])
+	}
+	return fmt.Errorf("password must %s and %s", strings.Join(missing[:len(missing)-1], ", "), missing[len(missing)-1])
+}
+
+// IsBreachedPassword looks the password up in the local breached password list. Only the file of the first
+// five hex characters of its SHA-1 is read, the same k-anonymity range the Have I Been Pwned API answers with,
+// so no network is needed. A missing range file means no breached password has that prefix.
+func IsBreachedPassword(password string) (bool, error) {
+	if configs.BreachedPasswordsPath == "" {
+		return false, nil
+	}
+
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:5], hash[5:]
+
+	file, err := os.Open(filepath.Join(configs.BreachedPasswordsPath, prefix+".txt"))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	// Each line is "<suffix>:<count>"; the count does not matter, any breach disqualifies a password
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		candidate, _, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if strings.EqualFold(candidate, suffix) {
+			return true, nil
+		}
+	}
+	return false, scanner.Err()
+}
+
+// PasswordExpiresAt returns when a password changed at changedAt has to be reset, or the zero time when
+// passwords do not expire
+func PasswordExpiresAt(changedAt time.Time) time.Time {
+	if configs.PasswordMaxAge <= 0 {
+		return time.Time{}
+	}
+	return changedAt.Add(configs.PasswordMaxAge)
+}
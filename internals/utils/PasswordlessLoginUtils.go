@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/lordofthemind/EventureGo/configs"
+)
+
+// Login modes a role can be configured with under passwordless_login.login_modes in config.yaml
+const (
+	LoginModePassword     = "password"     // Email or username and password only
+	LoginModePasswordless = "passwordless" // Emailed link or code only
+	LoginModeBoth         = "both"         // Either way
+)
+
+// LoginModeForRole returns the configured login mode of a role; roles without a known mode log in with a password
+func LoginModeForRole(role string) string {
+	// Configuration keys are lower-cased when the configuration is loaded
+	switch mode := configs.LoginModes[strings.ToLower(role)]; mode {
+	case LoginModePasswordless, LoginModeBoth:
+		return mode
+	default:
+		return LoginModePassword
+	}
+}
+
+// AllowsPasswordLogin reports whether users with the role may log in with their password
+func AllowsPasswordLogin(role string) bool {
+	return LoginModeForRole(role) != LoginModePasswordless
+}
+
+// AllowsPasswordlessLogin reports whether users with the role may log in with an emailed link or code
+func AllowsPasswordlessLogin(role string) bool {
+	return LoginModeForRole(role) != LoginModePassword
+}
+
+// PasswordlessNonceCookieName is the cookie binding a passwordless login to the browser that requested it
+func PasswordlessNonceCookieName() string {
+	return "passwordless_nonce|_|" + configs.TokenBaseCookieName
+}
+
+// PasswordlessLoginPath is where the passwordless login routes live; the nonce cookie is only sent there
+const PasswordlessLoginPath = "/superusers/login/passwordless"
+
+// PasswordlessLoginRequest represents the request structure for emailing a login link and code
+type PasswordlessLoginRequest struct {
+	Email string `json:"email" binding:"required,email" validate:"required,email"` // Email of the superuser logging in
+}
+
+// PasswordlessCodeLoginRequest represents the request structure for logging in with an emailed code
+type PasswordlessCodeLoginRequest struct {
+	Code string `json:"code" binding:"required" validate:"required"` // 6-digit code from the login email
+}
+
+// GenerateLoginCode generates a random 6-digit login code
+func GenerateLoginCode() string {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		panic("failed to generate random login code") // Panic if random bytes cannot be generated
+	}
+	return fmt.Sprintf("%06d", n.Int64())
+}
+
+// HashLoginCode hashes a login code together with the nonce of the browser it was sent to. Six digits alone
+// are quickly guessed from a hash; without the nonce, which is only stored hashed, they are not.
+func HashLoginCode(nonce, code string) string {
+	return HashRegistrationToken(nonce + ":" + code)
+}
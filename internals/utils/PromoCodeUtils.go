@@ -0,0 +1,191 @@
+package utils
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// PromoCodeSettingsRequest holds the discount, limits and restrictions of a promo code
+type PromoCodeSettingsRequest struct {
+	DiscountType          string      `json:"discount_type" binding:"required"` // "percentage" or "fixed"
+	PercentOff            int         `json:"percent_off"`                      // 1 to 100, for percentage discounts
+	AmountOffCents        int64       `json:"amount_off_cents"`                 // Taken off the whole order, for fixed discounts
+	Currency              string      `json:"currency"`                         // Currency of a fixed discount, e.g. "EUR"
+	MaxRedemptions        int         `json:"max_redemptions"`                  // 0 for unlimited
+	MaxRedemptionsPerUser int         `json:"max_redemptions_per_user"`         // 0 for unlimited
+	ValidFrom             *time.Time  `json:"valid_from"`
+	ValidUntil            *time.Time  `json:"valid_until"`
+	TicketTierIDs         []uuid.UUID `json:"ticket_tier_ids"` // Empty for every tier of the event
+	IsActive              *bool       `json:"is_active"`       // Defaults to true
+}
+
+// CreatePromoCodeRequest defines the structure for creating a promo code of an event
+type CreatePromoCodeRequest struct {
+	Code string `json:"code" binding:"required"` // Case-insensitive, e.g. "EARLY20"
+	PromoCodeSettingsRequest
+}
+
+// UpdatePromoCodeRequest defines the structure for replacing the settings of a promo code; the code itself stays
+type UpdatePromoCodeRequest struct {
+	PromoCodeSettingsRequest
+}
+
+// PromoCodeDTO is the internal representation of the promo code data
+type PromoCodeDTO struct {
+	Code                  string
+	DiscountType          string
+	PercentOff            int
+	AmountOffCents        int64
+	Currency              string
+	MaxRedemptions        int
+	MaxRedemptionsPerUser int
+	ValidFrom             *time.Time
+	ValidUntil            *time.Time
+	TicketTierIDs         []uuid.UUID
+	IsActive              bool
+}
+
+// NormalizePromoCode turns a code as typed by a buyer or organizer into its stored form
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// TransformToPromoCodeDTO converts the incoming request to a PromoCodeDTO for internal use
+func TransformToPromoCodeDTO(promoReq CreatePromoCodeRequest) *PromoCodeDTO {
+	promoDTO := transformPromoCodeSettings(promoReq.PromoCodeSettingsRequest)
+	promoDTO.Code = NormalizePromoCode(promoReq.Code)
+	return promoDTO
+}
+
+// TransformToUpdatePromoCodeDTO converts the incoming request to a PromoCodeDTO without a code for internal use
+func TransformToUpdatePromoCodeDTO(promoReq UpdatePromoCodeRequest) *PromoCodeDTO {
+	return transformPromoCodeSettings(promoReq.PromoCodeSettingsRequest)
+}
+
+// transformPromoCodeSettings converts the shared settings of the promo code requests
+func transformPromoCodeSettings(settings PromoCodeSettingsRequest) *PromoCodeDTO {
+	isActive := true
+	if settings.IsActive != nil {
+		isActive = *settings.IsActive
+	}
+	promoDTO := &PromoCodeDTO{
+		DiscountType:          strings.ToLower(settings.DiscountType),
+		PercentOff:            settings.PercentOff,
+		AmountOffCents:        settings.AmountOffCents,
+		Currency:              strings.ToUpper(settings.Currency),
+		MaxRedemptions:        settings.MaxRedemptions,
+		MaxRedemptionsPerUser: settings.MaxRedemptionsPerUser,
+		ValidFrom:             settings.ValidFrom,
+		ValidUntil:            settings.ValidUntil,
+		IsActive:              isActive,
+	}
+	if len(settings.TicketTierIDs) > 0 {
+		promoDTO.TicketTierIDs = uniqueUUIDs(settings.TicketTierIDs)
+	}
+	return promoDTO
+}
+
+// PromoCodeResponse defines the structure returned to clients for a promo code
+type PromoCodeResponse struct {
+	ID                    uuid.UUID   `json:"id"`
+	EventID               uuid.UUID   `json:"event_id"`
+	Code                  string      `json:"code"`
+	DiscountType          string      `json:"discount_type"`
+	PercentOff            int         `json:"percent_off,omitempty"`
+	AmountOffCents        int64       `json:"amount_off_cents,omitempty"`
+	Currency              string      `json:"currency,omitempty"`
+	MaxRedemptions        int         `json:"max_redemptions"`
+	MaxRedemptionsPerUser int         `json:"max_redemptions_per_user"`
+	Redemptions           int         `json:"redemptions"`
+	Remaining             *int        `json:"remaining,omitempty"` // Unset for unlimited codes
+	ValidFrom             *time.Time  `json:"valid_from,omitempty"`
+	ValidUntil            *time.Time  `json:"valid_until,omitempty"`
+	TicketTierIDs         []uuid.UUID `json:"ticket_tier_ids,omitempty"`
+	IsActive              bool        `json:"is_active"`
+	CreatedAt             time.Time   `json:"created_at"`
+	UpdatedAt             time.Time   `json:"updated_at"`
+}
+
+// TransformToPromoCodeResponse converts the PromoCodeType to PromoCodeResponse
+func TransformToPromoCodeResponse(promoCode *types.PromoCodeType) *PromoCodeResponse {
+	return &PromoCodeResponse{
+		ID:                    promoCode.ID,
+		EventID:               promoCode.EventID,
+		Code:                  promoCode.Code,
+		DiscountType:          promoCode.DiscountType,
+		PercentOff:            promoCode.PercentOff,
+		AmountOffCents:        promoCode.AmountOffCents,
+		Currency:              promoCode.Currency,
+		MaxRedemptions:        promoCode.MaxRedemptions,
+		MaxRedemptionsPerUser: promoCode.MaxRedemptionsPerUser,
+		Redemptions:           promoCode.Redemptions,
+		Remaining:             remainingPromoRedemptions(promoCode),
+		ValidFrom:             promoCode.ValidFrom,
+		ValidUntil:            promoCode.ValidUntil,
+		TicketTierIDs:         promoCode.TicketTierIDs,
+		IsActive:              promoCode.IsActive,
+		CreatedAt:             promoCode.CreatedAt,
+		UpdatedAt:             promoCode.UpdatedAt,
+	}
+}
+
+// TransformToPromoCodeResponses converts a list of promo codes to responses
+func TransformToPromoCodeResponses(promoCodes []*types.PromoCodeType) []*PromoCodeResponse {
+	responses := make([]*PromoCodeResponse, 0, len(promoCodes))
+	for _, promoCode := range promoCodes {
+		responses = append(responses, TransformToPromoCodeResponse(promoCode))
+	}
+	return responses
+}
+
+// PromoCodeUsageReport summarises how often one promo code of an event was used
+type PromoCodeUsageReport struct {
+	PromoCodeID        uuid.UUID  `json:"promo_code_id"`
+	Code               string     `json:"code"`
+	IsActive           bool       `json:"is_active"`
+	Redemptions        int        `json:"redemptions"`         // Orders currently using the code, pending ones included
+	Remaining          *int       `json:"remaining,omitempty"` // Unset for unlimited codes
+	UniqueUsers        int        `json:"unique_users"`
+	PendingOrders      int        `json:"pending_orders"`
+	ConfirmedOrders    int        `json:"confirmed_orders"`
+	RefundedOrders     int        `json:"refunded_orders"`
+	TicketsSold        int        `json:"tickets_sold"`         // Tickets of confirmed orders
+	TotalDiscountCents int64      `json:"total_discount_cents"` // Given on confirmed orders
+	LastRedeemedAt     *time.Time `json:"last_redeemed_at,omitempty"`
+}
+
+// NewPromoCodeUsageReport starts an empty usage report for a promo code
+func NewPromoCodeUsageReport(promoCode *types.PromoCodeType) *PromoCodeUsageReport {
+	return &PromoCodeUsageReport{
+		PromoCodeID: promoCode.ID,
+		Code:        promoCode.Code,
+		IsActive:    promoCode.IsActive,
+		Redemptions: promoCode.Redemptions,
+		Remaining:   remainingPromoRedemptions(promoCode),
+	}
+}
+
+// remainingPromoRedemptions returns how often a limited code can still be redeemed, or nil for unlimited codes
+func remainingPromoRedemptions(promoCode *types.PromoCodeType) *int {
+	if promoCode.MaxRedemptions == 0 {
+		return nil
+	}
+	remaining := max(promoCode.MaxRedemptions-promoCode.Redemptions, 0)
+	return &remaining
+}
+
+// uniqueUUIDs returns ids without duplicates, keeping the first occurrence of each
+func uniqueUUIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
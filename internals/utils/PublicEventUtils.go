@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// PublicRegistrationHoneypotField is a form field hidden from people; bots filling in every field give themselves away
+const PublicRegistrationHoneypotField = "website"
+
+// registrationAnswerFormPrefix prefixes the names of registration form fields on the server-rendered page
+const registrationAnswerFormPrefix = "answers."
+
+// PublicEventResponse is what anyone may see of a published event, without organizer or guest details
+type PublicEventResponse struct {
+	Slug               string                        `json:"slug"`
+	Title              string                        `json:"title"`
+	Description        string                        `json:"description"`
+	StartTime          time.Time                     `json:"start_time"` // In the event's timezone
+	EndTime            time.Time                     `json:"end_time"`   // In the event's timezone
+	Timezone           string                        `json:"timezone"`
+	Location           string                        `json:"location"`
+	Tags               []string                      `json:"tags,omitempty"`
+	Status             string                        `json:"status"`
+	CancellationReason string                        `json:"cancellation_reason,omitempty"`
+	RegistrationOpen   bool                          `json:"registration_open"`
+	SpotsLeft          *int                          `json:"spots_left,omitempty"` // Unset when the venue has no capacity; 0 puts new guests on the waitlist
+	RegistrationFields []types.RegistrationFieldType `json:"registration_fields"`  // Questions to answer when registering
+}
+
+// TransformToPublicEventResponse converts an event and its registration form to a PublicEventResponse
+func TransformToPublicEventResponse(event *types.EventType, form *types.RegistrationFormType, registrationOpen bool, spotsLeft *int) *PublicEventResponse {
+	fields := []types.RegistrationFieldType{}
+	if form != nil {
+		fields = form.Fields
+	}
+	return &PublicEventResponse{
+		Slug:               event.Slug,
+		Title:              event.Title,
+		Description:        event.Description,
+		StartTime:          InEventTimezone(event.StartTime, event.Timezone),
+		EndTime:            InEventTimezone(event.EndTime, event.Timezone),
+		Timezone:           event.Timezone,
+		Location:           event.Location,
+		Tags:               event.Tags,
+		Status:             event.CurrentStatus(),
+		CancellationReason: event.CancellationReason,
+		RegistrationOpen:   registrationOpen,
+		SpotsLeft:          spotsLeft,
+		RegistrationFields: fields,
+	}
+}
+
+// SelfRegistrationRequest defines the structure for registering for a public event without an account
+type SelfRegistrationRequest struct {
+	FullName string                 `json:"full_name" binding:"required,max=100"`
+	Email    string                 `json:"email" binding:"required,max=254"`
+	Answers  map[string]interface{} `json:"answers"` // Answers to the event's registration form, keyed by field key
+	Website  string                 `json:"website"` // Honeypot, must stay empty
+}
+
+// SelfRegistrationDTO is the internal representation of a self-registration
+type SelfRegistrationDTO struct {
+	FullName string
+	Email    string
+	Answers  map[string]interface{}
+	Honeypot string
+}
+
+// TransformToSelfRegistrationDTO converts the incoming request to a SelfRegistrationDTO for internal use
+func TransformToSelfRegistrationDTO(req SelfRegistrationRequest) *SelfRegistrationDTO {
+	return &SelfRegistrationDTO{
+		FullName: strings.TrimSpace(req.FullName),
+		Email:    strings.TrimSpace(req.Email),
+		Answers:  req.Answers,
+		Honeypot: req.Website,
+	}
+}
+
+// TransformFormToSelfRegistrationDTO reads a self-registration posted from the server-rendered event page.
+// Form values are all text, so answers are converted to what the JSON API would receive for each field type.
+func TransformFormToSelfRegistrationDTO(values url.Values, fields []types.RegistrationFieldType) *SelfRegistrationDTO {
+	answers := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		name := registrationAnswerFormPrefix + field.Key
+		switch field.Type {
+		case types.RegistrationFieldMultiSelect:
+			var choices []interface{}
+			for _, choice := range values[name] {
+				choices = append(choices, choice)
+			}
+			answers[field.Key] = choices
+		case types.RegistrationFieldCheckbox:
+			answers[field.Key] = values.Get(name) != ""
+		case types.RegistrationFieldNumber:
+			text := strings.TrimSpace(values.Get(name))
+			if text == "" {
+				continue
+			}
+			if number, err := strconv.ParseFloat(text, 64); err == nil {
+				answers[field.Key] = number
+			} else {
+				answers[field.Key] = text // Rejected as not a number
+			}
+		default:
+			answers[field.Key] = values.Get(name)
+		}
+	}
+	return &SelfRegistrationDTO{
+		FullName: strings.TrimSpace(values.Get("full_name")),
+		Email:    strings.TrimSpace(values.Get("email")),
+		Answers:  answers,
+		Honeypot: values.Get(PublicRegistrationHoneypotField),
+	}
+}
+
+// ConfirmRegistrationRequest defines the structure for confirming a self-registration with the emailed token
+type ConfirmRegistrationRequest struct {
+	GuestID uuid.UUID `json:"guest_id" binding:"required"`
+	Token   string    `json:"token" binding:"required"`
+}
+
+// ConfirmedRegistrationResponse tells a guest where their confirmed registration stands
+type ConfirmedRegistrationResponse struct {
+	FullName   string `json:"full_name"`
+	Email      string `json:"email"`
+	RSVPStatus string `json:"rsvp_status"` // Accepted, or Waitlisted when the event is full
+}
+
+// PublicEventPage is the data of the server-rendered event page
+type PublicEventPage struct {
+	Event         *PublicEventResponse
+	StartsAt      string     // Start time formatted for people
+	EndsAt        string     // End time formatted for people
+	Values        url.Values // Values of a rejected submission, to fill the form in again
+	Submitted     bool       // A registration went through and waits for email confirmation
+	Waitlist      bool       // The venue is full, so new guests join the waitlist
+	HoneypotField string
+}
+
+// NewPublicEventPage prepares the event page for rendering
+func NewPublicEventPage(event *PublicEventResponse) *PublicEventPage {
+	return &PublicEventPage{
+		Event:         event,
+		StartsAt:      event.StartTime.Format("Monday, January 2, 2006 at 15:04 MST"),
+		EndsAt:        event.EndTime.Format("Monday, January 2, 2006 at 15:04 MST"),
+		Values:        url.Values{},
+		Waitlist:      event.SpotsLeft != nil && *event.SpotsLeft == 0,
+		HoneypotField: PublicRegistrationHoneypotField,
+	}
+}
+
+// AnswerName returns the form field name a registration question is posted under
+func (p *PublicEventPage) AnswerName(key string) string {
+	return registrationAnswerFormPrefix + key
+}
+
+// AnswerValue returns what was entered for a registration question in a rejected submission
+func (p *PublicEventPage) AnswerValue(key string) string {
+	return p.Values.Get(registrationAnswerFormPrefix + key)
+}
+
+// AnswerChosen reports whether an option of a registration question was picked in a rejected submission
+func (p *PublicEventPage) AnswerChosen(key, option string) bool {
+	for _, value := range p.Values[registrationAnswerFormPrefix+key] {
+		if value == option {
+			return true
+		}
+	}
+	return false
+}
+
+// HashRegistrationToken returns the hash a self-registration confirmation token is stored as
+func HashRegistrationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// maxRecurrenceIterations guards against runaway expansion of long-running series
+const maxRecurrenceIterations = 100000
+
+// EventOccurrence is a single concrete instance of a (possibly recurring) event, in UTC
+type EventOccurrence struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+// ExpandEventOccurrences returns the occurrences of an event that overlap [from, to), at most limit of them (0 means no limit).
+// Recurring occurrences are generated on the wall clock of the event's timezone, so a 09:00 meeting stays at 09:00 local
+// time across DST transitions while its UTC instant shifts. Monthly and yearly rules skip dates that do not exist
+// (e.g. the 31st in a 30-day month), matching iCalendar RRULE semantics.
+func ExpandEventOccurrences(event *types.EventType, from, to time.Time, limit int) []EventOccurrence {
+	duration := event.EndTime.Sub(event.StartTime)
+	occurrences := []EventOccurrence{}
+
+	if event.Recurrence == nil {
+		if event.StartTime.Before(to) && event.EndTime.After(from) {
+			occurrences = append(occurrences, EventOccurrence{StartTime: event.StartTime.UTC(), EndTime: event.EndTime.UTC()})
+		}
+		return occurrences
+	}
+
+	rule := event.Recurrence
+	interval := rule.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	localStart := InEventTimezone(event.StartTime, event.Timezone)
+	generated := 0
+
+	for step := 0; step < maxRecurrenceIterations; step++ {
+		candidate, ok := nextRecurrenceStart(localStart, rule.Frequency, step*interval)
+		if !ok {
+			continue
+		}
+		if rule.Until != nil && candidate.After(*rule.Until) {
+			break
+		}
+		if rule.Count > 0 && generated >= rule.Count {
+			break
+		}
+		if !candidate.Before(to) {
+			break
+		}
+		generated++
+
+		if candidate.Add(duration).After(from) {
+			occurrences = append(occurrences, EventOccurrence{StartTime: candidate.UTC(), EndTime: candidate.Add(duration).UTC()})
+			if limit > 0 && len(occurrences) >= limit {
+				break
+			}
+		}
+	}
+
+	return occurrences
+}
+
+// NextEventOccurrence returns the first occurrence of the event that has not ended at the given instant, or nil if the series is over.
+func NextEventOccurrence(event *types.EventType, after time.Time) *EventOccurrence {
+	occurrences := ExpandEventOccurrences(event, after, after.AddDate(100, 0, 0), 1)
+	if len(occurrences) == 0 {
+		return nil
+	}
+	return &occurrences[0]
+}
+
+// nextRecurrenceStart offsets the local start by the given number of frequency units, keeping the wall-clock time.
+// It reports false when the resulting calendar date does not exist for the rule.
+func nextRecurrenceStart(localStart time.Time, frequency string, units int) (time.Time, bool) {
+	year, month, day := localStart.Date()
+	hour, minute, second := localStart.Clock()
+	location := localStart.Location()
+
+	var candidate time.Time
+	switch frequency {
+	case types.RecurrenceDaily:
+		candidate = time.Date(year, month, day+units, hour, minute, second, localStart.Nanosecond(), location)
+	case types.RecurrenceWeekly:
+		candidate = time.Date(year, month, day+7*units, hour, minute, second, localStart.Nanosecond(), location)
+	case types.RecurrenceMonthly:
+		candidate = time.Date(year, month+time.Month(units), day, hour, minute, second, localStart.Nanosecond(), location)
+	case types.RecurrenceYearly:
+		candidate = time.Date(year+units, month, day, hour, minute, second, localStart.Nanosecond(), location)
+	default:
+		return time.Time{}, false
+	}
+
+	// time.Date normalizes overflowing days into the next month; those dates are not part of the series
+	if (frequency == types.RecurrenceMonthly || frequency == types.RecurrenceYearly) && candidate.Day() != day {
+		return time.Time{}, false
+	}
+	return candidate, true
+}
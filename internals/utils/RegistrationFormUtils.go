@@ -0,0 +1,243 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// Limits of registration forms
+const (
+	MaxRegistrationFormFields   = 50
+	MaxRegistrationFieldOptions = 100
+	MaxRegistrationAnswerLength = 1000 // Longest text answer, and the cap of a text field's max_length
+)
+
+// RegistrationDateLayout is the format of date answers and of the date range of a date field
+const RegistrationDateLayout = "2006-01-02"
+
+// RegistrationFieldRequest defines the structure of one question of a registration form
+type RegistrationFieldRequest struct {
+	Key       string   `json:"key" binding:"required"`   // Stable identifier answers are stored under, e.g. "tshirt_size"
+	Label     string   `json:"label" binding:"required"` // Question shown to guests and used as the export column
+	Type      string   `json:"type" binding:"required"`  // text, select, multi_select, checkbox, number or date
+	Required  bool     `json:"required"`
+	HelpText  string   `json:"help_text"`
+	Options   []string `json:"options"`    // Choices of select and multi-select fields
+	MinLength int      `json:"min_length"` // Text fields only
+	MaxLength int      `json:"max_length"` // Text fields only; 0 allows up to 1000 characters
+	Pattern   string   `json:"pattern"`    // Text fields only; regular expression the answer must match
+	Min       *float64 `json:"min"`        // Lowest number, or fewest choices of a multi-select
+	Max       *float64 `json:"max"`        // Highest number, or most choices of a multi-select
+	MinDate   string   `json:"min_date"`   // Date fields only, YYYY-MM-DD
+	MaxDate   string   `json:"max_date"`   // Date fields only, YYYY-MM-DD
+}
+
+// SaveRegistrationFormRequest defines the structure for creating or replacing the registration form of an event
+type SaveRegistrationFormRequest struct {
+	Fields []RegistrationFieldRequest `json:"fields" binding:"required,min=1,dive"`
+}
+
+// RegistrationFormDTO is the internal representation of a registration form
+type RegistrationFormDTO struct {
+	Fields []types.RegistrationFieldType
+}
+
+// TransformToRegistrationFormDTO converts the incoming request to a RegistrationFormDTO for internal use,
+// trimming the texts and lower-casing keys and types
+func TransformToRegistrationFormDTO(formReq SaveRegistrationFormRequest) *RegistrationFormDTO {
+	fields := make([]types.RegistrationFieldType, 0, len(formReq.Fields))
+	for _, fieldReq := range formReq.Fields {
+		var options []string
+		for _, option := range fieldReq.Options {
+			options = append(options, strings.TrimSpace(option))
+		}
+		fields = append(fields, types.RegistrationFieldType{
+			Key:       strings.ToLower(strings.TrimSpace(fieldReq.Key)),
+			Label:     strings.TrimSpace(fieldReq.Label),
+			Type:      strings.ToLower(strings.TrimSpace(fieldReq.Type)),
+			Required:  fieldReq.Required,
+			HelpText:  strings.TrimSpace(fieldReq.HelpText),
+			Options:   options,
+			MinLength: fieldReq.MinLength,
+			MaxLength: fieldReq.MaxLength,
+			Pattern:   fieldReq.Pattern,
+			Min:       fieldReq.Min,
+			Max:       fieldReq.Max,
+			MinDate:   strings.TrimSpace(fieldReq.MinDate),
+			MaxDate:   strings.TrimSpace(fieldReq.MaxDate),
+		})
+	}
+	return &RegistrationFormDTO{Fields: fields}
+}
+
+// ParseRegistrationAnswers checks the answers a guest gave against the rules of a form and converts them to
+// stored values: text as given, numbers in their shortest form, checkboxes as "true" or "false", and every
+// choice of a multi-select as its own value. Empty answers are left out; keys the form does not have are rejected.
+// Required fields are not checked here, see MissingRegistrationAnswer.
+func ParseRegistrationAnswers(form *types.RegistrationFormType, answers map[string]interface{}) (map[string][]string, error) {
+	parsed := make(map[string][]string, len(answers))
+	for key, answer := range answers {
+		field := form.Field(key)
+		if field == nil {
+			return nil, fmt.Errorf("%q is not a field of the registration form", key)
+		}
+		if answer == nil {
+			continue
+		}
+		values, err := parseRegistrationAnswer(field, answer)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", field.Label, err)
+		}
+		if len(values) > 0 {
+			parsed[key] = values
+		}
+	}
+	return parsed, nil
+}
+
+// MissingRegistrationAnswer returns the first required field of a form the answers leave blank, or nil.
+// A required checkbox only counts as answered when it is ticked.
+func MissingRegistrationAnswer(form *types.RegistrationFormType, answers map[string][]string) *types.RegistrationFieldType {
+	for i := range form.Fields {
+		field := &form.Fields[i]
+		if !field.Required {
+			continue
+		}
+		values := answers[field.Key]
+		if len(values) == 0 || (field.Type == types.RegistrationFieldCheckbox && values[0] != "true") {
+			return field
+		}
+	}
+	return nil
+}
+
+// parseRegistrationAnswer converts a single answer to its stored values
+func parseRegistrationAnswer(field *types.RegistrationFieldType, answer interface{}) ([]string, error) {
+	switch field.Type {
+	case types.RegistrationFieldText:
+		text, ok := answer.(string)
+		if !ok {
+			return nil, fmt.Errorf("answer must be text")
+		}
+		text = strings.TrimSpace(text)
+		if text == "" {
+			return nil, nil
+		}
+		maxLength := field.MaxLength
+		if maxLength == 0 {
+			maxLength = MaxRegistrationAnswerLength
+		}
+		if length := utf8.RuneCountInString(text); length < field.MinLength || length > maxLength {
+			return nil, fmt.Errorf("answer must be %d to %d characters long", field.MinLength, maxLength)
+		}
+		if field.Pattern != "" {
+			if matched, err := regexp.MatchString(field.Pattern, text); err != nil || !matched {
+				return nil, fmt.Errorf("answer has the wrong format")
+			}
+		}
+		return []string{text}, nil
+
+	case types.RegistrationFieldSelect:
+		choice, ok := answer.(string)
+		if !ok {
+			return nil, fmt.Errorf("answer must be one of the options")
+		}
+		if choice == "" {
+			return nil, nil
+		}
+		if !field.HasOption(choice) {
+			return nil, fmt.Errorf("%q is not one of the options", choice)
+		}
+		return []string{choice}, nil
+
+	case types.RegistrationFieldMultiSelect:
+		list, ok := answer.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("answer must be a list of options")
+		}
+		seen := make(map[string]struct{}, len(list))
+		choices := make([]string, 0, len(list))
+		for _, item := range list {
+			choice, ok := item.(string)
+			if !ok || !field.HasOption(choice) {
+				return nil, fmt.Errorf("%v is not one of the options", item)
+			}
+			if _, duplicate := seen[choice]; duplicate {
+				continue
+			}
+			seen[choice] = struct{}{}
+			choices = append(choices, choice)
+		}
+		if len(choices) == 0 {
+			return nil, nil
+		}
+		if field.Min != nil && float64(len(choices)) < *field.Min {
+			return nil, fmt.Errorf("choose at least %v options", *field.Min)
+		}
+		if field.Max != nil && float64(len(choices)) > *field.Max {
+			return nil, fmt.Errorf("choose at most %v options", *field.Max)
+		}
+		// Keep the order of the options, whatever order they were picked in
+		sort.SliceStable(choices, func(i, j int) bool {
+			return optionIndex(field, choices[i]) < optionIndex(field, choices[j])
+		})
+		return choices, nil
+
+	case types.RegistrationFieldCheckbox:
+		checked, ok := answer.(bool)
+		if !ok {
+			return nil, fmt.Errorf("answer must be true or false")
+		}
+		return []string{strconv.FormatBool(checked)}, nil
+
+	case types.RegistrationFieldNumber:
+		number, ok := answer.(float64)
+		if !ok {
+			return nil, fmt.Errorf("answer must be a number")
+		}
+		if field.Min != nil && number < *field.Min {
+			return nil, fmt.Errorf("answer must be at least %v", *field.Min)
+		}
+		if field.Max != nil && number > *field.Max {
+			return nil, fmt.Errorf("answer must be at most %v", *field.Max)
+		}
+		return []string{strconv.FormatFloat(number, 'f', -1, 64)}, nil
+
+	case types.RegistrationFieldDate:
+		text, ok := answer.(string)
+		if !ok {
+			return nil, fmt.Errorf("answer must be a date formatted as YYYY-MM-DD")
+		}
+		if text == "" {
+			return nil, nil
+		}
+		date, err := time.Parse(RegistrationDateLayout, text)
+		if err != nil {
+			return nil, fmt.Errorf("answer must be a date formatted as YYYY-MM-DD")
+		}
+		if field.MinDate != "" && text < field.MinDate {
+			return nil, fmt.Errorf("answer must be on or after %s", field.MinDate)
+		}
+		if field.MaxDate != "" && text > field.MaxDate {
+			return nil, fmt.Errorf("answer must be on or before %s", field.MaxDate)
+		}
+		return []string{date.Format(RegistrationDateLayout)}, nil
+	}
+	return nil, fmt.Errorf("unsupported field type %q", field.Type)
+}
+
+func optionIndex(field *types.RegistrationFieldType, option string) int {
+	for i, candidate := range field.Options {
+		if candidate == option {
+			return i
+		}
+	}
+	return len(field.Options)
+}
@@ -0,0 +1,26 @@
+package utils
+
+import "context"
+
+// RequestInfo describes the request a service call is made for, e.g. for the audit log
+type RequestInfo struct {
+	RequestID string
+	ClientIP  string
+}
+
+type requestInfoKey struct{}
+
+// RequestInfoContextKey is the key request info is stored under; Fiber middleware sets it as a local,
+// which the context of a Fiber request exposes as a value
+var RequestInfoContextKey = requestInfoKey{}
+
+// ContextWithRequestInfo returns a copy of ctx that carries info
+func ContextWithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, RequestInfoContextKey, info)
+}
+
+// RequestInfoFromContext returns the request info ctx carries, or empty info outside a request
+func RequestInfoFromContext(ctx context.Context) RequestInfo {
+	info, _ := ctx.Value(RequestInfoContextKey).(RequestInfo)
+	return info
+}
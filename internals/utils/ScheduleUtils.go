@@ -0,0 +1,136 @@
+package utils
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// Conflict modes a client can pick when creating or rescheduling an event
+const (
+	ConflictModeReject = "reject" // Refuse the change when it double-books the venue or organizer
+	ConflictModeWarn   = "warn"   // Save the change and report the clashes alongside it
+)
+
+// Reasons an existing event clashes with a new schedule
+const (
+	ConflictReasonVenue     = "venue"
+	ConflictReasonOrganizer = "organizer"
+)
+
+// FreeSlotDateLayout is the layout of the date query parameter of the free-slots endpoint
+const FreeSlotDateLayout = "2006-01-02"
+
+// TimeSlot is a half-open [StartTime, EndTime) interval
+type TimeSlot struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+// Overlaps reports whether two half-open slots share any instant; touching slots do not overlap
+func (s TimeSlot) Overlaps(other TimeSlot) bool {
+	return s.StartTime.Before(other.EndTime) && other.StartTime.Before(s.EndTime)
+}
+
+// WithBuffer widens an event slot by the setup time before and the teardown time after it
+func (s TimeSlot) WithBuffer(before, after time.Duration) TimeSlot {
+	return TimeSlot{StartTime: s.StartTime.Add(-before), EndTime: s.EndTime.Add(after)}
+}
+
+// FreeTimeSlots returns the gaps inside [windowStart, windowEnd) that are not covered by any busy slot,
+// dropping gaps shorter than minDuration
+func FreeTimeSlots(windowStart, windowEnd time.Time, busy []TimeSlot, minDuration time.Duration) []TimeSlot {
+	sorted := make([]TimeSlot, len(busy))
+	copy(sorted, busy)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].StartTime.Before(sorted[j].StartTime) })
+
+	free := []TimeSlot{}
+	cursor := windowStart
+	for _, slot := range sorted {
+		if !slot.EndTime.After(cursor) {
+			continue
+		}
+		if slot.StartTime.After(cursor) {
+			gapEnd := slot.StartTime
+			if gapEnd.After(windowEnd) {
+				gapEnd = windowEnd
+			}
+			if gapEnd.Sub(cursor) >= minDuration && gapEnd.After(cursor) {
+				free = append(free, TimeSlot{StartTime: cursor, EndTime: gapEnd})
+			}
+		}
+		cursor = slot.EndTime
+		if !cursor.Before(windowEnd) {
+			return free
+		}
+	}
+
+	if windowEnd.Sub(cursor) >= minDuration && windowEnd.After(cursor) {
+		free = append(free, TimeSlot{StartTime: cursor, EndTime: windowEnd})
+	}
+	return free
+}
+
+// EventConflictResponse describes an existing event that clashes with a requested schedule
+type EventConflictResponse struct {
+	EventID   uuid.UUID  `json:"event_id"`   // The clashing event
+	Title     string     `json:"title"`      // Title of the clashing event
+	VenueID   *uuid.UUID `json:"venue_id"`   // Venue of the clashing event, if any
+	Reasons   []string   `json:"reasons"`    // "venue" and/or "organizer"
+	StartTime time.Time  `json:"start_time"` // Start of the clashing occurrence, in that event's timezone
+	EndTime   time.Time  `json:"end_time"`   // End of the clashing occurrence, in that event's timezone
+}
+
+// NewEventConflictResponse builds the conflict entry for a clashing occurrence of an existing event
+func NewEventConflictResponse(event *types.EventType, occurrence EventOccurrence, reasons []string) *EventConflictResponse {
+	return &EventConflictResponse{
+		EventID:   event.ID,
+		Title:     event.Title,
+		VenueID:   event.VenueID,
+		Reasons:   reasons,
+		StartTime: InEventTimezone(occurrence.StartTime, event.Timezone),
+		EndTime:   InEventTimezone(occurrence.EndTime, event.Timezone),
+	}
+}
+
+// RescheduleEventRequest defines the structure for moving an event to a new time
+type RescheduleEventRequest struct {
+	StartTime             time.Time `json:"start_time" binding:"required"`                        // New start time, RFC 3339 with an offset
+	EndTime               time.Time `json:"end_time" binding:"required"`                          // New end time
+	ConflictMode          string    `json:"conflict_mode" validate:"omitempty,oneof=reject warn"` // Overrides the configured conflict mode
+	SuppressNotifications bool      `json:"suppress_notifications"`                               // Skip the guest emails, e.g. for a minor correction
+}
+
+// RescheduleEventDTO is the internal representation of a reschedule request
+type RescheduleEventDTO struct {
+	StartTime             time.Time
+	EndTime               time.Time
+	ConflictMode          string
+	SuppressNotifications bool
+}
+
+// TransformToRescheduleEventDTO converts the incoming request to a RescheduleEventDTO for internal use
+func TransformToRescheduleEventDTO(req RescheduleEventRequest) *RescheduleEventDTO {
+	return &RescheduleEventDTO{
+		StartTime:             req.StartTime,
+		EndTime:               req.EndTime,
+		ConflictMode:          req.ConflictMode,
+		SuppressNotifications: req.SuppressNotifications,
+	}
+}
+
+// FreeSlotsQuery defines the query parameters for the venue free-slots endpoint
+type FreeSlotsQuery struct {
+	Date       string `form:"date" binding:"required"` // Day to inspect, YYYY-MM-DD in the venue's timezone
+	MinMinutes int    `form:"min_minutes"`             // Ignore gaps shorter than this many minutes
+}
+
+// VenueFreeSlotsResponse lists the free time of a venue on a given day
+type VenueFreeSlotsResponse struct {
+	VenueID   uuid.UUID  `json:"venue_id"`   // The venue
+	Date      string     `json:"date"`       // The inspected day, YYYY-MM-DD
+	Timezone  string     `json:"timezone"`   // Timezone the slots are rendered in
+	FreeSlots []TimeSlot `json:"free_slots"` // Gaps between bookings, setup and teardown buffers included
+}
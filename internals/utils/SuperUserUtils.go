@@ -5,6 +5,8 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"errors"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -28,6 +30,8 @@ type LoginSuperuserResponse struct {
 	Role         string    `json:"-"`              // Omit role from the response
 	Token        string    `json:"-"`              // Omit token from the response
 	Is2FAEnabled bool      `json:"is_2fa_enabled"` // Indicates if two-factor authentication is enabled
+	// PasswordExpiresAt is when the password has to be reset under the password policy; nil when it never expires
+	PasswordExpiresAt *time.Time `json:"password_expires_at,omitempty"`
 }
 
 // RegisterSuperuserRequest represents the request structure for registering a new superuser.
@@ -35,7 +39,7 @@ type RegisterSuperuserRequest struct {
 	Email    string `json:"email" binding:"required,email" validate:"required,email"`                                    // Email of the new superuser
 	FullName string `json:"full_name" binding:"required,min=3,max=32" validate:"required,min=3,max=32"`                  // Full name of the new superuser
 	Username string `json:"username" binding:"required,min=3,max=32,alphanum" validate:"required,min=3,max=32,alphanum"` // Username of the new superuser
-	Password string `json:"password" binding:"required,min=8" validate:"required,min=8"`                                 // Password for the new superuser
+	Password string `json:"password" binding:"required" validate:"required"`                                             // Password for the new superuser; the password policy decides what is strong enough
 }
 
 // RegisterSuperuserResponse represents the response structure for a successful registration.
@@ -77,6 +81,24 @@ func ValidateUniqueness(ctx context.Context, email, username string, repo reposi
 	return nil // Return nil if both email and username are unique
 }
 
+// ChangePasswordRequest represents the request structure for a logged-in superuser changing their password.
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required" validate:"required"` // Password the superuser logs in with now
+	NewPassword     string `json:"new_password" binding:"required" validate:"required"`     // Password replacing it; the password policy decides what is strong enough
+}
+
+// UpdateProfileRequest represents the request structure for a logged-in superuser updating their profile.
+type UpdateProfileRequest struct {
+	FullName string `json:"full_name" binding:"required,min=3,max=32" validate:"required,min=3,max=32"` // New full name
+}
+
+// ChangeEmailRequest represents the request structure for a logged-in superuser changing their email address.
+// The change only takes effect once the new address is verified.
+type ChangeEmailRequest struct {
+	Email    string `json:"email" binding:"required,email" validate:"required,email"` // New email address
+	Password string `json:"password" binding:"required" validate:"required"`          // Current password, confirming the change
+}
+
 // GenerateResetToken generates a unique token for password resets.
 // The token consists of a UUID and a random hex string.
 func GenerateResetToken() string {
@@ -94,3 +116,148 @@ func GenerateResetToken() string {
 	resetToken := uuidPart + "-" + randomPart
 	return resetToken
 }
+
+// SuperUserPageSize is how many superusers an admin listing shows per page unless asked for another page size
+const SuperUserPageSize = 25
+
+// MaxSuperUserPageSize caps the page size of an admin superuser listing
+const MaxSuperUserPageSize = 100
+
+// SuperUserListQuery holds the query parameters of an admin superuser listing
+type SuperUserListQuery struct {
+	Search   string `form:"q" query:"q"`                 // Part of a username, email or full name; empty lists everyone
+	Role     string `form:"role" query:"role"`           // Only superusers with this role
+	Verified string `form:"verified" query:"verified"`   // Only verified ("true") or unverified ("false") superusers; empty lists both
+	Active   string `form:"active" query:"active"`       // Only active ("true") or deactivated ("false") superusers; empty lists both
+	Deleted  bool   `form:"deleted" query:"deleted"`     // Whether soft-deleted superusers are listed too
+	Page     int    `form:"page" query:"page"`           // 1-based page number; defaults to the first page
+	PageSize int    `form:"page_size" query:"page_size"` // Superusers per page; defaults to SuperUserPageSize
+}
+
+// parseBoolFilter turns an optional "true"/"false" filter into a boolean, or nil when it is empty or invalid
+func parseBoolFilter(filter string) *bool {
+	value, err := strconv.ParseBool(filter)
+	if err != nil {
+		return nil
+	}
+	return &value
+}
+
+// TransformToSuperUserListFilter turns the filters of a listing query into a repository filter
+func TransformToSuperUserListFilter(query *SuperUserListQuery) repositories.SuperUserListFilter {
+	return repositories.SuperUserListFilter{
+		Search:         strings.TrimSpace(query.Search),
+		Role:           query.Role,
+		IsVerified:     parseBoolFilter(query.Verified),
+		IsActive:       parseBoolFilter(query.Active),
+		IncludeDeleted: query.Deleted,
+	}
+}
+
+// SuperUserAdminResponse is what administrators see of a superuser; secrets such as tokens and OTPs are left out
+type SuperUserAdminResponse struct {
+	ID               uuid.UUID `json:"id"`                // Unique identifier of the superuser
+	Email            string    `json:"email"`             // Email of the superuser
+	FullName         string    `json:"full_name"`         // Full name of the superuser
+	Username         string    `json:"username"`          // Username of the superuser
+	Role             string    `json:"role"`              // Role of the superuser
+	PermissionGroups []string  `json:"permission_groups"` // Permission groups the superuser belongs to
+	IsActive         bool      `json:"is_active"`         // Whether the superuser may log in
+	IsVerified       bool      `json:"is_verified"`       // Whether the superuser verified their email address
+	Is2FAEnabled     bool      `json:"is_2fa_enabled"`    // Indicates if two-factor authentication is enabled
+	CreatedAt        time.Time `json:"created_at"`        // Timestamp when the superuser was created
+	UpdatedAt        time.Time `json:"updated_at"`        // Timestamp when the superuser was last updated
+	// PasswordResetRequired is set when an admin forced a password reset that has not happened yet
+	PasswordResetRequired bool `json:"password_reset_required"`
+	// DeletedAt is set when the superuser was soft-deleted
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+// TransformToSuperUserAdminResponse creates a SuperUserAdminResponse from a SuperUserType
+func TransformToSuperUserAdminResponse(superUser *types.SuperUserType) *SuperUserAdminResponse {
+	permissionGroups := superUser.PermissionGroups
+	if permissionGroups == nil {
+		permissionGroups = []string{}
+	}
+	return &SuperUserAdminResponse{
+		ID:               superUser.ID,
+		Email:            superUser.Email,
+		FullName:         superUser.FullName,
+		Username:         superUser.Username,
+		Role:             superUser.Role,
+		PermissionGroups: permissionGroups,
+		IsActive:         superUser.IsActive,
+		IsVerified:       superUser.IsOTPVerified,
+		Is2FAEnabled:     superUser.Is2FAEnabled,
+		CreatedAt:        superUser.CreatedAt,
+		UpdatedAt:        superUser.UpdatedAt,
+
+		PasswordResetRequired: superUser.PasswordResetRequired,
+		DeletedAt:             superUser.DeletedAt,
+	}
+}
+
+// SuperUserListResponse is one page of an admin superuser listing
+type SuperUserListResponse struct {
+	SuperUsers []*SuperUserAdminResponse `json:"superusers"` // Superusers on this page, ordered by username
+	Query      *SuperUserListQuery       `json:"-"`          // Query the listing was made for, to link to other pages
+	Roles      []string                  `json:"-"`          // Roles the listing can be filtered by
+	Page       int                       `json:"page"`       // 1-based page number
+	PageSize   int                       `json:"page_size"`  // Maximum number of superusers per page
+	Total      int64                     `json:"total"`      // Number of superusers matching the search
+}
+
+// HasPreviousPage reports whether there is a page before this one
+func (l *SuperUserListResponse) HasPreviousPage() bool {
+	return l.Page > 1
+}
+
+// HasNextPage reports whether more superusers match than are shown up to this page
+func (l *SuperUserListResponse) HasNextPage() bool {
+	return int64(l.Page*l.PageSize) < l.Total
+}
+
+// PreviousPage returns the number of the page before this one
+func (l *SuperUserListResponse) PreviousPage() int {
+	return l.Page - 1
+}
+
+// NextPage returns the number of the page after this one
+func (l *SuperUserListResponse) NextPage() int {
+	return l.Page + 1
+}
+
+// SuperUserAdminPage is the data of the admin page of a single superuser
+type SuperUserAdminPage struct {
+	SuperUser    *SuperUserAdminResponse `json:"superuser"`     // The superuser shown
+	AllowedRoles []string                `json:"allowed_roles"` // Roles the superuser can be given
+	Notice       string                  `json:"-"`             // Outcome of the action that led to the page, if any
+}
+
+// SetSuperUserActiveRequest activates or deactivates a superuser account
+type SetSuperUserActiveRequest struct {
+	Active *bool `json:"active" form:"active" binding:"required"` // Whether the superuser may log in
+}
+
+// ChangeSuperUserRoleRequest changes the role and permission groups of a superuser
+type ChangeSuperUserRoleRequest struct {
+	Role             string   `json:"role" form:"role" binding:"required"`        // New role, one of the allowed roles
+	PermissionGroups []string `json:"permission_groups" form:"permission_groups"` // New permission groups; entries may hold comma-separated lists
+}
+
+// NormalizePermissionGroups splits comma-separated entries, trims and lowercases the groups and drops empty and repeated ones
+func NormalizePermissionGroups(entries []string) []string {
+	groups := []string{}
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		for _, group := range strings.Split(entry, ",") {
+			group = strings.ToLower(strings.TrimSpace(group))
+			if group == "" || seen[group] {
+				continue
+			}
+			seen[group] = true
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// TicketTierRequest defines the structure for creating or replacing a ticket tier of an event
+type TicketTierRequest struct {
+	Name         string     `json:"name" binding:"required,max=100"` // e.g. "Free", "Early Bird" or "VIP"
+	Description  string     `json:"description" binding:"max=500"`   // Optional perks of the tier
+	PriceCents   int64      `json:"price_cents"`                     // Price per ticket in the minor unit of the currency, 0 for free tickets
+	Currency     string     `json:"currency" binding:"required"`     // ISO 4217 code, e.g. "EUR"
+	Quantity     int        `json:"quantity" binding:"required"`     // Tickets on sale in this tier
+	SalesStartAt *time.Time `json:"sales_start_at"`                  // Optional start of the sale window
+	SalesEndAt   *time.Time `json:"sales_end_at"`                    // Optional end of the sale window
+}
+
+// TicketTierDTO is the internal representation of the ticket tier data
+type TicketTierDTO struct {
+	Name         string
+	Description  string
+	PriceCents   int64
+	Currency     string
+	Quantity     int
+	SalesStartAt *time.Time
+	SalesEndAt   *time.Time
+}
+
+// TransformToTicketTierDTO converts the incoming request to a TicketTierDTO for internal use
+func TransformToTicketTierDTO(tierReq TicketTierRequest) *TicketTierDTO {
+	return &TicketTierDTO{
+		Name:         strings.TrimSpace(tierReq.Name),
+		Description:  tierReq.Description,
+		PriceCents:   tierReq.PriceCents,
+		Currency:     strings.ToUpper(tierReq.Currency),
+		Quantity:     tierReq.Quantity,
+		SalesStartAt: tierReq.SalesStartAt,
+		SalesEndAt:   tierReq.SalesEndAt,
+	}
+}
+
+// TicketTierResponse defines the structure returned to clients for a ticket tier
+type TicketTierResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	EventID      uuid.UUID  `json:"event_id"`
+	Name         string     `json:"name"`
+	Description  string     `json:"description,omitempty"`
+	PriceCents   int64      `json:"price_cents"`
+	Currency     string     `json:"currency"`
+	Quantity     int        `json:"quantity"`
+	Sold         int        `json:"sold"`
+	Available    int        `json:"available"` // Tickets that can still be ordered; held tickets come back when their order expires
+	OnSale       bool       `json:"on_sale"`   // Whether the sale window is open right now
+	SalesStartAt *time.Time `json:"sales_start_at,omitempty"`
+	SalesEndAt   *time.Time `json:"sales_end_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// TransformToTicketTierResponse converts the TicketTierType to TicketTierResponse
+func TransformToTicketTierResponse(tier *types.TicketTierType) *TicketTierResponse {
+	return &TicketTierResponse{
+		ID:           tier.ID,
+		EventID:      tier.EventID,
+		Name:         tier.Name,
+		Description:  tier.Description,
+		PriceCents:   tier.PriceCents,
+		Currency:     tier.Currency,
+		Quantity:     tier.Quantity,
+		Sold:         tier.Sold,
+		Available:    tier.Available(),
+		OnSale:       tier.OnSale(time.Now()),
+		SalesStartAt: tier.SalesStartAt,
+		SalesEndAt:   tier.SalesEndAt,
+		CreatedAt:    tier.CreatedAt,
+		UpdatedAt:    tier.UpdatedAt,
+	}
+}
+
+// TransformToTicketTierResponses converts a list of ticket tiers to responses
+func TransformToTicketTierResponses(tiers []*types.TicketTierType) []*TicketTierResponse {
+	responses := make([]*TicketTierResponse, 0, len(tiers))
+	for _, tier := range tiers {
+		responses = append(responses, TransformToTicketTierResponse(tier))
+	}
+	return responses
+}
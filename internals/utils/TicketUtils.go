@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skip2/go-qrcode"
+)
+
+// ticketCodeVersion prefixes every signed payload so the layout can change without breaking printed tickets
+const ticketCodeVersion byte = 1
+
+// ticketPayloadSize is the version byte, three UUIDs and the issue time in Unix seconds
+const ticketPayloadSize = 1 + 3*16 + 8
+
+// TicketQRCodeSize is the width and height in pixels of ticket QR images
+const TicketQRCodeSize = 320
+
+// MaxCheckInSyncScans caps how many offline scans a check-in app may upload at once
+const MaxCheckInSyncScans = 500
+
+// Outcomes of scanning a ticket at the door
+const (
+	CheckInStatusCheckedIn        = "checked_in"         // The guest is admitted now
+	CheckInStatusAlreadyCheckedIn = "already_checked_in" // The ticket was used before
+	CheckInStatusRevoked          = "revoked"            // The ticket was cancelled
+	CheckInStatusInvalid          = "invalid"            // Forged, damaged or for another event
+)
+
+var ErrInvalidTicketCode = errors.New("invalid ticket code")
+
+// TicketClaims is what a signed ticket code proves: which ticket of which guest for which event
+type TicketClaims struct {
+	TicketID uuid.UUID
+	EventID  uuid.UUID
+	GuestID  uuid.UUID
+	IssuedAt time.Time
+}
+
+// SignTicketCode encodes the claims and their Ed25519 signature as a URL-safe code for QR images and links
+func SignTicketCode(privateKey ed25519.PrivateKey, claims TicketClaims) string {
+	payload := make([]byte, 0, ticketPayloadSize+ed25519.SignatureSize)
+	payload = append(payload, ticketCodeVersion)
+	payload = append(payload, claims.TicketID[:]...)
+	payload = append(payload, claims.EventID[:]...)
+	payload = append(payload, claims.GuestID[:]...)
+	payload = binary.BigEndian.AppendUint64(payload, uint64(claims.IssuedAt.Unix()))
+	payload = append(payload, ed25519.Sign(privateKey, payload)...)
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+// ParseTicketCode verifies the signature of a ticket code and returns its claims.
+// Only the signature is checked; whether the ticket was used or revoked is up to the caller.
+func ParseTicketCode(publicKey ed25519.PublicKey, code string) (*TicketClaims, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(code)
+	if err != nil || len(raw) != ticketPayloadSize+ed25519.SignatureSize || raw[0] != ticketCodeVersion {
+		return nil, ErrInvalidTicketCode
+	}
+	payload, signature := raw[:ticketPayloadSize], raw[ticketPayloadSize:]
+	if !ed25519.Verify(publicKey, payload, signature) {
+		return nil, ErrInvalidTicketCode
+	}
+
+	claims := &TicketClaims{}
+	copy(claims.TicketID[:], payload[1:17])
+	copy(claims.EventID[:], payload[17:33])
+	copy(claims.GuestID[:], payload[33:49])
+	claims.IssuedAt = time.Unix(int64(binary.BigEndian.Uint64(payload[49:57])), 0).UTC()
+	return claims, nil
+}
+
+// TicketQRCodePNG renders a ticket code as a PNG QR image
+func TicketQRCodePNG(code string) ([]byte, error) {
+	return qrcode.Encode(code, qrcode.Medium, TicketQRCodeSize)
+}
+
+// RevokeTicketRequest defines the optional body for revoking a guest's ticket
+type RevokeTicketRequest struct {
+	Reason string `json:"reason" binding:"max=500"` // Shown to door staff when the ticket is scanned
+}
+
+// CheckInRequest defines the structure for checking a guest in with a scanned ticket
+type CheckInRequest struct {
+	Code string `json:"code" binding:"required"` // The scanned ticket code
+}
+
+// OfflineScanRequest is one ticket scanned while the check-in app had no connection
+type OfflineScanRequest struct {
+	Code      string    `json:"code" binding:"required"`       // The scanned ticket code
+	ScannedAt time.Time `json:"scanned_at" binding:"required"` // When the guest was admitted, RFC 3339
+}
+
+// SyncCheckInsRequest defines the structure for uploading the scans a check-in app made offline
+type SyncCheckInsRequest struct {
+	Scans []OfflineScanRequest `json:"scans" binding:"required,min=1,dive"` // In scan order
+}
+
+// CheckInScanDTO is the internal representation of a scan; ScannedAt is nil for live scans
+type CheckInScanDTO struct {
+	Code      string
+	ScannedAt *time.Time
+}
+
+// TransformToCheckInScanDTO converts a live check-in request to a CheckInScanDTO for internal use
+func TransformToCheckInScanDTO(req CheckInRequest) *CheckInScanDTO {
+	return &CheckInScanDTO{Code: req.Code}
+}
+
+// TransformToCheckInScanDTOs converts uploaded offline scans to CheckInScanDTOs for internal use
+func TransformToCheckInScanDTOs(req SyncCheckInsRequest) []*CheckInScanDTO {
+	scans := make([]*CheckInScanDTO, len(req.Scans))
+	for i, scan := range req.Scans {
+		scannedAt := scan.ScannedAt
+		scans[i] = &CheckInScanDTO{Code: scan.Code, ScannedAt: &scannedAt}
+	}
+	return scans
+}
+
+// CheckInResult reports the outcome of one scan
+type CheckInResult struct {
+	Status      string     `json:"status"`                  // One of the CheckInStatus values
+	TicketID    *uuid.UUID `json:"ticket_id,omitempty"`     // Omitted for invalid codes
+	GuestID     *uuid.UUID `json:"guest_id,omitempty"`      // Omitted for invalid codes
+	FullName    string     `json:"full_name,omitempty"`     // Shown to door staff to compare with the guest's ID
+	CheckedInAt *time.Time `json:"checked_in_at,omitempty"` // When the ticket was used, now or earlier
+	Error       string     `json:"error,omitempty"`         // Why the guest was not admitted
+}
+
+// CheckInManifestTicket is the state of one ticket as a check-in app needs it to work offline
+type CheckInManifestTicket struct {
+	TicketID    uuid.UUID  `json:"ticket_id"`
+	GuestID     uuid.UUID  `json:"guest_id"`
+	FullName    string     `json:"full_name"`
+	Status      string     `json:"status"` // Valid, Used or Revoked
+	CheckedInAt *time.Time `json:"checked_in_at,omitempty"`
+}
+
+// CheckInManifestResponse gives a check-in app everything it needs to admit guests while offline:
+// the key to verify ticket signatures and the tickets that are already used or revoked
+type CheckInManifestResponse struct {
+	EventID     uuid.UUID                `json:"event_id"`
+	Algorithm   string                   `json:"algorithm"`  // Always "Ed25519"
+	PublicKey   string                   `json:"public_key"` // Standard base64 of the raw public key
+	GeneratedAt time.Time                `json:"generated_at"`
+	Tickets     []*CheckInManifestTicket `json:"tickets"`
+}
@@ -0,0 +1,28 @@
+package utils
+
+import "time"
+
+// EventTimeLayout is the human readable layout used when showing event times in emails
+const EventTimeLayout = "Mon, 02 Jan 2006 15:04 MST"
+
+// LoadEventLocation resolves an IANA timezone name, falling back to UTC for empty or unknown names.
+func LoadEventLocation(timezone string) *time.Location {
+	if timezone == "" {
+		return time.UTC
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// InEventTimezone converts a stored UTC time into the event's timezone for presentation.
+func InEventTimezone(t time.Time, timezone string) time.Time {
+	return t.In(LoadEventLocation(timezone))
+}
+
+// FormatEventTime renders a time in the event's timezone using EventTimeLayout.
+func FormatEventTime(t time.Time, timezone string) string {
+	return InEventTimezone(t, timezone).Format(EventTimeLayout)
+}
@@ -0,0 +1,103 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// RegisterVenueRequest defines the structure for registering a new venue
+type RegisterVenueRequest struct {
+	Name        string  `json:"name" binding:"required,min=3,max=100" validate:"required,min=3,max=100"` // Required venue name
+	AddressLine string  `json:"address_line" validate:"max=255"`                                         // Street address
+	City        string  `json:"city" validate:"max=100"`                                                 // City
+	State       string  `json:"state" validate:"max=100"`                                                // State or region
+	PostalCode  string  `json:"postal_code" validate:"max=20"`                                           // Postal code
+	Country     string  `json:"country" validate:"max=100"`                                              // Country
+	Latitude    float64 `json:"latitude" validate:"latitude"`                                            // Latitude in decimal degrees
+	Longitude   float64 `json:"longitude" validate:"longitude"`                                          // Longitude in decimal degrees
+	Timezone    string  `json:"timezone" binding:"required" validate:"required,timezone"`                // IANA timezone, e.g. "Europe/Berlin"
+	Capacity    int     `json:"capacity" validate:"gte=0"`                                               // Maximum number of attendees, 0 for unknown
+}
+
+// VenueDTO is the internal representation of the venue data
+type VenueDTO struct {
+	Name        string
+	AddressLine string
+	City        string
+	State       string
+	PostalCode  string
+	Country     string
+	Latitude    float64
+	Longitude   float64
+	Timezone    string
+	Capacity    int
+}
+
+// TransformToVenueDTO converts the incoming request to a VenueDTO for internal use
+func TransformToVenueDTO(venueReq RegisterVenueRequest) *VenueDTO {
+	return &VenueDTO{
+		Name:        venueReq.Name,
+		AddressLine: venueReq.AddressLine,
+		City:        venueReq.City,
+		State:       venueReq.State,
+		PostalCode:  venueReq.PostalCode,
+		Country:     venueReq.Country,
+		Latitude:    venueReq.Latitude,
+		Longitude:   venueReq.Longitude,
+		Timezone:    venueReq.Timezone,
+		Capacity:    venueReq.Capacity,
+	}
+}
+
+// VenueResponse defines the structure returned to clients for a venue
+type VenueResponse struct {
+	ID          uuid.UUID `json:"id"`           // The unique identifier for the venue
+	Name        string    `json:"name"`         // Venue name
+	AddressLine string    `json:"address_line"` // Street address
+	City        string    `json:"city"`         // City
+	State       string    `json:"state"`        // State or region
+	PostalCode  string    `json:"postal_code"`  // Postal code
+	Country     string    `json:"country"`      // Country
+	Latitude    float64   `json:"latitude"`     // Latitude in decimal degrees
+	Longitude   float64   `json:"longitude"`    // Longitude in decimal degrees
+	Timezone    string    `json:"timezone"`     // IANA timezone
+	Capacity    int       `json:"capacity"`     // Maximum number of attendees
+	CreatedAt   time.Time `json:"created_at"`   // Timestamp when the venue was created
+	UpdatedAt   time.Time `json:"updated_at"`   // Timestamp when the venue was last updated
+}
+
+// TransformToVenueResponse converts the VenueType to VenueResponse
+func TransformToVenueResponse(venue *types.VenueType) *VenueResponse {
+	return &VenueResponse{
+		ID:          venue.ID,
+		Name:        venue.Name,
+		AddressLine: venue.AddressLine,
+		City:        venue.City,
+		State:       venue.State,
+		PostalCode:  venue.PostalCode,
+		Country:     venue.Country,
+		Latitude:    venue.Latitude,
+		Longitude:   venue.Longitude,
+		Timezone:    venue.Timezone,
+		Capacity:    venue.Capacity,
+		CreatedAt:   venue.CreatedAt,
+		UpdatedAt:   venue.UpdatedAt,
+	}
+}
+
+// GeoRadiusQuery defines the query parameters for a radius search
+type GeoRadiusQuery struct {
+	Latitude  *float64 `form:"lat" binding:"required"` // Latitude of the search center
+	Longitude *float64 `form:"lng" binding:"required"` // Longitude of the search center
+	RadiusKm  float64  `form:"radius_km"`              // Search radius in kilometers, defaults to the configured radius
+}
+
+// GeoBoundingBoxQuery defines the query parameters for a bounding-box search
+type GeoBoundingBoxQuery struct {
+	MinLatitude  *float64 `form:"min_lat" binding:"required"` // South edge of the box
+	MinLongitude *float64 `form:"min_lng" binding:"required"` // West edge of the box
+	MaxLatitude  *float64 `form:"max_lat" binding:"required"` // North edge of the box
+	MaxLongitude *float64 `form:"max_lng" binding:"required"` // East edge of the box
+}
@@ -0,0 +1,220 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/types"
+)
+
+// Headers sent with every webhook delivery
+const (
+	WebhookEventHeader     = "X-EventureGo-Event"     // Domain event type, e.g. "guest.checked_in"
+	WebhookDeliveryHeader  = "X-EventureGo-Delivery"  // ID of the delivery, new for every manual redelivery
+	WebhookSignatureHeader = "X-EventureGo-Signature" // "t=<unix seconds>,v1=<hex HMAC-SHA256>"
+)
+
+// webhookSecretPrefix marks webhook signing secrets, so they are recognisable when pasted into receiver configs
+const webhookSecretPrefix = "whsec_"
+
+// MaxWebhookResponseBody caps how much of an endpoint's answer is kept in the delivery log
+const MaxWebhookResponseBody = 1024
+
+// IsPublicWebhookIP reports whether a webhook may be delivered to ip. Loopback, private, link-local, unspecified and
+// multicast addresses reach the server's own network rather than a receiver on the internet.
+func IsPublicWebhookIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified())
+}
+
+// Bounds of the limit query parameter of the webhook deliveries endpoint
+const (
+	DefaultWebhookDeliveriesLimit = 50
+	MaxWebhookDeliveriesLimit     = 500
+)
+
+// GenerateWebhookSecret returns a new random secret for signing the deliveries of a webhook
+func GenerateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return webhookSecretPrefix + hex.EncodeToString(secret), nil
+}
+
+// SignWebhookPayload returns the signature header value of a delivery sent at timestamp.
+// The HMAC-SHA256 covers "<unix seconds>.<payload>", so receivers can reject replays by checking the timestamp.
+func SignWebhookPayload(secret string, timestamp time.Time, payload []byte) string {
+	unix := timestamp.Unix()
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", unix)
+	mac.Write(payload)
+	return fmt.Sprintf("t=%d,v1=%s", unix, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// RegisterWebhookRequest defines the structure for registering a webhook endpoint
+type RegisterWebhookRequest struct {
+	URL         string   `json:"url" binding:"required,max=2048"`      // http(s) endpoint that receives the deliveries
+	EventTypes  []string `json:"event_types" binding:"required,min=1"` // Domain events to deliver, e.g. "event.created"
+	Description string   `json:"description" binding:"max=200"`        // Optional note, e.g. "CRM sync"
+}
+
+// UpdateWebhookRequest defines the structure for changing a webhook; omitted fields are left unchanged
+type UpdateWebhookRequest struct {
+	URL         *string  `json:"url" binding:"omitempty,max=2048"`
+	EventTypes  []string `json:"event_types" binding:"omitempty,min=1"`
+	Description *string  `json:"description" binding:"omitempty,max=200"`
+	IsActive    *bool    `json:"is_active"` // Inactive webhooks receive no new deliveries and stop retrying
+}
+
+// WebhookDTO is the internal representation of a new webhook
+type WebhookDTO struct {
+	URL         string
+	EventTypes  []string
+	Description string
+}
+
+// UpdateWebhookDTO is the internal representation of a webhook change; nil fields are left unchanged
+type UpdateWebhookDTO struct {
+	URL         *string
+	EventTypes  []string
+	Description *string
+	IsActive    *bool
+}
+
+// TransformToWebhookDTO converts the incoming request to a WebhookDTO for internal use
+func TransformToWebhookDTO(webhookReq RegisterWebhookRequest) *WebhookDTO {
+	return &WebhookDTO{
+		URL:         webhookReq.URL,
+		EventTypes:  uniqueStrings(webhookReq.EventTypes),
+		Description: webhookReq.Description,
+	}
+}
+
+// TransformToUpdateWebhookDTO converts the incoming request to an UpdateWebhookDTO for internal use
+func TransformToUpdateWebhookDTO(webhookReq UpdateWebhookRequest) *UpdateWebhookDTO {
+	dto := &UpdateWebhookDTO{
+		URL:         webhookReq.URL,
+		Description: webhookReq.Description,
+		IsActive:    webhookReq.IsActive,
+	}
+	if webhookReq.EventTypes != nil {
+		dto.EventTypes = uniqueStrings(webhookReq.EventTypes)
+	}
+	return dto
+}
+
+// WebhookResponse defines the structure returned to clients for a webhook; the secret is never part of it
+type WebhookResponse struct {
+	ID          uuid.UUID `json:"id"`
+	URL         string    `json:"url"`
+	EventTypes  []string  `json:"event_types"`
+	Description string    `json:"description,omitempty"`
+	IsActive    bool      `json:"is_active"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CreatedWebhookResponse is returned once, when a webhook is registered, and carries its signing secret
+type CreatedWebhookResponse struct {
+	*WebhookResponse
+	Secret string `json:"secret"` // Verify the X-EventureGo-Signature header with it
+}
+
+// TransformToWebhookResponse converts the WebhookType to WebhookResponse
+func TransformToWebhookResponse(webhook *types.WebhookType) *WebhookResponse {
+	return &WebhookResponse{
+		ID:          webhook.ID,
+		URL:         webhook.URL,
+		EventTypes:  webhook.EventTypes,
+		Description: webhook.Description,
+		IsActive:    webhook.IsActive,
+		CreatedAt:   webhook.CreatedAt,
+		UpdatedAt:   webhook.UpdatedAt,
+	}
+}
+
+// TransformToWebhookResponses converts a list of webhooks to responses
+func TransformToWebhookResponses(webhooks []*types.WebhookType) []*WebhookResponse {
+	responses := make([]*WebhookResponse, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		responses = append(responses, TransformToWebhookResponse(webhook))
+	}
+	return responses
+}
+
+// TransformToCreatedWebhookResponse converts a newly registered WebhookType to CreatedWebhookResponse
+func TransformToCreatedWebhookResponse(webhook *types.WebhookType) *CreatedWebhookResponse {
+	return &CreatedWebhookResponse{
+		WebhookResponse: TransformToWebhookResponse(webhook),
+		Secret:          webhook.Secret,
+	}
+}
+
+// WebhookDeliveriesQuery defines the query parameters for listing the deliveries of a webhook
+type WebhookDeliveriesQuery struct {
+	Limit int `form:"limit"` // Number of deliveries to return, newest first
+}
+
+// WebhookDeliveryResponse defines the structure returned to clients for an entry of the delivery log
+type WebhookDeliveryResponse struct {
+	ID             uuid.UUID       `json:"id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"`
+	LastAttemptAt  *time.Time      `json:"last_attempt_at,omitempty"`
+	ResponseStatus int             `json:"response_status,omitempty"`
+	ResponseBody   string          `json:"response_body,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	RedeliveryOf   *uuid.UUID      `json:"redelivery_of,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// TransformToWebhookDeliveryResponse converts the WebhookDeliveryType to WebhookDeliveryResponse
+func TransformToWebhookDeliveryResponse(delivery *types.WebhookDeliveryType) *WebhookDeliveryResponse {
+	return &WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		EventType:      delivery.EventType,
+		Payload:        json.RawMessage(delivery.Payload),
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastAttemptAt:  delivery.LastAttemptAt,
+		ResponseStatus: delivery.ResponseStatus,
+		ResponseBody:   delivery.ResponseBody,
+		LastError:      delivery.LastError,
+		RedeliveryOf:   delivery.RedeliveryOf,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
+
+// TransformToWebhookDeliveryResponses converts a list of deliveries to responses
+func TransformToWebhookDeliveryResponses(deliveries []*types.WebhookDeliveryType) []*WebhookDeliveryResponse {
+	responses := make([]*WebhookDeliveryResponse, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		responses = append(responses, TransformToWebhookDeliveryResponse(delivery))
+	}
+	return responses
+}
+
+// uniqueStrings returns values without duplicates, keeping the first occurrence of each
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	unique := make([]string, 0, len(values))
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,49 @@
+package validators
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// ValidateAuditRecordQuery checks the paging and filters of an audit log listing
+func ValidateAuditRecordQuery(query utils.AuditRecordQuery) error {
+	if query.Page < 0 {
+		return fmt.Errorf("page must be positive")
+	}
+	if query.PageSize < 0 || query.PageSize > utils.MaxAuditPageSize {
+		return fmt.Errorf("page_size must be between 1 and %d", utils.MaxAuditPageSize)
+	}
+	if query.ActorID != "" {
+		if _, err := uuid.Parse(query.ActorID); err != nil {
+			return fmt.Errorf("actor_id must be a UUID")
+		}
+	}
+	if query.TargetID != "" {
+		if _, err := uuid.Parse(query.TargetID); err != nil {
+			return fmt.Errorf("target_id must be a UUID")
+		}
+	}
+	if len(query.Action) > 100 || len(query.TargetType) > 100 {
+		return fmt.Errorf("action and target_type can be at most 100 characters long")
+	}
+
+	var since, until time.Time
+	var err error
+	if query.Since != "" {
+		if since, err = time.Parse(time.RFC3339, query.Since); err != nil {
+			return fmt.Errorf("since must be an RFC 3339 time, e.g. 2024-05-01T00:00:00Z")
+		}
+	}
+	if query.Until != "" {
+		if until, err = time.Parse(time.RFC3339, query.Until); err != nil {
+			return fmt.Errorf("until must be an RFC 3339 time, e.g. 2024-05-01T00:00:00Z")
+		}
+	}
+	if query.Since != "" && query.Until != "" && !until.After(since) {
+		return fmt.Errorf("until must be after since")
+	}
+	return nil
+}
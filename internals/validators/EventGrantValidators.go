@@ -0,0 +1,38 @@
+package validators
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// ValidateGrantEventAccessRequest checks the email and role of a new grant
+func ValidateGrantEventAccessRequest(req utils.GrantEventAccessRequest) error {
+	if strings.TrimSpace(req.Email) == "" {
+		return errors.New("email is required")
+	}
+	return validateEventGrantRole(req.Role)
+}
+
+// ValidateChangeEventGrantRoleRequest checks the new role of a grant
+func ValidateChangeEventGrantRoleRequest(req utils.ChangeEventGrantRoleRequest) error {
+	return validateEventGrantRole(req.Role)
+}
+
+// ValidateTransferEventRequest checks the email of the new primary organizer
+func ValidateTransferEventRequest(req utils.TransferEventRequest) error {
+	if strings.TrimSpace(req.Email) == "" {
+		return errors.New("email is required")
+	}
+	return nil
+}
+
+// validateEventGrantRole accepts the known event grant roles
+func validateEventGrantRole(role string) error {
+	if !types.IsEventGrantRole(role) {
+		return errors.New("role must be one of CoOrganizer, Editor or CheckIn")
+	}
+	return nil
+}
@@ -2,8 +2,11 @@ package validators
 
 import (
 	"errors"
+	"regexp"
+	"strings"
 	"time"
 
+	"github.com/lordofthemind/EventureGo/internals/types"
 	"github.com/lordofthemind/EventureGo/internals/utils"
 )
 
@@ -15,8 +18,109 @@ func ValidateEventRequest(req utils.RegisterEventRequest) error {
 	if req.StartTime.After(req.EndTime) {
 		return errors.New("start time cannot be after end time")
 	}
-	if time.Now().After(req.StartTime) {
+	// Both sides are absolute instants, so the comparison does not depend on the server's local zone
+	if time.Now().UTC().After(req.StartTime) {
 		return errors.New("event start time cannot be in the past")
 	}
+	if req.Location == "" && req.VenueID == nil {
+		return errors.New("either location or venue_id is required")
+	}
+	if err := ValidateTimezone(req.Timezone); err != nil {
+		return err
+	}
+	if err := ValidateConflictMode(req.ConflictMode); err != nil {
+		return err
+	}
+	return ValidateRecurrence(req.Recurrence, req.StartTime)
+}
+
+// ValidateRescheduleEventRequest checks that the new time slot is valid
+func ValidateRescheduleEventRequest(req utils.RescheduleEventRequest) error {
+	if !req.EndTime.After(req.StartTime) {
+		return errors.New("end time must be after start time")
+	}
+	if time.Now().UTC().After(req.StartTime) {
+		return errors.New("event start time cannot be in the past")
+	}
+	return ValidateConflictMode(req.ConflictMode)
+}
+
+// ValidateConflictMode checks that an optional conflict mode is "reject" or "warn"
+func ValidateConflictMode(mode string) error {
+	switch mode {
+	case "", utils.ConflictModeReject, utils.ConflictModeWarn:
+		return nil
+	default:
+		return errors.New("conflict_mode must be either reject or warn")
+	}
+}
+
+// ValidateFreeSlotsQuery checks the day and minimum gap of a free-slots query
+func ValidateFreeSlotsQuery(query utils.FreeSlotsQuery) error {
+	if _, err := time.Parse(utils.FreeSlotDateLayout, query.Date); err != nil {
+		return errors.New("date must be formatted as YYYY-MM-DD")
+	}
+	if query.MinMinutes < 0 {
+		return errors.New("min_minutes cannot be negative")
+	}
+	return nil
+}
+
+// ValidateTimezone checks that a non-empty timezone is a known IANA name
+func ValidateTimezone(timezone string) error {
+	if timezone == "" {
+		return nil
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return errors.New("timezone must be a valid IANA timezone such as Europe/Berlin")
+	}
+	return nil
+}
+
+// ValidateRecurrence checks that an optional recurrence rule is well formed
+func ValidateRecurrence(rule *types.EventRecurrenceType, startTime time.Time) error {
+	if rule == nil {
+		return nil
+	}
+
+	switch rule.Frequency {
+	case types.RecurrenceDaily, types.RecurrenceWeekly, types.RecurrenceMonthly, types.RecurrenceYearly:
+	default:
+		return errors.New("recurrence frequency must be one of DAILY, WEEKLY, MONTHLY or YEARLY")
+	}
+	if rule.Interval < 0 || rule.Count < 0 {
+		return errors.New("recurrence interval and count cannot be negative")
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return errors.New("recurrence can have either count or until, not both")
+	}
+	if rule.Until != nil && rule.Until.Before(startTime) {
+		return errors.New("recurrence until cannot be before the event start time")
+	}
+	return nil
+}
+
+var eventSlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// ValidateEventSlug checks a slug chosen for the public page of an event
+func ValidateEventSlug(slug string) error {
+	if len(slug) < 3 || len(slug) > 80 || !eventSlugPattern.MatchString(slug) {
+		return errors.New("slug must be 3 to 80 lower-case letters, digits or single dashes between them")
+	}
+	return nil
+}
+
+// ValidateEventStatusChangeRequest checks the target state and reason of a lifecycle change
+func ValidateEventStatusChangeRequest(req utils.EventStatusChangeRequest) error {
+	if !types.IsEventStatus(req.Status) || req.Status == types.EventStatusDraft {
+		return errors.New("status must be one of Published, Postponed, Cancelled or Completed")
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if req.Status == types.EventStatusCancelled && reason == "" {
+		return errors.New("a reason is required to cancel an event")
+	}
+	if len(reason) > 500 {
+		return errors.New("reason cannot be longer than 500 characters")
+	}
 	return nil
 }
@@ -0,0 +1,47 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+var errInvalidRSVPStatus = fmt.Errorf("status must be one of %s, %s, %s, %s or %s",
+	types.RSVPStatusPending, types.RSVPStatusAccepted, types.RSVPStatusTentative, types.RSVPStatusDeclined, types.RSVPStatusWaitlisted)
+
+// ValidateGuestImportFile checks the name and size of an uploaded guest list
+func ValidateGuestImportFile(fileName string, size int64) error {
+	extension := strings.ToLower(filepath.Ext(fileName))
+	if extension != utils.GuestImportFormatCSV && extension != utils.GuestImportFormatXLSX {
+		return errors.New("guest list must be a .csv or .xlsx file")
+	}
+	if size > utils.MaxGuestImportFileSize {
+		return fmt.Errorf("guest list must be smaller than %d MiB", utils.MaxGuestImportFileSize>>20)
+	}
+	return nil
+}
+
+// ValidateGuestExportQuery checks the format and RSVP status filter of a guest export
+func ValidateGuestExportQuery(exportDTO *utils.GuestExportDTO) error {
+	switch exportDTO.Format {
+	case utils.GuestExportFormatCSV, utils.GuestExportFormatXLSX, utils.GuestExportFormatPDF:
+	default:
+		return errors.New("format must be csv, xlsx or pdf")
+	}
+	if exportDTO.RSVPStatus != "" && !types.IsRSVPStatus(exportDTO.RSVPStatus) {
+		return errInvalidRSVPStatus
+	}
+	return nil
+}
+
+// ValidateUpdateRSVP checks that an RSVP update carries a known answer
+func ValidateUpdateRSVP(rsvpDTO *utils.UpdateRSVPDTO) error {
+	if !types.IsRSVPStatus(rsvpDTO.Status) {
+		return errInvalidRSVPStatus
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package validators
+
+import (
+	"fmt"
+
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// ValidateJobRunsQuery checks the limit of a job runs listing
+func ValidateJobRunsQuery(query utils.JobRunsQuery) error {
+	if query.Limit < 0 || query.Limit > utils.MaxJobRunsLimit {
+		return fmt.Errorf("limit must be between 0 and %d", utils.MaxJobRunsLimit)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// ValidateCreateOrderRequest checks the attendees of a new order
+func ValidateCreateOrderRequest(orderDTO *utils.CreateOrderDTO) error {
+	if len(orderDTO.Attendees) == 0 {
+		return errors.New("attendees must contain at least one attendee")
+	}
+	if len(orderDTO.Attendees) > configs.MaxTicketsPerOrder {
+		return fmt.Errorf("an order can contain at most %d tickets", configs.MaxTicketsPerOrder)
+	}
+
+	seen := make(map[string]bool, len(orderDTO.Attendees))
+	for i, attendee := range orderDTO.Attendees {
+		if attendee.FullName == "" {
+			return fmt.Errorf("attendee %d needs a full_name", i+1)
+		}
+		if _, err := mail.ParseAddress(attendee.Email); err != nil {
+			return fmt.Errorf("attendee %d has an invalid email", i+1)
+		}
+		if seen[attendee.Email] {
+			return fmt.Errorf("attendee %d has the same email as an earlier attendee", i+1)
+		}
+		seen[attendee.Email] = true
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package validators
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// ValidateCreateOrganizationRequest checks if the data of a new organization is valid
+func ValidateCreateOrganizationRequest(req utils.CreateOrganizationRequest) error {
+	name := strings.TrimSpace(req.Name)
+	if len(name) < 2 {
+		return errors.New("name must be at least 2 characters long")
+	}
+	if len(name) > 100 {
+		return errors.New("name cannot be longer than 100 characters")
+	}
+	return nil
+}
+
+// ValidateInviteOrganizationMemberRequest checks the email and role of an invitation
+func ValidateInviteOrganizationMemberRequest(req utils.InviteOrganizationMemberRequest) error {
+	if strings.TrimSpace(req.Email) == "" {
+		return errors.New("email is required")
+	}
+	return validateOrganizationRole(req.Role)
+}
+
+// ValidateChangeOrganizationMemberRoleRequest checks the new role of a member
+func ValidateChangeOrganizationMemberRoleRequest(req utils.ChangeOrganizationMemberRoleRequest) error {
+	return validateOrganizationRole(req.Role)
+}
+
+// validateOrganizationRole accepts the known organization roles
+func validateOrganizationRole(role string) error {
+	if !types.IsOrganizationRole(role) {
+		return errors.New("role must be one of Owner, Admin, Editor or Viewer")
+	}
+	return nil
+}
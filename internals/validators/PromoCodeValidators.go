@@ -0,0 +1,65 @@
+package validators
+
+import (
+	"errors"
+
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// ValidatePromoCodeRequest checks if the data of a new promo code is valid
+func ValidatePromoCodeRequest(promoDTO *utils.PromoCodeDTO) error {
+	if !isPromoCode(promoDTO.Code) {
+		return errors.New("code must be 3 to 32 letters, digits, dashes or underscores")
+	}
+	return ValidatePromoCodeSettings(promoDTO)
+}
+
+// ValidatePromoCodeSettings checks the discount, limits and validity window of a promo code
+func ValidatePromoCodeSettings(promoDTO *utils.PromoCodeDTO) error {
+	switch promoDTO.DiscountType {
+	case types.PromoDiscountPercentage:
+		if promoDTO.PercentOff < 1 || promoDTO.PercentOff > 100 {
+			return errors.New("percent_off must be between 1 and 100")
+		}
+		if promoDTO.AmountOffCents != 0 || promoDTO.Currency != "" {
+			return errors.New("percentage discounts take no amount_off_cents or currency")
+		}
+	case types.PromoDiscountFixed:
+		if promoDTO.AmountOffCents < 1 {
+			return errors.New("amount_off_cents must be at least 1")
+		}
+		if !isCurrencyCode(promoDTO.Currency) {
+			return errors.New("fixed discounts need a three-letter ISO 4217 currency such as EUR")
+		}
+		if promoDTO.PercentOff != 0 {
+			return errors.New("fixed discounts take no percent_off")
+		}
+	default:
+		return errors.New("discount_type must be percentage or fixed")
+	}
+
+	if promoDTO.MaxRedemptions < 0 || promoDTO.MaxRedemptionsPerUser < 0 {
+		return errors.New("max_redemptions and max_redemptions_per_user cannot be negative")
+	}
+	if promoDTO.MaxRedemptions > 0 && promoDTO.MaxRedemptionsPerUser > promoDTO.MaxRedemptions {
+		return errors.New("max_redemptions_per_user cannot be higher than max_redemptions")
+	}
+	if promoDTO.ValidFrom != nil && promoDTO.ValidUntil != nil && !promoDTO.ValidUntil.After(*promoDTO.ValidFrom) {
+		return errors.New("valid_until must be after valid_from")
+	}
+	return nil
+}
+
+// isPromoCode accepts 3 to 32 upper-case letters, digits, dashes and underscores
+func isPromoCode(code string) bool {
+	if len(code) < 3 || len(code) > 32 {
+		return false
+	}
+	for _, r := range code {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,122 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+var registrationFieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,39}$`)
+
+// ValidateRegistrationForm checks the fields of a registration form and the rules each of them sets
+func ValidateRegistrationForm(formDTO *utils.RegistrationFormDTO) error {
+	if len(formDTO.Fields) == 0 {
+		return errors.New("a registration form needs at least one field")
+	}
+	if len(formDTO.Fields) > utils.MaxRegistrationFormFields {
+		return fmt.Errorf("a registration form can have at most %d fields", utils.MaxRegistrationFormFields)
+	}
+
+	keys := make(map[string]struct{}, len(formDTO.Fields))
+	for i := range formDTO.Fields {
+		field := &formDTO.Fields[i]
+		if !registrationFieldKeyPattern.MatchString(field.Key) {
+			return fmt.Errorf("field key %q must start with a letter and have at most 40 lower-case letters, digits or underscores", field.Key)
+		}
+		if _, duplicate := keys[field.Key]; duplicate {
+			return fmt.Errorf("field key %q is used twice", field.Key)
+		}
+		keys[field.Key] = struct{}{}
+
+		if err := validateRegistrationField(field); err != nil {
+			return fmt.Errorf("field %q: %v", field.Key, err)
+		}
+	}
+	return nil
+}
+
+// validateRegistrationField checks the label of a field and the rules that apply to its type
+func validateRegistrationField(field *types.RegistrationFieldType) error {
+	if field.Label == "" || len(field.Label) > 200 {
+		return errors.New("label must be 1 to 200 characters long")
+	}
+	if !types.IsRegistrationFieldType(field.Type) {
+		return fmt.Errorf("type must be one of %s, %s, %s, %s, %s or %s",
+			types.RegistrationFieldText, types.RegistrationFieldSelect, types.RegistrationFieldMultiSelect,
+			types.RegistrationFieldCheckbox, types.RegistrationFieldNumber, types.RegistrationFieldDate)
+	}
+
+	choice := field.Type == types.RegistrationFieldSelect || field.Type == types.RegistrationFieldMultiSelect
+	if choice != (len(field.Options) > 0) {
+		if choice {
+			return errors.New("options are required")
+		}
+		return errors.New("options are only allowed on select and multi_select fields")
+	}
+	if field.Type != types.RegistrationFieldText && (field.MinLength != 0 || field.MaxLength != 0 || field.Pattern != "") {
+		return errors.New("min_length, max_length and pattern are only allowed on text fields")
+	}
+	if field.Type != types.RegistrationFieldNumber && field.Type != types.RegistrationFieldMultiSelect && (field.Min != nil || field.Max != nil) {
+		return errors.New("min and max are only allowed on number and multi_select fields")
+	}
+	if field.Type != types.RegistrationFieldDate && (field.MinDate != "" || field.MaxDate != "") {
+		return errors.New("min_date and max_date are only allowed on date fields")
+	}
+	if field.Min != nil && field.Max != nil && *field.Min > *field.Max {
+		return errors.New("min cannot be greater than max")
+	}
+
+	switch field.Type {
+	case types.RegistrationFieldText:
+		if field.MinLength < 0 || field.MaxLength < 0 || field.MaxLength > utils.MaxRegistrationAnswerLength {
+			return fmt.Errorf("min_length and max_length must be between 0 and %d", utils.MaxRegistrationAnswerLength)
+		}
+		if field.MaxLength > 0 && field.MinLength > field.MaxLength {
+			return errors.New("min_length cannot be greater than max_length")
+		}
+		if field.Pattern != "" {
+			if _, err := regexp.Compile(field.Pattern); err != nil {
+				return fmt.Errorf("pattern is not a valid regular expression: %v", err)
+			}
+		}
+
+	case types.RegistrationFieldSelect, types.RegistrationFieldMultiSelect:
+		if len(field.Options) > utils.MaxRegistrationFieldOptions {
+			return fmt.Errorf("a field can have at most %d options", utils.MaxRegistrationFieldOptions)
+		}
+		seen := make(map[string]struct{}, len(field.Options))
+		for _, option := range field.Options {
+			if option == "" || len(option) > 200 {
+				return errors.New("options must be 1 to 200 characters long")
+			}
+			if _, duplicate := seen[option]; duplicate {
+				return fmt.Errorf("option %q is listed twice", option)
+			}
+			seen[option] = struct{}{}
+		}
+		if field.Min != nil && (*field.Min < 0 || *field.Min > float64(len(field.Options))) {
+			return errors.New("min cannot be negative or more than the number of options")
+		}
+		if field.Max != nil && *field.Max < 1 {
+			return errors.New("max must be at least 1")
+		}
+
+	case types.RegistrationFieldDate:
+		for _, date := range []string{field.MinDate, field.MaxDate} {
+			if date == "" {
+				continue
+			}
+			if _, err := time.Parse(utils.RegistrationDateLayout, date); err != nil {
+				return errors.New("min_date and max_date must be formatted as YYYY-MM-DD")
+			}
+		}
+		if field.MinDate != "" && field.MaxDate != "" && field.MinDate > field.MaxDate {
+			return errors.New("min_date cannot be after max_date")
+		}
+	}
+	return nil
+}
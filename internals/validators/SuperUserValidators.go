@@ -0,0 +1,105 @@
+package validators
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// maxPermissionGroups caps how many permission groups a superuser can belong to
+const maxPermissionGroups = 20
+
+// permissionGroupPattern is what a permission group name may look like, e.g. "events:write"
+var permissionGroupPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.:-]{0,49}$`)
+
+// ValidateSuperUserListQuery checks the paging, search and role filter of an admin superuser listing
+func ValidateSuperUserListQuery(query utils.SuperUserListQuery) error {
+	if query.Page < 0 {
+		return fmt.Errorf("page must be positive")
+	}
+	if query.PageSize < 0 || query.PageSize > utils.MaxSuperUserPageSize {
+		return fmt.Errorf("page_size must be between 1 and %d", utils.MaxSuperUserPageSize)
+	}
+	if len(query.Search) > 100 {
+		return fmt.Errorf("search can be at most 100 characters long")
+	}
+	if query.Verified != "" && query.Verified != "true" && query.Verified != "false" {
+		return fmt.Errorf("verified must be true or false")
+	}
+	if query.Active != "" && query.Active != "true" && query.Active != "false" {
+		return fmt.Errorf("active must be true or false")
+	}
+	if query.Role != "" && !slices.Contains(configs.AllowedRoles, query.Role) {
+		return fmt.Errorf("role must be one of %s", strings.Join(configs.AllowedRoles, ", "))
+	}
+	return nil
+}
+
+// ValidatePermissionGroups checks normalized permission group names
+func ValidatePermissionGroups(groups []string) error {
+	if len(groups) > maxPermissionGroups {
+		return fmt.Errorf("a superuser can belong to at most %d permission groups", maxPermissionGroups)
+	}
+	for _, group := range groups {
+		if !permissionGroupPattern.MatchString(group) {
+			return fmt.Errorf("invalid permission group %q: use up to 50 lowercase letters, digits and _ . : -", group)
+		}
+	}
+	return nil
+}
+
+// ValidateChangePasswordRequest checks that both passwords are given and the new one differs from the current one
+func ValidateChangePasswordRequest(req utils.ChangePasswordRequest) error {
+	if req.CurrentPassword == "" {
+		return fmt.Errorf("current_password is required")
+	}
+	if req.NewPassword == "" {
+		return fmt.Errorf("new_password is required")
+	}
+	if req.NewPassword == req.CurrentPassword {
+		return fmt.Errorf("new_password must differ from the current password")
+	}
+	return nil
+}
+
+// ValidateUpdateProfileRequest checks the length of the new full name
+func ValidateUpdateProfileRequest(req utils.UpdateProfileRequest) error {
+	fullName := strings.TrimSpace(req.FullName)
+	if len(fullName) < 3 || len(fullName) > 32 {
+		return fmt.Errorf("full_name must be between 3 and 32 characters long")
+	}
+	return nil
+}
+
+// ValidateChangeEmailRequest checks the new email address and that the current password is given
+func ValidateChangeEmailRequest(req utils.ChangeEmailRequest) error {
+	if _, err := mail.ParseAddress(strings.TrimSpace(req.Email)); err != nil {
+		return fmt.Errorf("email must be a valid email address")
+	}
+	if req.Password == "" {
+		return fmt.Errorf("password is required")
+	}
+	return nil
+}
+
+// ValidatePasswordlessLoginRequest checks the email address a login link and code are sent to
+func ValidatePasswordlessLoginRequest(req utils.PasswordlessLoginRequest) error {
+	if _, err := mail.ParseAddress(strings.TrimSpace(req.Email)); err != nil {
+		return fmt.Errorf("email must be a valid email address")
+	}
+	return nil
+}
+
+// ValidatePasswordlessCodeLoginRequest checks that a login code has 6 digits
+func ValidatePasswordlessCodeLoginRequest(req utils.PasswordlessCodeLoginRequest) error {
+	code := strings.TrimSpace(req.Code)
+	if len(code) != 6 || strings.Trim(code, "0123456789") != "" {
+		return fmt.Errorf("code must be the 6 digits from the login email")
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package validators
+
+import (
+	"errors"
+
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// ValidateTicketTierRequest checks if the data of a ticket tier is valid
+func ValidateTicketTierRequest(tierDTO *utils.TicketTierDTO) error {
+	if tierDTO.Name == "" || len(tierDTO.Name) > 100 {
+		return errors.New("name is required and cannot be longer than 100 characters")
+	}
+	if len(tierDTO.Description) > 500 {
+		return errors.New("description cannot be longer than 500 characters")
+	}
+	if tierDTO.PriceCents < 0 {
+		return errors.New("price_cents cannot be negative")
+	}
+	if !isCurrencyCode(tierDTO.Currency) {
+		return errors.New("currency must be a three-letter ISO 4217 code such as EUR")
+	}
+	if tierDTO.Quantity < 1 {
+		return errors.New("quantity must be at least 1")
+	}
+	if tierDTO.SalesStartAt != nil && tierDTO.SalesEndAt != nil && !tierDTO.SalesEndAt.After(*tierDTO.SalesStartAt) {
+		return errors.New("sales_end_at must be after sales_start_at")
+	}
+	return nil
+}
+
+// isCurrencyCode accepts three upper-case letters
+func isCurrencyCode(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
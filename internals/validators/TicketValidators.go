@@ -0,0 +1,15 @@
+package validators
+
+import (
+	"fmt"
+
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// ValidateCheckInSync checks the size of an offline scan upload
+func ValidateCheckInSync(scans []*utils.CheckInScanDTO) error {
+	if len(scans) > utils.MaxCheckInSyncScans {
+		return fmt.Errorf("at most %d scans can be synced at once", utils.MaxCheckInSyncScans)
+	}
+	return nil
+}
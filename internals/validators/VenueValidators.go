@@ -0,0 +1,65 @@
+package validators
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// ValidateVenueRequest checks if the venue data is valid
+func ValidateVenueRequest(req utils.RegisterVenueRequest) error {
+	if len(strings.TrimSpace(req.Name)) < 3 || len(req.Name) > 100 {
+		return errors.New("name must be between 3 and 100 characters")
+	}
+	if err := validateCoordinates(req.Latitude, req.Longitude); err != nil {
+		return err
+	}
+	if _, err := time.LoadLocation(req.Timezone); err != nil || req.Timezone == "" {
+		return errors.New("timezone must be a valid IANA timezone such as Europe/Berlin")
+	}
+	if req.Capacity < 0 {
+		return errors.New("capacity cannot be negative")
+	}
+	return nil
+}
+
+// ValidateGeoRadiusQuery checks if the radius search parameters are valid
+func ValidateGeoRadiusQuery(query utils.GeoRadiusQuery) error {
+	if err := validateCoordinates(*query.Latitude, *query.Longitude); err != nil {
+		return err
+	}
+	if query.RadiusKm < 0 || query.RadiusKm > 20000 {
+		return errors.New("radius_km must be between 0 and 20000")
+	}
+	return nil
+}
+
+// ValidateGeoBoundingBoxQuery checks if the bounding-box search parameters are valid
+func ValidateGeoBoundingBoxQuery(query utils.GeoBoundingBoxQuery) error {
+	if err := validateCoordinates(*query.MinLatitude, *query.MinLongitude); err != nil {
+		return err
+	}
+	if err := validateCoordinates(*query.MaxLatitude, *query.MaxLongitude); err != nil {
+		return err
+	}
+	if *query.MinLatitude > *query.MaxLatitude {
+		return errors.New("min_lat cannot be greater than max_lat")
+	}
+	if *query.MinLongitude > *query.MaxLongitude {
+		return errors.New("min_lng cannot be greater than max_lng")
+	}
+	return nil
+}
+
+// validateCoordinates checks that a latitude and longitude pair is within range
+func validateCoordinates(latitude, longitude float64) error {
+	if latitude < -90 || latitude > 90 {
+		return errors.New("latitude must be between -90 and 90")
+	}
+	if longitude < -180 || longitude > 180 {
+		return errors.New("longitude must be between -180 and 180")
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/lordofthemind/EventureGo/configs"
+	"github.com/lordofthemind/EventureGo/internals/types"
+	"github.com/lordofthemind/EventureGo/internals/utils"
+)
+
+// ValidateWebhookRequest checks if the data of a new webhook is valid
+func ValidateWebhookRequest(req utils.RegisterWebhookRequest) error {
+	if err := validateWebhookURL(req.URL); err != nil {
+		return err
+	}
+	if err := validateWebhookEventTypes(req.EventTypes); err != nil {
+		return err
+	}
+	if len(req.Description) > 200 {
+		return errors.New("description cannot be longer than 200 characters")
+	}
+	return nil
+}
+
+// ValidateUpdateWebhookRequest checks the fields a webhook change sets
+func ValidateUpdateWebhookRequest(req utils.UpdateWebhookRequest) error {
+	if req.URL != nil {
+		if err := validateWebhookURL(*req.URL); err != nil {
+			return err
+		}
+	}
+	if req.EventTypes != nil {
+		if err := validateWebhookEventTypes(req.EventTypes); err != nil {
+			return err
+		}
+	}
+	if req.Description != nil && len(*req.Description) > 200 {
+		return errors.New("description cannot be longer than 200 characters")
+	}
+	return nil
+}
+
+// ValidateWebhookDeliveriesQuery checks the limit of a webhook deliveries listing
+func ValidateWebhookDeliveriesQuery(query utils.WebhookDeliveriesQuery) error {
+	if query.Limit < 0 || query.Limit > utils.MaxWebhookDeliveriesLimit {
+		return fmt.Errorf("limit must be between 0 and %d", utils.MaxWebhookDeliveriesLimit)
+	}
+	return nil
+}
+
+// validateWebhookURL accepts absolute http and https URLs that do not name an address of the server's own network
+func validateWebhookURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errors.New("url must be an absolute http or https URL")
+	}
+	if len(rawURL) > 2048 {
+		return errors.New("url cannot be longer than 2048 characters")
+	}
+	// Host names are checked again on every delivery, once they are resolved
+	if !configs.WebhookAllowPrivate {
+		host := parsed.Hostname()
+		if ip := net.ParseIP(host); strings.EqualFold(host, "localhost") || (ip != nil && !utils.IsPublicWebhookIP(ip)) {
+			return errors.New("url must not point to a loopback, private or link-local address")
+		}
+	}
+	return nil
+}
+
+// validateWebhookEventTypes requires at least one event type and only known ones
+func validateWebhookEventTypes(eventTypes []string) error {
+	if len(eventTypes) == 0 {
+		return errors.New("event_types must contain at least one event type")
+	}
+	for _, eventType := range eventTypes {
+		if !types.IsWebhookEventType(eventType) {
+			return fmt.Errorf("event_types must only contain %s", strings.Join(types.WebhookEventTypes, ", "))
+		}
+	}
+	return nil
+}
@@ -1,10 +1,18 @@
 package main
 
 import (
+	"os"
+
 	"github.com/lordofthemind/EventureGo/cmd"
 )
 
 func main() {
+	// `go run . verify-audit-chain` checks the audit log instead of starting a server
+	if len(os.Args) > 1 && os.Args[1] == "verify-audit-chain" {
+		cmd.VerifyAuditChain()
+		return
+	}
+
 	cmd.GinServer()
 	// cmd.FiberServer()
 }